@@ -0,0 +1,85 @@
+// Package cmd provides command-line interface implementations for Spectr.
+// This file contains the coverage command for linking scenarios to tests.
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/connerohnesorge/spectr/internal/coverage"
+	"github.com/connerohnesorge/spectr/internal/discovery"
+)
+
+// CoverageCmd represents the coverage command which matches spec
+// scenarios to Go tests and reports passing, failing, or missing tests.
+type CoverageCmd struct {
+	// Capabilities restricts the report to specific specs
+	Capabilities []string `arg:"" optional:"" help:"Capabilities to report on"`
+	// Convention selects which test names are matched against scenarios
+	Convention string `name:"convention" enum:"any,func,subtest,tag" default:"any" help:"Test name matching"`
+	// TestJSON is a file with `go test -json` output ("-" for stdin)
+	TestJSON string `name:"test-json" help:"go test -json output file (- for stdin)"`
+	// JSON enables JSON output format
+	JSON bool `name:"json" help:"Output as JSON"`
+	// Strict fails the command when any scenario is failing or missing
+	Strict bool `name:"strict" help:"Fail if any scenario is failing or missing"`
+}
+
+// Run executes the coverage command
+func (c *CoverageCmd) Run() error {
+	projectPath, err := discovery.ProjectRoot()
+	if err != nil {
+		return err
+	}
+
+	opts := coverage.Options{
+		Capabilities: c.Capabilities,
+		Convention:   coverage.Convention(c.Convention),
+	}
+
+	if c.TestJSON != "" {
+		results, err := readTestResults(c.TestJSON)
+		if err != nil {
+			return err
+		}
+		opts.Results = results
+	}
+
+	report, err := coverage.BuildReport(projectPath, opts)
+	if err != nil {
+		return fmt.Errorf("coverage failed: %w", err)
+	}
+
+	if c.JSON {
+		output, err := coverage.FormatJSON(report)
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(output)
+	} else {
+		fmt.Println(coverage.FormatText(report))
+	}
+
+	if c.Strict && (report.Summary.Failing > 0 || report.Summary.Missing > 0) {
+		return errors.New("scenario coverage incomplete")
+	}
+
+	return nil
+}
+
+// readTestResults parses `go test -json` output from a file or stdin
+func readTestResults(path string) (coverage.Results, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open test results: %w", err)
+		}
+		defer func() { _ = file.Close() }()
+		r = file
+	}
+
+	return coverage.ParseTestJSON(r)
+}
@@ -0,0 +1,114 @@
+// Package cmd provides command-line interface implementations for Spectr.
+// This file contains the export command for converting specs to other
+// formats.
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/gherkin"
+	"github.com/connerohnesorge/spectr/internal/publish"
+)
+
+// exportFilePerm is the mode of files written by export commands
+const exportFilePerm = 0o644
+
+// ExportCmd groups spec export subcommands
+type ExportCmd struct {
+	Gherkin  ExportGherkinCmd  `cmd:"" help:"Export specs as Gherkin feature files"`
+	HTML     ExportHTMLCmd     `cmd:"" name:"html" help:"Export a static HTML site"`
+	Markdown ExportMarkdownCmd `cmd:"" help:"Export all specs as one Markdown document"`
+}
+
+// ExportGherkinCmd writes one .feature file per capability
+type ExportGherkinCmd struct {
+	// Capabilities restricts the export to specific specs
+	Capabilities []string `arg:"" optional:"" help:"Capabilities to export"`
+	// Out is the directory receiving the feature files
+	Out string `name:"out" short:"o" default:"features" help:"Output directory"`
+}
+
+// Run executes the export gherkin command
+func (c *ExportGherkinCmd) Run() error {
+	projectPath, err := discovery.ProjectRoot()
+	if err != nil {
+		return err
+	}
+
+	results, err := gherkin.Export(projectPath, c.Out, c.Capabilities)
+	if err != nil {
+		return fmt.Errorf("export failed: %w", err)
+	}
+
+	for _, result := range results {
+		fmt.Printf("✓ %s -> %s\n", result.Capability, result.Path)
+	}
+	fmt.Printf("\nExported %d feature file(s)\n", len(results))
+
+	return nil
+}
+
+// ExportHTMLCmd renders specs, changes and the dashboard as a static site
+type ExportHTMLCmd struct {
+	// Out is the directory receiving the site
+	Out string `name:"out" short:"o" default:"site" help:"Output directory"`
+}
+
+// Run executes the export html command
+func (c *ExportHTMLCmd) Run() error {
+	projectPath, err := discovery.ProjectRoot()
+	if err != nil {
+		return err
+	}
+
+	files, err := publish.ExportHTML(projectPath, c.Out)
+	if err != nil {
+		return fmt.Errorf("export failed: %w", err)
+	}
+
+	fmt.Printf(
+		"✓ Wrote %d file(s) to %s\n",
+		len(files),
+		c.Out,
+	)
+	fmt.Printf("  Open %s in a browser\n", filepath.Join(c.Out, "index.html"))
+
+	return nil
+}
+
+// ExportMarkdownCmd writes all specs into one consolidated document
+type ExportMarkdownCmd struct {
+	// Capabilities restricts the document to specific specs
+	Capabilities []string `name:"capability" short:"c" help:"Only include these capabilities"`
+	// Out is the file to write; the document goes to stdout when empty
+	Out string `name:"out" short:"o" help:"Output file (default: stdout)"`
+}
+
+// Run executes the export markdown command
+func (c *ExportMarkdownCmd) Run() error {
+	projectPath, err := discovery.ProjectRoot()
+	if err != nil {
+		return err
+	}
+
+	doc, err := publish.ExportMarkdown(projectPath, c.Capabilities)
+	if err != nil {
+		return fmt.Errorf("export failed: %w", err)
+	}
+
+	if c.Out == "" {
+		fmt.Print(doc)
+
+		return nil
+	}
+
+	if err := os.WriteFile(c.Out, []byte(doc), exportFilePerm); err != nil {
+		return fmt.Errorf("write %s: %w", c.Out, err)
+	}
+	fmt.Printf("✓ Wrote %s\n", c.Out)
+
+	return nil
+}
@@ -0,0 +1,78 @@
+// Package cmd provides command-line interface implementations for Spectr.
+// This file contains the gen command for generating code from specs.
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/testgen"
+)
+
+// GenCmd groups code generation subcommands
+type GenCmd struct {
+	Tests GenTestsCmd `cmd:"" help:"Generate Go test skeletons from scenarios"`
+}
+
+// GenTestsCmd generates a _test.go file with one t.Run per scenario of
+// a capability. Existing test functions are never overwritten.
+type GenTestsCmd struct {
+	// Capability is the spec whose scenarios are turned into tests
+	Capability string `arg:"" help:"Capability to generate tests for"`
+	// Package is the Go package directory receiving the test file
+	Package string `name:"package" short:"p" default:"." help:"Target package directory"`
+	// File overrides the generated file name
+	File string `name:"file" help:"Test file name"`
+	// DryRun prints the resulting file instead of writing it
+	DryRun bool `name:"dry-run" help:"Print the file instead of writing it"`
+}
+
+// Run executes the gen tests command
+func (c *GenTestsCmd) Run() error {
+	projectPath, err := discovery.ProjectRoot()
+	if err != nil {
+		return err
+	}
+
+	// The package is relative to where the command runs, which may be
+	// below the project root
+	packageDir, err := filepath.Abs(c.Package)
+	if err != nil {
+		return fmt.Errorf("resolve package directory: %w", err)
+	}
+
+	result, err := testgen.Generate(projectPath, testgen.Options{
+		Capability: c.Capability,
+		PackageDir: packageDir,
+		FileName:   c.File,
+		DryRun:     c.DryRun,
+	})
+	if err != nil {
+		return fmt.Errorf("generate tests failed: %w", err)
+	}
+
+	if c.DryRun {
+		fmt.Print(result.Content)
+
+		return nil
+	}
+
+	for _, name := range result.Skipped {
+		fmt.Printf("⚠️  Skipping %s (already exists)\n", name)
+	}
+
+	if len(result.Added) == 0 {
+		fmt.Println("No new tests to generate")
+
+		return nil
+	}
+
+	relPath, err := filepath.Rel(projectPath, result.Path)
+	if err != nil {
+		relPath = result.Path
+	}
+	fmt.Printf("✓ Generated %d test(s) in %s\n", len(result.Added), relPath)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+// Package cmd provides command-line interface implementations for Spectr.
+// This file contains the graph command for rendering change dependencies.
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/graph"
+)
+
+// GraphCmd renders the dependency graph of the active changes and the
+// capabilities they touch
+type GraphCmd struct {
+	// Format selects the output format
+	Format string `name:"format" short:"f" enum:"dot,mermaid" default:"dot" help:"Output format (dot, mermaid)"`
+	// ChangesOnly omits the capabilities touched by each change
+	ChangesOnly bool `name:"changes-only" help:"Only show changes and their dependencies"`
+}
+
+// Run executes the graph command
+func (c *GraphCmd) Run() error {
+	projectPath, err := discovery.ProjectRoot()
+	if err != nil {
+		return err
+	}
+
+	g, err := graph.Load(projectPath)
+	if err != nil {
+		return fmt.Errorf("failed to load change graph: %w", err)
+	}
+
+	opts := graph.Options{Capabilities: !c.ChangesOnly}
+	if c.Format == "mermaid" {
+		fmt.Print(graph.FormatMermaid(g, opts))
+	} else {
+		fmt.Print(graph.FormatDOT(g, opts))
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// Package cmd provides command-line interface implementations for Spectr.
+// This file contains the ids command for managing stable requirement IDs.
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/ids"
+)
+
+// IDsCmd groups the stable requirement ID subcommands
+type IDsCmd struct {
+	Assign IDsAssignCmd `cmd:"" help:"Assign IDs to requirements without one"`
+}
+
+// IDsAssignCmd back-fills stable IDs such as {#VAL-012} onto requirement
+// headers that do not have one yet.
+type IDsAssignCmd struct {
+	// Capabilities restricts assignment to specific specs
+	Capabilities []string `arg:"" optional:"" help:"Capabilities to assign IDs in"`
+	// DryRun prints the assignments without writing any files
+	DryRun bool `name:"dry-run" help:"Show assignments without writing"`
+	// JSON enables JSON output format
+	JSON bool `name:"json" help:"Output as JSON"`
+}
+
+// Run executes the ids assign command
+func (c *IDsAssignCmd) Run() error {
+	projectPath, err := discovery.ProjectRoot()
+	if err != nil {
+		return err
+	}
+
+	assignments, err := ids.Assign(projectPath, ids.Options{
+		Capabilities: c.Capabilities,
+		DryRun:       c.DryRun,
+	})
+	if err != nil {
+		return fmt.Errorf("assign failed: %w", err)
+	}
+
+	if c.JSON {
+		if assignments == nil {
+			assignments = []ids.Assignment{}
+		}
+		data, err := json.MarshalIndent(assignments, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(data))
+
+		return nil
+	}
+
+	if len(assignments) == 0 {
+		fmt.Println("All requirements already have IDs")
+
+		return nil
+	}
+
+	for _, a := range assignments {
+		fmt.Printf("%s  %s: %s\n", a.ID, a.Capability, a.Requirement)
+	}
+
+	verb := "Assigned"
+	if c.DryRun {
+		verb = "Would assign"
+	}
+	fmt.Printf("✓ %s %d requirement ID(s)\n", verb, len(assignments))
+
+	return nil
+}
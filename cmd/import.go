@@ -0,0 +1,63 @@
+// Package cmd provides command-line interface implementations for Spectr.
+// This file contains the import command for creating changes from
+// other formats.
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/gherkin"
+)
+
+// ImportCmd groups import subcommands
+type ImportCmd struct {
+	Gherkin ImportGherkinCmd `cmd:"" help:"Create a change from Gherkin feature files"`
+}
+
+// ImportGherkinCmd creates a change with ADDED requirements from
+// Gherkin feature files
+type ImportGherkinCmd struct {
+	// ChangeID is the change to create or extend
+	ChangeID string `arg:"" help:"Change ID to create"`
+	// Files are the feature files to import
+	Files []string `arg:"" type:"existingfile" help:"Feature files"`
+	// Capability overrides the capability derived from file names
+	Capability string `name:"capability" short:"c" help:"Target capability"`
+}
+
+// Run executes the import gherkin command
+func (c *ImportGherkinCmd) Run() error {
+	projectPath, err := discovery.ProjectRoot()
+	if err != nil {
+		return err
+	}
+
+	result, err := gherkin.Import(projectPath, gherkin.ImportOptions{
+		ChangeID:   c.ChangeID,
+		Files:      c.Files,
+		Capability: c.Capability,
+	})
+	if err != nil {
+		return fmt.Errorf("import failed: %w", err)
+	}
+
+	capabilities := make([]string, 0, len(result.Requirements))
+	for capability := range result.Requirements {
+		capabilities = append(capabilities, capability)
+	}
+	sort.Strings(capabilities)
+
+	fmt.Printf("Imported into change: %s\n", c.ChangeID)
+	for _, capability := range capabilities {
+		fmt.Printf(
+			"  + %d requirement(s) -> %s\n",
+			result.Requirements[capability],
+			capability,
+		)
+	}
+	fmt.Printf("\nRun 'spectr validate %s' to check the result\n", c.ChangeID)
+
+	return nil
+}
@@ -5,8 +5,8 @@ package cmd
 import (
 	"errors"
 	"fmt"
-	"os"
 
+	"github.com/connerohnesorge/spectr/internal/discovery"
 	"github.com/connerohnesorge/spectr/internal/list"
 )
 
@@ -24,6 +24,26 @@ type ListCmd struct {
 	JSON bool `name:"json" help:"Output as JSON"`
 	// Interactive enables interactive table mode with clipboard
 	Interactive bool `short:"I" name:"interactive" help:"Interactive mode"`
+	// Workspace lists every spectr root in the repository
+	Workspace bool `name:"workspace" help:"List all spectr roots in the repository, prefixing IDs with their root"`
+	// Label keeps changes carrying all of the given labels
+	Label []string `name:"label" help:"Only list changes with this label (repeatable)"`
+	// Owner keeps changes owned by the given owner
+	Owner string `name:"owner" help:"Only list changes with this owner"`
+	// Status keeps changes in the given status
+	Status string `name:"status" help:"Only list changes with this status"`
+}
+
+// filter returns the change filter given by --label, --owner and --status
+func (c *ListCmd) filter() list.ChangeFilter {
+	return list.ChangeFilter{Labels: c.Label, Owner: c.Owner, Status: c.Status}
+}
+
+// itemLister lists the changes and specs of a project or a workspace
+type itemLister interface {
+	ListChanges() ([]list.ChangeInfo, error)
+	ListSpecs() ([]list.SpecInfo, error)
+	ListAll(opts *list.ListAllOptions) (list.ItemList, error)
 }
 
 // Run executes the list command.
@@ -40,17 +60,30 @@ func (c *ListCmd) Run() error {
 		return errors.New("cannot use --all with --specs")
 	}
 
-	// Get current working directory as the project path
-	projectPath, err := os.Getwd()
+	// Validate flags - filters select changes by proposal metadata
+	if c.Specs && !c.filter().IsZero() {
+		return errors.New("--label, --owner, and --status only apply to changes")
+	}
+
+	// Resolve the project root
+	projectPath, err := discovery.ProjectRoot()
 	if err != nil {
-		return fmt.Errorf(
-			"failed to get current directory: %w",
-			err,
-		)
+		return err
 	}
 
-	// Create lister instance for the project
-	lister := list.NewLister(projectPath)
+	// Create lister instance for the project or the whole workspace
+	var lister itemLister = list.NewLister(projectPath)
+	if c.Workspace {
+		if c.Interactive {
+			return errors.New("cannot use --interactive with --workspace")
+		}
+
+		workspace, roots, err := findWorkspaceRoots(projectPath)
+		if err != nil {
+			return err
+		}
+		lister = list.NewWorkspaceLister(workspace, roots)
+	}
 
 	// Route to appropriate listing function
 	if c.All {
@@ -65,12 +98,13 @@ func (c *ListCmd) Run() error {
 
 // listChanges retrieves and displays changes in the requested format.
 // It handles interactive mode, JSON, long, and default text formats.
-func (c *ListCmd) listChanges(lister *list.Lister, projectPath string) error {
+func (c *ListCmd) listChanges(lister itemLister, projectPath string) error {
 	// Retrieve all changes from the project
 	changes, err := lister.ListChanges()
 	if err != nil {
 		return fmt.Errorf("failed to list changes: %w", err)
 	}
+	changes = list.FilterChanges(changes, c.filter())
 
 	// Handle interactive mode - shows a navigable table
 	if c.Interactive {
@@ -109,7 +143,7 @@ func (c *ListCmd) listChanges(lister *list.Lister, projectPath string) error {
 
 // listSpecs retrieves and displays specifications in the requested format.
 // It handles interactive mode, JSON, long, and default text formats.
-func (c *ListCmd) listSpecs(lister *list.Lister, projectPath string) error {
+func (c *ListCmd) listSpecs(lister itemLister, projectPath string) error {
 	// Retrieve all specifications from the project
 	specs, err := lister.ListSpecs()
 	if err != nil {
@@ -153,12 +187,13 @@ func (c *ListCmd) listSpecs(lister *list.Lister, projectPath string) error {
 
 // listAll retrieves and displays both changes and specs in unified format.
 // It handles interactive mode, JSON, long, and default text formats.
-func (c *ListCmd) listAll(lister *list.Lister, projectPath string) error {
+func (c *ListCmd) listAll(lister itemLister, projectPath string) error {
 	// Retrieve all items (changes and specs) from the project
 	items, err := lister.ListAll(nil)
 	if err != nil {
 		return fmt.Errorf("failed to list all items: %w", err)
 	}
+	items = list.FilterItems(items, c.filter())
 
 	// Handle interactive mode - shows a unified navigable table
 	if c.Interactive {
@@ -194,3 +229,18 @@ func (c *ListCmd) listAll(lister *list.Lister, projectPath string) error {
 
 	return nil
 }
+
+// findWorkspaceRoots returns the workspace containing projectPath, i.e.
+// its git repository, and all spectr roots below it
+func findWorkspaceRoots(projectPath string) (string, []string, error) {
+	workspace := discovery.WorkspaceRoot(projectPath)
+	roots, err := discovery.FindRoots(workspace)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(roots) == 0 {
+		return "", nil, fmt.Errorf("no spectr roots found under %s", workspace)
+	}
+
+	return workspace, roots, nil
+}
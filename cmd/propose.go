@@ -0,0 +1,92 @@
+// Package cmd provides command-line interface implementations for Spectr.
+// This file contains the propose command, which marks a change as
+// proposed and can open a pull request for it.
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/lifecycle"
+	"github.com/connerohnesorge/spectr/internal/propose"
+)
+
+// ProposeCmd validates a change, moves a draft to proposed, and
+// optionally opens a pull request proposing it
+type ProposeCmd struct {
+	// ChangeID is the change to propose
+	ChangeID string `arg:"" help:"Change ID"`
+	// PR opens a pull request with the change directory
+	PR        bool     `name:"pr" help:"Commit the change on a new branch and open a PR"`
+	Remote    string   `name:"remote" help:"Git remote for the PR (default: origin)"`
+	Base      string   `name:"base" help:"Target branch of the PR (default: default branch)"`
+	Draft     *bool    `name:"draft" negatable:"" help:"Open the PR as a draft (--no-draft overrides the config)"`
+	Labels    []string `name:"label" help:"Label to add to the PR (repeatable)"`
+	Reviewers []string `name:"reviewer" help:"Reviewer to request on the PR (repeatable)"`
+}
+
+// Run executes the propose command
+func (c *ProposeCmd) Run() error {
+	projectPath, err := discovery.ProjectRoot()
+	if err != nil {
+		return err
+	}
+
+	changeDir := filepath.Join(projectPath, "spectr", "changes", c.ChangeID)
+	if _, err := os.Stat(changeDir); os.IsNotExist(err) {
+		return fmt.Errorf("change not found: %s", c.ChangeID)
+	}
+
+	if err := c.markProposed(changeDir); err != nil {
+		return fmt.Errorf("propose failed: %w", err)
+	}
+
+	if !c.PR {
+		return nil
+	}
+
+	fmt.Println("\nCreating pull request...")
+	pr, err := propose.OpenPR(projectPath, c.ChangeID, propose.Options{
+		Remote:    c.Remote,
+		Base:      c.Base,
+		Draft:     c.Draft,
+		Labels:    c.Labels,
+		Reviewers: c.Reviewers,
+	})
+	if err != nil {
+		return fmt.Errorf("propose failed: %w", err)
+	}
+	fmt.Printf("\n✓ Pull request created: %s\n", pr.URL)
+
+	return nil
+}
+
+// markProposed validates the change and moves it from draft to proposed.
+// Changes past draft keep their state.
+func (c *ProposeCmd) markProposed(changeDir string) error {
+	state, err := lifecycle.Current(changeDir)
+	if err != nil {
+		return err
+	}
+
+	if state != lifecycle.Draft {
+		if err := validateChangeDir(changeDir); err != nil {
+			return err
+		}
+		fmt.Printf("✓ %s is valid (%s)\n", c.ChangeID, state)
+
+		return nil
+	}
+
+	from, err := lifecycle.Transition(changeDir, lifecycle.Proposed, lifecycle.Guards{
+		Validate: validateChangeDir,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✓ %s: %s → %s\n", c.ChangeID, from, lifecycle.Proposed)
+
+	return nil
+}
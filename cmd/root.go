@@ -2,13 +2,34 @@ package cmd
 
 import (
 	"github.com/connerohnesorge/spectr/internal/archive"
+	"github.com/connerohnesorge/spectr/internal/discovery"
 )
 
 // CLI represents the root command structure for Kong
 type CLI struct {
+	Root string `name:"root" type:"existingdir" help:"Project root containing spectr/ (default: nearest one above the current directory)"`
+
 	Init     InitCmd            `cmd:"" help:"Initialize Spectr in a project"`
 	List     ListCmd            `cmd:"" help:"List changes or specifications"`
 	Validate ValidateCmd        `cmd:"" help:"Validate changes or specs"`
+	Propose  ProposeCmd         `cmd:"" help:"Validate a change and propose it, optionally as a PR"`
 	Archive  archive.ArchiveCmd `cmd:"" help:"Archive a completed change"`
 	View     ViewCmd            `cmd:"" help:"Display project dashboard"`
+	Show     ShowCmd            `cmd:"" help:"Show a change and its tasks"`
+	Task     TaskCmd            `cmd:"" help:"List and update the tasks of a change"`
+	Coverage CoverageCmd        `cmd:"" help:"Report scenario test coverage"`
+	Gen      GenCmd             `cmd:"" help:"Generate code from specs"`
+	Export   ExportCmd          `cmd:"" help:"Export specs to other formats"`
+	Import   ImportCmd          `cmd:"" help:"Import changes from other formats"`
+	IDs      IDsCmd             `cmd:"" name:"ids" help:"Manage stable requirement IDs"`
+	Graph    GraphCmd           `cmd:"" help:"Render the change dependency graph"`
+	Status   StatusCmd          `cmd:"" help:"Show or change the lifecycle state of a change"`
+}
+
+// AfterApply hands --root to discovery, where every command resolves
+// its project root via discovery.ProjectRoot
+func (c *CLI) AfterApply() error {
+	discovery.SetRoot(c.Root)
+
+	return nil
 }
@@ -0,0 +1,46 @@
+// Package cmd provides command-line interface implementations for Spectr.
+// This file contains the show command for displaying a single change.
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/view"
+)
+
+// ShowCmd displays the details of an active change, including its
+// tasks grouped by tasks.md section
+type ShowCmd struct {
+	// ChangeID is the change to show
+	ChangeID string `arg:"" help:"Change ID"`
+	// JSON enables JSON output format
+	JSON bool `name:"json" help:"Output as JSON"`
+}
+
+// Run executes the show command
+func (c *ShowCmd) Run() error {
+	projectPath, err := discovery.ProjectRoot()
+	if err != nil {
+		return err
+	}
+
+	detail, err := view.CollectChange(projectPath, c.ChangeID)
+	if err != nil {
+		return err
+	}
+
+	if !c.JSON {
+		fmt.Println(view.FormatChangeText(detail))
+
+		return nil
+	}
+
+	output, err := view.FormatChangeJSON(detail)
+	if err != nil {
+		return err
+	}
+	fmt.Println(output)
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// Package cmd provides command-line interface implementations for Spectr.
+// This file contains the status command for moving a change through its
+// lifecycle.
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/lifecycle"
+	"github.com/connerohnesorge/spectr/internal/validation"
+)
+
+// StatusCmd shows or changes the lifecycle state of a change
+type StatusCmd struct {
+	// ChangeID is the change to inspect or update
+	ChangeID string `arg:"" help:"Change ID"`
+	// State is the state to move the change to
+	State string `arg:"" optional:"" help:"New state (draft, proposed, approved, in-progress, ready-to-archive)"`
+	// Force skips the validation and task guards
+	Force bool `name:"force" help:"Skip validation and task checks"`
+}
+
+// Run executes the status command
+func (c *StatusCmd) Run() error {
+	projectPath, err := discovery.ProjectRoot()
+	if err != nil {
+		return err
+	}
+
+	changeDir := filepath.Join(projectPath, "spectr", "changes", c.ChangeID)
+	if _, err := os.Stat(changeDir); os.IsNotExist(err) {
+		return fmt.Errorf("change not found: %s", c.ChangeID)
+	}
+
+	if c.State == "" {
+		state, err := lifecycle.Current(changeDir)
+		if err != nil {
+			return fmt.Errorf("status failed: %w", err)
+		}
+		fmt.Printf("%s: %s\n", c.ChangeID, state)
+
+		return nil
+	}
+
+	to, err := lifecycle.Parse(c.State)
+	if err != nil {
+		return err
+	}
+
+	from, err := lifecycle.Transition(changeDir, to, lifecycle.Guards{
+		Validate: validateChangeDir,
+		Force:    c.Force,
+	})
+	if err != nil {
+		return fmt.Errorf("status failed: %w", err)
+	}
+	fmt.Printf("✓ %s: %s → %s\n", c.ChangeID, from, to)
+
+	return nil
+}
+
+// validateChangeDir fails if the change in changeDir has validation
+// errors
+func validateChangeDir(changeDir string) error {
+	report, err := validation.NewValidator(false).ValidateChange(changeDir)
+	if err != nil {
+		return err
+	}
+	if !report.Valid {
+		return fmt.Errorf(
+			"%d validation error(s); run 'spectr validate %s'",
+			report.Summary.Errors,
+			filepath.Base(changeDir),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+// Package cmd provides command-line interface implementations for Spectr.
+// This file contains the task command for updating tasks.md.
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/parsers"
+	"github.com/connerohnesorge/spectr/internal/tasks"
+)
+
+// TaskCmd groups the subcommands that read and update a change's
+// tasks.md
+type TaskCmd struct {
+	List TaskListCmd `cmd:"" help:"List the tasks of a change"`
+	Done TaskDoneCmd `cmd:"" help:"Check off tasks"`
+	Undo TaskUndoCmd `cmd:"" help:"Uncheck tasks"`
+	Add  TaskAddCmd  `cmd:"" help:"Add a task to a section"`
+}
+
+// TaskListCmd lists the tasks of a change by section
+type TaskListCmd struct {
+	// ChangeID is the change whose tasks are listed
+	ChangeID string `arg:"" help:"Change ID"`
+	// Open lists only tasks that are not done
+	Open bool `name:"open" help:"Only list open tasks"`
+	// JSON enables JSON output format
+	JSON bool `name:"json" help:"Output as JSON"`
+}
+
+// TaskDoneCmd checks off tasks by ID
+type TaskDoneCmd struct {
+	// ChangeID is the change whose tasks are updated
+	ChangeID string `arg:"" help:"Change ID"`
+	// IDs are the task IDs to check off
+	IDs []string `arg:"" name:"task-id" help:"Task IDs, e.g. 1.3"`
+}
+
+// TaskUndoCmd unchecks tasks by ID
+type TaskUndoCmd struct {
+	// ChangeID is the change whose tasks are updated
+	ChangeID string `arg:"" help:"Change ID"`
+	// IDs are the task IDs to uncheck
+	IDs []string `arg:"" name:"task-id" help:"Task IDs, e.g. 1.3"`
+}
+
+// TaskAddCmd appends a task to a section
+type TaskAddCmd struct {
+	// ChangeID is the change the task is added to
+	ChangeID string `arg:"" help:"Change ID"`
+	// Text is the task description
+	Text string `arg:"" help:"Task description"`
+	// Section selects the section by number or title
+	Section string `name:"section" short:"s" help:"Section number or title (default: last section)"`
+}
+
+// Run executes the task list command
+func (c *TaskListCmd) Run() error {
+	tasksPath, err := changeTasksPath(c.ChangeID)
+	if err != nil {
+		return err
+	}
+
+	list, err := parsers.ParseTasksFile(tasksPath)
+	if err != nil {
+		return fmt.Errorf("read tasks: %w", err)
+	}
+
+	if c.JSON {
+		data, err := json.MarshalIndent(list, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(data))
+
+		return nil
+	}
+
+	for _, section := range list.Sections {
+		status := section.Status()
+		if c.Open && status.Completed == status.Total {
+			continue
+		}
+		if section.Line > 0 {
+			fmt.Printf("%s (%d/%d)\n", section.Heading(), status.Completed, status.Total)
+		}
+		printTasks(section.Tasks, 1, c.Open)
+	}
+
+	return nil
+}
+
+// printTasks prints tasks as checklist lines, indenting nested tasks
+func printTasks(list []*parsers.Task, depth int, openOnly bool) {
+	for _, task := range list {
+		if !openOnly || !task.Done {
+			mark := " "
+			if task.Done {
+				mark = "x"
+			}
+			text := task.Text
+			if task.ID != "" {
+				text = task.ID + " " + text
+			}
+			fmt.Printf("%s[%s] %s\n", strings.Repeat("  ", depth), mark, text)
+		}
+		printTasks(task.Children, depth+1, openOnly)
+	}
+}
+
+// Run executes the task done command
+func (c *TaskDoneCmd) Run() error {
+	return setTasksDone(c.ChangeID, c.IDs, true)
+}
+
+// Run executes the task undo command
+func (c *TaskUndoCmd) Run() error {
+	return setTasksDone(c.ChangeID, c.IDs, false)
+}
+
+// setTasksDone checks or unchecks the tasks with the given IDs. No task
+// is changed unless all IDs exist.
+func setTasksDone(changeID string, ids []string, done bool) error {
+	tasksPath, err := changeTasksPath(changeID)
+	if err != nil {
+		return err
+	}
+
+	var changed, unchanged []string
+	err = tasks.EditFile(tasksPath, func(content string) (string, error) {
+		for _, id := range ids {
+			updated, ok, err := tasks.SetDone(content, id, done)
+			if err != nil {
+				return "", err
+			}
+			if ok {
+				changed = append(changed, id)
+			} else {
+				unchanged = append(unchanged, id)
+			}
+			content = updated
+		}
+
+		return content, nil
+	})
+	if err != nil {
+		return fmt.Errorf("task update failed: %w", err)
+	}
+
+	verb, state := "Checked off", "done"
+	if !done {
+		verb, state = "Unchecked", "open"
+	}
+	if len(changed) > 0 {
+		fmt.Printf("✓ %s %s\n", verb, strings.Join(changed, ", "))
+	}
+	if len(unchanged) > 0 {
+		fmt.Printf("Already %s: %s\n", state, strings.Join(unchanged, ", "))
+	}
+
+	return nil
+}
+
+// Run executes the task add command
+func (c *TaskAddCmd) Run() error {
+	tasksPath, err := changeTasksPath(c.ChangeID)
+	if err != nil {
+		return err
+	}
+
+	var id string
+	err = tasks.EditFile(tasksPath, func(content string) (string, error) {
+		updated, newID, err := tasks.Add(content, c.Section, c.Text)
+		id = newID
+
+		return updated, err
+	})
+	if err != nil {
+		return fmt.Errorf("task add failed: %w", err)
+	}
+
+	if id == "" {
+		fmt.Printf("✓ Added task: %s\n", c.Text)
+	} else {
+		fmt.Printf("✓ Added task %s: %s\n", id, c.Text)
+	}
+
+	return nil
+}
+
+// changeTasksPath returns the path of the tasks.md of an active change
+func changeTasksPath(changeID string) (string, error) {
+	projectPath, err := discovery.ProjectRoot()
+	if err != nil {
+		return "", err
+	}
+
+	changeDir := filepath.Join(projectPath, "spectr", "changes", changeID)
+	if _, err := os.Stat(changeDir); os.IsNotExist(err) {
+		return "", fmt.Errorf("change not found: %s", changeID)
+	}
+
+	return filepath.Join(changeDir, "tasks.md"), nil
+}
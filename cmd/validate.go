@@ -4,8 +4,9 @@ package cmd
 import (
 	"errors"
 	"fmt"
-	"os"
 
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/git"
 	"github.com/connerohnesorge/spectr/internal/validation"
 )
 
@@ -19,18 +20,31 @@ type ValidateCmd struct {
 	Specs         bool    `name:"specs" help:"Validate specs"`
 	Type          *string `name:"type" enum:"change,spec" help:"Item type"`
 	NoInteractive bool    `name:"no-interactive" help:"No prompts"`
+	Fix           bool    `name:"fix" help:"Apply unambiguous suggestions"`
+	Workspace     bool    `name:"workspace" help:"Validate all spectr roots in the repository"`
+	Changed       bool    `name:"changed" help:"Validate only what changed according to git"`
+	Since         string  `name:"since" placeholder:"REF" help:"Compare with the merge base of REF instead of HEAD (implies --changed)"`
 }
 
+// maxFixPasses bounds how often --fix re-validates after applying fixes.
+// Pre-merge validation stops at the first unknown reference per delta
+// file, so each pass can uncover the next one.
+const maxFixPasses = 10
+
 // Run executes the validate command
 func (c *ValidateCmd) Run() error {
-	// Get current working directory
-	projectPath, err := os.Getwd()
+	// Resolve the project root
+	projectPath, err := discovery.ProjectRoot()
 	if err != nil {
-		return fmt.Errorf("failed to get current directory: %w", err)
+		return err
+	}
+
+	if c.Changed || c.Since != "" {
+		return c.runChangedValidation(projectPath)
 	}
 
 	// Check if bulk validation flags are set
-	if c.All || c.Changes || c.Specs {
+	if c.All || c.Changes || c.Specs || c.Workspace {
 		return c.runBulkValidation(projectPath)
 	}
 
@@ -59,16 +73,27 @@ func (c *ValidateCmd) runDirectValidation(
 		return err
 	}
 
-	// Create validator and validate
+	// Create validator and validate, applying fixes if requested
 	validator := validation.NewValidator(c.Strict)
-	report, err := validation.ValidateItemByType(
-		validator,
-		projectPath,
-		itemName,
-		info.ItemType,
-	)
-	if err != nil {
-		return fmt.Errorf("validation failed: %w", err)
+	var report *validation.ValidationReport
+	for range maxFixPasses {
+		report, err = validation.ValidateItemByType(
+			validator,
+			projectPath,
+			itemName,
+			info.ItemType,
+		)
+		if err != nil {
+			return fmt.Errorf("validation failed: %w", err)
+		}
+
+		fixed, err := c.applyFixes(report)
+		if err != nil {
+			return err
+		}
+		if !fixed {
+			break
+		}
 	}
 
 	// Print report
@@ -88,19 +113,48 @@ func (c *ValidateCmd) runDirectValidation(
 
 // runBulkValidation validates multiple items based on flags
 func (c *ValidateCmd) runBulkValidation(projectPath string) error {
-	validator := validation.NewValidator(c.Strict)
-
 	// Determine what to validate
 	items, err := c.getItemsToValidate(projectPath)
 	if err != nil {
 		return err
 	}
 
+	return c.validateItems(items)
+}
+
+// runChangedValidation validates the changes and specs with files that
+// git reports as changed, and the changes depending on them. Nothing is
+// loaded when no file below spectr/ changed.
+func (c *ValidateCmd) runChangedValidation(projectPath string) error {
+	files, err := git.ChangedFiles(projectPath, c.Since)
+	if err != nil {
+		return fmt.Errorf("find changed files: %w", err)
+	}
+
+	scope, err := validation.FindChangedScope(projectPath, files)
+	if err != nil {
+		return err
+	}
+	if scope.Empty() {
+		return c.handleNoItems()
+	}
+
+	items, err := validation.GetChangedItems(projectPath, scope)
+	if err != nil {
+		return err
+	}
+
+	return c.validateItems(items)
+}
+
+// validateItems validates items and prints the results
+func (c *ValidateCmd) validateItems(items []validation.ValidationItem) error {
 	if len(items) == 0 {
 		return c.handleNoItems()
 	}
 
 	// Validate all items
+	validator := validation.NewValidator(c.Strict)
 	results, hasFailures := c.validateAllItems(validator, items)
 
 	// Print results
@@ -117,20 +171,36 @@ func (c *ValidateCmd) runBulkValidation(projectPath string) error {
 	return nil
 }
 
-// getItemsToValidate returns the items to validate based on flags
+// getItemsToValidate returns the items to validate based on flags.
+// With --workspace, the items of every spectr root in the repository
+// are collected (all of them unless --changes or --specs is given).
 func (c *ValidateCmd) getItemsToValidate(
 	projectPath string,
 ) ([]validation.ValidationItem, error) {
+	var get func(string) ([]validation.ValidationItem, error)
 	switch {
 	case c.All:
-		return validation.GetAllItems(projectPath)
+		get = validation.GetAllItems
 	case c.Changes:
-		return validation.GetChangeItems(projectPath)
+		get = validation.GetChangeItems
 	case c.Specs:
-		return validation.GetSpecItems(projectPath)
+		get = validation.GetSpecItems
+	case c.Workspace:
+		get = validation.GetAllItems
 	default:
 		return nil, nil
 	}
+
+	if !c.Workspace {
+		return get(projectPath)
+	}
+
+	workspace, roots, err := findWorkspaceRoots(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return validation.GetWorkspaceItems(workspace, roots, get)
 }
 
 // handleNoItems handles the case when there are no items to validate
@@ -145,7 +215,7 @@ func (c *ValidateCmd) handleNoItems() error {
 }
 
 // validateAllItems validates all items and returns results
-func (*ValidateCmd) validateAllItems(
+func (c *ValidateCmd) validateAllItems(
 	validator *validation.Validator,
 	items []validation.ValidationItem,
 ) ([]validation.BulkResult, bool) {
@@ -153,7 +223,26 @@ func (*ValidateCmd) validateAllItems(
 	hasFailures := false
 
 	for _, item := range items {
-		result, err := validation.ValidateSingleItem(validator, item)
+		var result validation.BulkResult
+		var err error
+		for range maxFixPasses {
+			result, err = validation.ValidateSingleItem(validator, item)
+			if err != nil || result.Report == nil {
+				break
+			}
+
+			fixed, fixErr := c.applyFixes(result.Report)
+			if fixErr != nil {
+				result.Valid = false
+				result.Error = fixErr.Error()
+				err = fixErr
+
+				break
+			}
+			if !fixed {
+				break
+			}
+		}
 		results = append(results, result)
 
 		if err != nil || !result.Valid {
@@ -164,12 +253,43 @@ func (*ValidateCmd) validateAllItems(
 	return results, hasFailures
 }
 
+// applyFixes applies the unambiguous fixes in report when --fix is set
+// and reports whether any file changed
+func (c *ValidateCmd) applyFixes(
+	report *validation.ValidationReport,
+) (bool, error) {
+	if !c.Fix {
+		return false, nil
+	}
+
+	applied, err := validation.ApplyFixes(report.Issues)
+	if err != nil {
+		return false, fmt.Errorf("fix failed: %w", err)
+	}
+
+	if !c.JSON {
+		for _, issue := range applied {
+			fmt.Printf(
+				"✓ Fixed %s:%d: %q → %q\n",
+				issue.Path,
+				issue.Line,
+				issue.Fix.Old,
+				issue.Fix.New,
+			)
+		}
+	}
+
+	return len(applied) > 0, nil
+}
+
 // getUsageError returns the usage error message
 func getUsageError() error {
 	return errors.New(
 		"usage: spectr validate <item-name> [flags]\n" +
 			"       spectr validate --all\n" +
 			"       spectr validate --changes\n" +
-			"       spectr validate --specs",
+			"       spectr validate --specs\n" +
+			"       spectr validate --workspace\n" +
+			"       spectr validate --changed [--since <ref>]",
 	)
 }
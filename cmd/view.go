@@ -5,7 +5,10 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"time"
 
+	"github.com/connerohnesorge/spectr/internal/discovery"
 	"github.com/connerohnesorge/spectr/internal/view"
 )
 
@@ -31,6 +34,11 @@ type ViewCmd struct {
 	// When enabled, outputs structured data matching the schema defined
 	// in the view command design specification.
 	JSON bool `kong:"help='Output in JSON format for scripting'"`
+
+	// Change shows a single change with its tasks and the burndown of
+	// its task progress, derived from the git history of tasks.md,
+	// instead of the dashboard.
+	Change string `kong:"help='Show task burndown of a change'"`
 }
 
 // Run executes the view command.
@@ -39,13 +47,14 @@ type ViewCmd struct {
 // Returns an error if the spectr directory is missing or if
 // discovery/parsing fails.
 func (c *ViewCmd) Run() error {
-	// Get current working directory as the project path
-	projectPath, err := os.Getwd()
+	// Resolve the project root
+	projectPath, err := discovery.ProjectRoot()
 	if err != nil {
-		return fmt.Errorf(
-			"failed to get current directory: %w",
-			err,
-		)
+		return err
+	}
+
+	if c.Change != "" {
+		return c.runChange(projectPath)
 	}
 
 	// Collect dashboard data from the project
@@ -81,3 +90,31 @@ func (c *ViewCmd) Run() error {
 
 	return nil
 }
+
+// runChange prints the details and task burndown of a single change
+func (c *ViewCmd) runChange(projectPath string) error {
+	detail, err := view.CollectChange(projectPath, c.Change)
+	if err != nil {
+		return err
+	}
+
+	changeDir := filepath.Join(projectPath, "spectr", "changes", c.Change)
+	detail.Burndown, err = view.CollectBurndown(changeDir, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to collect task history: %w", err)
+	}
+
+	if !c.JSON {
+		fmt.Println(view.FormatChangeText(detail))
+
+		return nil
+	}
+
+	output, err := view.FormatChangeJSON(detail)
+	if err != nil {
+		return err
+	}
+	fmt.Println(output)
+
+	return nil
+}
@@ -272,7 +272,7 @@ func TestViewCmd_Integration_MissingSpectrDirectory(t *testing.T) {
 	if !strings.Contains(output, "0 specs, 0 requirements") {
 		t.Error("Expected output to show 0 specs and requirements")
 	}
-	if !strings.Contains(output, "Active Changes: 0 in progress") {
+	if !strings.Contains(output, "Active Changes: 0") {
 		t.Error("Expected output to show 0 active changes")
 	}
 	if !strings.Contains(output, "Spectr Dashboard") {
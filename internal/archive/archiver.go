@@ -23,34 +23,26 @@ import (
 	"strings"
 	"time"
 
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/graph"
+	"github.com/connerohnesorge/spectr/internal/lifecycle"
 	"github.com/connerohnesorge/spectr/internal/parsers"
 )
 
 // Archive archives a change by validating, applying specs, and moving to archive directory
 //
 // The workingDir parameter allows operating in a different working directory (e.g., for git worktree operations).
-// If workingDir is empty, the current working directory is used as the project root.
+// If workingDir is empty, the project root is resolved by discovery.ProjectRoot: the --root
+// flag, then SPECTR_ROOT, then the nearest root above the current working directory.
 //
 //nolint:revive // cmd.ChangeID field needs to be reassigned when empty
 func Archive(cmd *ArchiveCmd, workingDir string) error {
 	changeID := cmd.ChangeID
-	// Get project root based on workingDir parameter
-	var projectRoot string
-	var err error
-	if workingDir == "" {
-		projectRoot, err = os.Getwd()
-		if err != nil {
-			return fmt.Errorf("get working directory: %w", err)
-		}
-	} else {
-		projectRoot = workingDir
+	projectRoot, err := resolveProjectRoot(workingDir)
+	if err != nil {
+		return err
 	}
-
-	// Check if spectr directory exists
 	spectrRoot := filepath.Join(projectRoot, "spectr")
-	if _, err := os.Stat(spectrRoot); os.IsNotExist(err) {
-		return fmt.Errorf("spectr directory not found in %s", projectRoot)
-	}
 
 	// If no change ID provided, use interactive selection
 	if changeID == "" {
@@ -72,6 +64,16 @@ func Archive(cmd *ArchiveCmd, workingDir string) error {
 		return fmt.Errorf("change not found: %s", changeID)
 	}
 
+	// Dependencies must be archived first
+	if err := checkDependencies(projectRoot, changeID); err != nil {
+		return err
+	}
+
+	// The change must have been approved
+	if err := checkLifecycle(changeDir); err != nil {
+		return err
+	}
+
 	fmt.Printf("Archiving change: %s\n\n", changeID)
 
 	// Validation workflow
@@ -113,17 +115,25 @@ func Archive(cmd *ArchiveCmd, workingDir string) error {
 		return fmt.Errorf("move to archive failed: %w", err)
 	}
 
+	fmt.Printf("\nMoved to: changes/archive/%s\n", archiveName)
 	fmt.Printf("\n✓ Successfully archived: %s\n", changeID)
 
 	// PR creation workflow (only if --pr flag is set)
 	if cmd.PR {
 		ctx := PRContext{
-			ChangeID:     changeID,
-			ArchiveName:  archiveName,
-			SkipSpecs:    cmd.SkipSpecs,
-			OpCounts:     totalCounts,
-			Capabilities: capabilities,
-			SpectrRoot:   spectrRoot,
+			ChangeID:      changeID,
+			ArchiveName:   archiveName,
+			Archives:      []ArchivedChange{{changeID, archiveName}},
+			SkipSpecs:     cmd.SkipSpecs,
+			OpCounts:      totalCounts,
+			Capabilities:  capabilities,
+			SpectrRoot:    spectrRoot,
+			Remote:        cmd.Remote,
+			Base:          cmd.Base,
+			draftFlag:     cmd.Draft,
+			Labels:        cmd.Labels,
+			Reviewers:     cmd.Reviewers,
+			TitleTemplate: cmd.TitleTemplate,
 		}
 
 		if err := createPR(ctx); err != nil {
@@ -137,6 +147,27 @@ func Archive(cmd *ArchiveCmd, workingDir string) error {
 	return nil
 }
 
+// resolveProjectRoot returns workingDir, or else the project root from
+// discovery.ProjectRoot (--root, then SPECTR_ROOT, then the nearest root
+// above the current working directory), and checks that it has a
+// spectr directory
+func resolveProjectRoot(workingDir string) (string, error) {
+	projectRoot := workingDir
+	if projectRoot == "" {
+		var err error
+		projectRoot, err = discovery.ProjectRoot()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(projectRoot, "spectr")); os.IsNotExist(err) {
+		return "", fmt.Errorf("spectr directory not found in %s", projectRoot)
+	}
+
+	return projectRoot, nil
+}
+
 // selectChange prompts user to select a change interactively
 func selectChange(interactive bool, projectRoot, spectrRoot string) (string, error) {
 	// Use interactive table mode if enabled
@@ -217,6 +248,54 @@ func selectChangeBasic(spectrRoot string) (string, error) {
 	return changes[selection-1], nil
 }
 
+// checkLifecycle fails if the change has not been approved yet. Changes
+// without a status count as proposed.
+func checkLifecycle(changeDir string) error {
+	metadata, err := parsers.ParseProposalMetadata(
+		filepath.Join(changeDir, "proposal.md"),
+	)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read proposal: %w", err)
+	}
+
+	if err := lifecycle.CheckArchivable(metadata.Status); err != nil {
+		return fmt.Errorf(
+			"%w (run: spectr status %s approved)",
+			err,
+			filepath.Base(changeDir),
+		)
+	}
+
+	return nil
+}
+
+// checkDependencies fails if a change that changeID depends on is
+// still active
+func checkDependencies(projectRoot, changeID string) error {
+	g, err := graph.Load(projectRoot)
+	if err != nil {
+		return fmt.Errorf("load change dependencies: %w", err)
+	}
+
+	if change, ok := g.Changes[changeID]; ok && change.Err != nil {
+		return change.Err
+	}
+
+	pending := g.Pending(changeID)
+	if len(pending) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"change %s depends on %s, which must be archived first",
+		changeID,
+		strings.Join(pending, ", "),
+	)
+}
+
 // runValidation validates the change before archiving
 func runValidation(changeDir string) error {
 	fmt.Println("Validating change...")
@@ -318,6 +397,15 @@ func updateSpecsWithTracking(
 		return OperationCounts{}, nil, err
 	}
 
+	totalCounts.Links, err = rewriteRenamedLinks(
+		updates,
+		mergedSpecs,
+		filepath.Join(spectrRoot, "specs"),
+	)
+	if err != nil {
+		return OperationCounts{}, nil, err
+	}
+
 	if err := writeSpecs(mergedSpecs, workingDir); err != nil {
 		return OperationCounts{}, nil, err
 	}
@@ -327,8 +415,7 @@ func updateSpecsWithTracking(
 	// Extract capability names from update targets
 	capabilities := make([]string, 0, len(updates))
 	for _, update := range updates {
-		capability := filepath.Base(filepath.Dir(update.Target))
-		capabilities = append(capabilities, capability)
+		capabilities = append(capabilities, update.Capability)
 	}
 
 	return totalCounts, capabilities, nil
@@ -379,9 +466,10 @@ func buildUpdatePlan(
 		}
 
 		updates = append(updates, SpecUpdate{
-			Source: deltaPath,
-			Target: targetPath,
-			Exists: exists,
+			Source:     deltaPath,
+			Target:     targetPath,
+			Capability: filepath.ToSlash(capabilityDir),
+			Exists:     exists,
 		})
 	}
 
@@ -392,12 +480,11 @@ func buildUpdatePlan(
 func displayUpdatePlan(updates []SpecUpdate) {
 	fmt.Printf("\nSpec updates (%d):\n", len(updates))
 	for _, update := range updates {
-		capability := filepath.Base(filepath.Dir(update.Target))
 		status := "update"
 		if !update.Exists {
 			status = "create"
 		}
-		fmt.Printf("  [%s] %s\n", status, capability)
+		fmt.Printf("  [%s] %s\n", status, update.Capability)
 	}
 }
 
@@ -419,6 +506,7 @@ func processMerges(
 		totalCounts.Modified += counts.Modified
 		totalCounts.Removed += counts.Removed
 		totalCounts.Renamed += counts.Renamed
+		totalCounts.Scenarios += counts.Scenarios
 	}
 
 	return totalCounts, mergedSpecs, nil
@@ -513,6 +601,12 @@ func displaySummary(totalCounts OperationCounts) {
 	if totalCounts.Renamed > 0 {
 		fmt.Printf("  → %d renamed\n", totalCounts.Renamed)
 	}
+	if totalCounts.Scenarios > 0 {
+		fmt.Printf("  ± %d scenario(s) changed\n", totalCounts.Scenarios)
+	}
+	if totalCounts.Links > 0 {
+		fmt.Printf("  ↻ %d link(s) to renamed requirements updated\n", totalCounts.Links)
+	}
 	fmt.Printf("  = %d total\n", totalCounts.Total())
 }
 
@@ -562,8 +656,6 @@ func moveToArchive(
 		return "", fmt.Errorf("move to archive: %w", err)
 	}
 
-	fmt.Printf("\nMoved to: changes/archive/%s\n", archiveName)
-
 	return archiveName, nil
 }
 
@@ -0,0 +1,111 @@
+package archive
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBuildUpdatePlan_NestedCapabilities(t *testing.T) {
+	tmpDir := t.TempDir()
+	specsDir := filepath.Join(tmpDir, "spectr", "changes", "add-keys", "specs")
+	spectrRoot := filepath.Join(tmpDir, "spectr")
+
+	for _, capability := range []string{"auth", "api/auth", "api/billing/invoices"} {
+		dir := filepath.Join(specsDir, filepath.FromSlash(capability))
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "spec.md"), []byte("## ADDED Requirements\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// api/auth already exists in the main specs
+	existing := filepath.Join(spectrRoot, "specs", "api", "auth")
+	if err := os.MkdirAll(existing, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(existing, "spec.md"), []byte("# API Auth\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	deltaSpecs, err := findDeltaSpecs(specsDir)
+	if err != nil {
+		t.Fatalf("findDeltaSpecs failed: %v", err)
+	}
+
+	updates, err := buildUpdatePlan(deltaSpecs, specsDir, spectrRoot)
+	if err != nil {
+		t.Fatalf("buildUpdatePlan failed: %v", err)
+	}
+
+	want := map[string]bool{"auth": false, "api/auth": true, "api/billing/invoices": false}
+	if len(updates) != len(want) {
+		t.Fatalf("Expected %d updates, got %+v", len(want), updates)
+	}
+	for _, update := range updates {
+		exists, ok := want[update.Capability]
+		if !ok {
+			t.Errorf("Unexpected capability %q", update.Capability)
+
+			continue
+		}
+		if update.Exists != exists {
+			t.Errorf("Capability %q: expected exists=%v", update.Capability, exists)
+		}
+		wantTarget := filepath.Join(spectrRoot, "specs", filepath.FromSlash(update.Capability), "spec.md")
+		if update.Target != wantTarget {
+			t.Errorf("Capability %q: expected target %s, got %s", update.Capability, wantTarget, update.Target)
+		}
+	}
+}
+
+func TestCheckDependencies(t *testing.T) {
+	projectRoot := t.TempDir()
+	changesDir := filepath.Join(projectRoot, "spectr", "changes")
+	proposals := map[string]string{
+		"archive/2025-01-01-base/proposal.md": "# Change: Base\n",
+		"ready/proposal.md":                   "---\ndepends_on: [base]\n---\n# Change: Ready\n",
+		"blocked/proposal.md":                 "# Change: Blocked\n\n## Depends On\n- ready\n- base\n",
+	}
+	for path, content := range proposals {
+		path = filepath.Join(changesDir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := checkDependencies(projectRoot, "ready"); err != nil {
+		t.Errorf("Expected ready to be archivable, got %v", err)
+	}
+
+	err := checkDependencies(projectRoot, "blocked")
+	if err == nil || err.Error() != "change blocked depends on ready, which must be archived first" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestCheckLifecycle(t *testing.T) {
+	changeDir := t.TempDir()
+	if err := checkLifecycle(changeDir); err != nil {
+		t.Errorf("Expected a change without proposal to pass, got %v", err)
+	}
+
+	proposalPath := filepath.Join(changeDir, "proposal.md")
+	for content, ok := range map[string]bool{
+		"# Change: Test\n": false,
+		"---\nstatus: approved\n---\n# Change: Test\n": true,
+		"---\nstatus: draft\n---\n# Change: Test\n":    false,
+	} {
+		if err := os.WriteFile(proposalPath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := checkLifecycle(changeDir); (err == nil) != ok {
+			t.Errorf("checkLifecycle(%q) = %v, want ok=%v", content, err, ok)
+		}
+	}
+}
@@ -0,0 +1,352 @@
+package archive
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/fsutil"
+	"github.com/connerohnesorge/spectr/internal/graph"
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// batchResult is the outcome of archiving one change of a batch
+type batchResult struct {
+	ChangeID    string
+	ArchiveName string
+	Tasks       parsers.TaskStatus
+	Updates     []SpecUpdate
+	Counts      OperationCounts
+}
+
+// ArchiveBatch archives the changes named by cmd.ChangeIDs, or with
+// cmd.AllComplete every approved change whose tasks are all complete,
+// together.
+//
+// The changes are ordered by dependency and then by creation. They are
+// first archived in a scratch copy of the spectr directory, which
+// validates each change against the specs left by the ones before it.
+// The combined plan is confirmed once, then the changes are archived
+// in sequence. With cmd.PR a single pull request holds all archives.
+func ArchiveBatch(cmd *ArchiveCmd, workingDir string) error {
+	projectRoot, err := resolveProjectRoot(workingDir)
+	if err != nil {
+		return err
+	}
+	spectrRoot := filepath.Join(projectRoot, "spectr")
+
+	g, err := graph.Load(projectRoot)
+	if err != nil {
+		return fmt.Errorf("load change dependencies: %w", err)
+	}
+
+	ids, err := batchChangeIDs(cmd, g, spectrRoot)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		fmt.Println("No completed changes to archive")
+
+		return nil
+	}
+
+	ids, err = orderBatch(g, ids)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := checkLifecycle(filepath.Join(spectrRoot, "changes", id)); err != nil {
+			return fmt.Errorf("%s: %w", id, err)
+		}
+	}
+
+	fmt.Printf("Archiving %d changes: %s\n\n", len(ids), strings.Join(ids, " → "))
+
+	if cmd.NoValidate {
+		fmt.Println("⚠️  Skipping validation")
+	} else {
+		fmt.Println("Validating cumulative result...")
+	}
+	plan, err := dryRunBatch(projectRoot, ids, cmd)
+	if err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	if !cmd.NoValidate {
+		fmt.Println("✓ Validation passed")
+	}
+
+	displayBatchPlan(plan, cmd.SkipSpecs)
+	if !cmd.Yes && !confirm(fmt.Sprintf("\nArchive %d changes?", len(ids))) {
+		return errors.New("archive cancelled")
+	}
+
+	results, err := archiveSequence(projectRoot, ids, cmd)
+	if err != nil {
+		return err
+	}
+
+	ctx := PRContext{
+		ChangeID:      strings.Join(ids, ", "),
+		SkipSpecs:     cmd.SkipSpecs,
+		SpectrRoot:    spectrRoot,
+		Remote:        cmd.Remote,
+		Base:          cmd.Base,
+		draftFlag:     cmd.Draft,
+		Labels:        cmd.Labels,
+		Reviewers:     cmd.Reviewers,
+		TitleTemplate: cmd.TitleTemplate,
+	}
+	fmt.Println()
+	for _, result := range results {
+		fmt.Printf("Moved to: changes/archive/%s\n", result.ArchiveName)
+		ctx.Archives = append(ctx.Archives, ArchivedChange{result.ChangeID, result.ArchiveName})
+		ctx.OpCounts = ctx.OpCounts.plus(result.Counts)
+		for _, update := range result.Updates {
+			if !slices.Contains(ctx.Capabilities, update.Capability) {
+				ctx.Capabilities = append(ctx.Capabilities, update.Capability)
+			}
+		}
+	}
+	if len(results) == 1 {
+		ctx.ArchiveName = results[0].ArchiveName
+	}
+	if !cmd.SkipSpecs {
+		displaySummary(ctx.OpCounts)
+	}
+
+	fmt.Printf("\n✓ Successfully archived: %s\n", ctx.ChangeID)
+
+	if cmd.PR {
+		if err := createPR(ctx); err != nil {
+			// PR creation failure should not fail the entire archive
+			fmt.Printf("\n⚠️  PR creation failed: %v\n", err)
+		}
+	}
+
+	return nil
+}
+
+// batchChangeIDs returns the changes named on the command line, or with
+// --all-complete the active changes whose tasks are all complete. Changes
+// that are complete but not yet approved are skipped and reported.
+func batchChangeIDs(cmd *ArchiveCmd, g *graph.Graph, spectrRoot string) ([]string, error) {
+	if !cmd.AllComplete {
+		return cmd.ChangeIDs, nil
+	}
+	if len(cmd.ChangeIDs) > 0 {
+		return nil, errors.New("give either change IDs or --all-complete, not both")
+	}
+
+	var ids []string
+	for _, change := range g.Active() {
+		changeDir := filepath.Join(spectrRoot, "changes", change.ID)
+		status, _ := parsers.CountTasks(filepath.Join(changeDir, "tasks.md"))
+		if status.Total == 0 || status.Completed < status.Total {
+			continue
+		}
+		if err := checkLifecycle(changeDir); err != nil {
+			fmt.Printf("Skipping %s: %v\n", change.ID, err)
+
+			continue
+		}
+		ids = append(ids, change.ID)
+	}
+
+	return ids, nil
+}
+
+// orderBatch checks that the batch can be archived on its own and
+// returns it in archive order. A dependency of a change must be
+// archived already or be part of the batch.
+func orderBatch(g *graph.Graph, ids []string) ([]string, error) {
+	inBatch := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		change, ok := g.Changes[id]
+		if !ok || change.Archived {
+			return nil, fmt.Errorf("change not found: %s", id)
+		}
+		if inBatch[id] {
+			return nil, fmt.Errorf("change %s is listed twice", id)
+		}
+		if change.Err != nil {
+			return nil, change.Err
+		}
+		inBatch[id] = true
+	}
+
+	for _, id := range ids {
+		var outside []string
+		for _, dep := range g.Pending(id) {
+			if !inBatch[dep] {
+				outside = append(outside, dep)
+			}
+		}
+		if len(outside) > 0 {
+			return nil, fmt.Errorf(
+				"change %s depends on %s, which must be archived first or in the same batch",
+				id,
+				strings.Join(outside, ", "),
+			)
+		}
+	}
+
+	return g.ArchiveOrder(ids)
+}
+
+// dryRunBatch archives the batch in a scratch copy of the spectr
+// directory and returns the plan it followed
+func dryRunBatch(projectRoot string, ids []string, cmd *ArchiveCmd) ([]batchResult, error) {
+	scratch, err := os.MkdirTemp("", "spectr-archive-batch-")
+	if err != nil {
+		return nil, fmt.Errorf("create scratch directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(scratch) }()
+
+	err = fsutil.CopyDir(
+		filepath.Join(projectRoot, "spectr"),
+		filepath.Join(scratch, "spectr"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("copy spectr directory: %w", err)
+	}
+
+	return archiveSequence(scratch, ids, cmd)
+}
+
+// archiveSequence validates, merges, and archives the changes of ids
+// one after the other below projectRoot, without asking. Each change is
+// validated against the specs merged by the changes before it.
+func archiveSequence(projectRoot string, ids []string, cmd *ArchiveCmd) ([]batchResult, error) {
+	spectrRoot := filepath.Join(projectRoot, "spectr")
+	results := make([]batchResult, 0, len(ids))
+
+	for _, id := range ids {
+		changeDir := filepath.Join(spectrRoot, "changes", id)
+		result := batchResult{ChangeID: id}
+
+		if !cmd.NoValidate {
+			if err := validateBatchChange(projectRoot, changeDir, id); err != nil {
+				return nil, err
+			}
+		}
+
+		result.Tasks, _ = parsers.CountTasks(filepath.Join(changeDir, "tasks.md"))
+
+		if !cmd.SkipSpecs {
+			updates, counts, err := mergeChangeSpecs(changeDir, projectRoot)
+			if err != nil {
+				return nil, fmt.Errorf("%s: spec update failed: %w", id, err)
+			}
+			result.Updates = updates
+			result.Counts = counts
+		}
+
+		archiveName, err := moveToArchive(changeDir, id, projectRoot)
+		if err != nil {
+			return nil, fmt.Errorf("%s: move to archive failed: %w", id, err)
+		}
+		result.ArchiveName = archiveName
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// validateBatchChange validates a change of a batch, printing its
+// issues relative to projectRoot when it is invalid
+func validateBatchChange(projectRoot, changeDir, id string) error {
+	report, err := ValidatePreArchive(changeDir, true)
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, err)
+	}
+	if report.Valid {
+		return nil
+	}
+
+	fmt.Printf("❌ %s: %d error(s), %d warning(s)\n",
+		id, report.Summary.Errors, report.Summary.Warnings)
+	for _, issue := range report.Issues {
+		path := issue.Path
+		if rel, err := filepath.Rel(projectRoot, path); err == nil {
+			path = rel
+		}
+		fmt.Printf("  [%s] %s: %s\n", issue.Level, path, issue.Message)
+	}
+
+	return fmt.Errorf("change %s has validation errors", id)
+}
+
+// mergeChangeSpecs merges the delta specs of the change in changeDir
+// into the specs below projectRoot
+func mergeChangeSpecs(changeDir, projectRoot string) ([]SpecUpdate, OperationCounts, error) {
+	specsDir := filepath.Join(changeDir, "specs")
+	if _, err := os.Stat(specsDir); os.IsNotExist(err) {
+		return nil, OperationCounts{}, nil
+	}
+	deltaSpecs, err := findDeltaSpecs(specsDir)
+	if err != nil {
+		return nil, OperationCounts{}, fmt.Errorf("find delta specs: %w", err)
+	}
+	if len(deltaSpecs) == 0 {
+		return nil, OperationCounts{}, nil
+	}
+
+	spectrRoot := filepath.Join(projectRoot, "spectr")
+	updates, err := buildUpdatePlan(deltaSpecs, specsDir, spectrRoot)
+	if err != nil {
+		return nil, OperationCounts{}, err
+	}
+
+	counts, mergedSpecs, err := processMerges(updates)
+	if err != nil {
+		return nil, OperationCounts{}, err
+	}
+	counts.Links, err = rewriteRenamedLinks(
+		updates,
+		mergedSpecs,
+		filepath.Join(spectrRoot, "specs"),
+	)
+	if err != nil {
+		return nil, OperationCounts{}, err
+	}
+	if err := writeSpecs(mergedSpecs, projectRoot); err != nil {
+		return nil, OperationCounts{}, err
+	}
+
+	return updates, counts, nil
+}
+
+// displayBatchPlan prints the combined plan of a batch: the tasks and
+// spec updates of each change, in archive order
+func displayBatchPlan(plan []batchResult, skipSpecs bool) {
+	fmt.Printf("\nArchive plan (%d changes):\n", len(plan))
+	for i, result := range plan {
+		fmt.Printf("\n%d. %s", i+1, result.ChangeID)
+		if result.Tasks.Total > 0 {
+			fmt.Printf(" (tasks %d/%d", result.Tasks.Completed, result.Tasks.Total)
+			if incomplete := result.Tasks.Total - result.Tasks.Completed; incomplete > 0 {
+				fmt.Printf(", %d incomplete", incomplete)
+			}
+			fmt.Print(")")
+		}
+		fmt.Println()
+
+		switch {
+		case skipSpecs:
+			fmt.Println("   spec updates skipped")
+		case len(result.Updates) == 0:
+			fmt.Println("   no spec deltas")
+		}
+		for _, update := range result.Updates {
+			status := "update"
+			if !update.Exists {
+				status = "create"
+			}
+			fmt.Printf("   [%s] %s\n", status, update.Capability)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package archive
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeProject writes files below a new project root; paths are
+// relative to its spectr directory
+func writeProject(t *testing.T, files map[string]string) string {
+	t.Helper()
+	projectRoot := t.TempDir()
+	for path, content := range files {
+		path = filepath.Join(projectRoot, "spectr", filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return projectRoot
+}
+
+const batchBaseSpec = `# Auth Specification
+
+## Purpose
+Authentication for the users of the application and its services.
+
+## Requirements
+
+### Requirement: Password Login
+The system SHALL let users log in with a password.
+
+#### Scenario: Valid password
+- **WHEN** a user submits a valid password
+- **THEN** they are logged in
+`
+
+// batchProject has the approved changes add-sso and tune-sso, tune-sso
+// modifying the requirement add-sso adds, and change wip without a
+// status
+func batchProject(t *testing.T) string {
+	t.Helper()
+
+	return writeProject(t, map[string]string{
+		"specs/auth/spec.md":          batchBaseSpec,
+		"changes/add-sso/proposal.md": "---\nstatus: approved\ncreated: 2025-02-01\n---\n# Change: Add SSO\n",
+		"changes/add-sso/tasks.md":    "## 1. Work\n- [x] 1.1 Add SSO\n",
+		"changes/add-sso/specs/auth/spec.md": `## ADDED Requirements
+
+### Requirement: SSO Login
+The system SHALL let users log in through SSO.
+
+#### Scenario: SSO success
+- **WHEN** a user completes SSO
+- **THEN** they are logged in
+`,
+		"changes/tune-sso/proposal.md": "---\nstatus: approved\ncreated: 2025-01-01\ndepends_on: [add-sso]\n---\n# Change: Tune SSO\n",
+		"changes/tune-sso/tasks.md":    "## 1. Work\n- [x] 1.1 Tune SSO\n",
+		"changes/tune-sso/specs/auth/spec.md": `## MODIFIED Requirements
+
+### Requirement: SSO Login
+The system SHALL let users log in through SSO or a passkey.
+
+#### Scenario: SSO success
+- **WHEN** a user completes SSO
+- **THEN** they are logged in
+`,
+		"changes/wip/proposal.md": "# Change: Work in progress\n",
+		"changes/wip/tasks.md":    "## 1. Work\n- [ ] 1.1 Start\n",
+	})
+}
+
+func TestArchiveBatch(t *testing.T) {
+	projectRoot := batchProject(t)
+
+	// tune-sso is older but depends on add-sso
+	cmd := &ArchiveCmd{ChangeIDs: []string{"tune-sso", "add-sso"}, Yes: true}
+	if err := ArchiveBatch(cmd, projectRoot); err != nil {
+		t.Fatalf("ArchiveBatch failed: %v", err)
+	}
+
+	spec, err := os.ReadFile(filepath.Join(projectRoot, "spectr", "specs", "auth", "spec.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(spec), "through SSO or a passkey") {
+		t.Errorf("Expected the modified SSO requirement:\n%s", spec)
+	}
+
+	changesDir := filepath.Join(projectRoot, "spectr", "changes")
+	for _, id := range []string{"add-sso", "tune-sso"} {
+		if _, err := os.Stat(filepath.Join(changesDir, id)); !os.IsNotExist(err) {
+			t.Errorf("Expected %s to be archived", id)
+		}
+	}
+	entries, err := os.ReadDir(filepath.Join(changesDir, "archive"))
+	if err != nil || len(entries) != 2 {
+		t.Errorf("Expected 2 archives, got %v, %v", entries, err)
+	}
+}
+
+func TestArchiveBatch_AllComplete(t *testing.T) {
+	projectRoot := batchProject(t)
+
+	cmd := &ArchiveCmd{AllComplete: true, Yes: true}
+	if err := ArchiveBatch(cmd, projectRoot); err != nil {
+		t.Fatalf("ArchiveBatch failed: %v", err)
+	}
+
+	changesDir := filepath.Join(projectRoot, "spectr", "changes")
+	if _, err := os.Stat(filepath.Join(changesDir, "wip")); err != nil {
+		t.Errorf("Expected the incomplete change to stay active: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(changesDir, "add-sso")); !os.IsNotExist(err) {
+		t.Error("Expected add-sso to be archived")
+	}
+
+	cmd = &ArchiveCmd{AllComplete: true, ChangeIDs: []string{"wip"}, Yes: true}
+	if err := ArchiveBatch(cmd, projectRoot); err == nil {
+		t.Error("Expected an error for change IDs with --all-complete")
+	}
+}
+
+func TestArchiveBatch_AllCompleteSkipsUnapproved(t *testing.T) {
+	projectRoot := batchProject(t)
+	changesDir := filepath.Join(projectRoot, "spectr", "changes")
+
+	// Complete changes that are draft or have no status are not approved
+	unapproved := map[string]string{
+		"draft":  "---\nstatus: draft\n---\n# Change: Draft\n",
+		"legacy": "# Change: Legacy\n",
+	}
+	for id, proposal := range unapproved {
+		dir := filepath.Join(changesDir, id)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "proposal.md"), []byte(proposal), 0644); err != nil {
+			t.Fatal(err)
+		}
+		tasks := "## 1. Work\n- [x] 1.1 Plan\n"
+		if err := os.WriteFile(filepath.Join(dir, "tasks.md"), []byte(tasks), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := &ArchiveCmd{AllComplete: true, Yes: true}
+	if err := ArchiveBatch(cmd, projectRoot); err != nil {
+		t.Fatalf("ArchiveBatch failed: %v", err)
+	}
+
+	for id := range unapproved {
+		if _, err := os.Stat(filepath.Join(changesDir, id)); err != nil {
+			t.Errorf("Expected the unapproved change %s to stay active: %v", id, err)
+		}
+	}
+	for _, id := range []string{"add-sso", "tune-sso"} {
+		if _, err := os.Stat(filepath.Join(changesDir, id)); !os.IsNotExist(err) {
+			t.Errorf("Expected %s to be archived", id)
+		}
+	}
+}
+
+func TestArchive_RequiresApproval(t *testing.T) {
+	projectRoot := batchProject(t)
+
+	// wip has no status, so it is proposed
+	err := Archive(&ArchiveCmd{ChangeID: "wip", Yes: true}, projectRoot)
+	if err == nil || !strings.Contains(err.Error(), "must be approved") {
+		t.Errorf("Expected archiving a change without status to fail, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(projectRoot, "spectr", "changes", "wip")); err != nil {
+		t.Errorf("Expected wip to stay active: %v", err)
+	}
+
+	cmd := &ArchiveCmd{ChangeIDs: []string{"add-sso", "wip"}, Yes: true}
+	if err := ArchiveBatch(cmd, projectRoot); err == nil {
+		t.Error("Expected a batch with an unapproved change to fail")
+	}
+}
+
+func TestArchiveBatch_InvalidResultLeavesTree(t *testing.T) {
+	projectRoot := batchProject(t)
+
+	// Without add-sso, tune-sso modifies a requirement that does not exist
+	cmd := &ArchiveCmd{ChangeIDs: []string{"tune-sso", "wip"}, Yes: true}
+	err := ArchiveBatch(cmd, projectRoot)
+	if err == nil || !strings.Contains(err.Error(), "add-sso, which must be archived first") {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	// Both changes add the same requirement, which only fails once the
+	// first is merged
+	dup := filepath.Join(projectRoot, "spectr", "changes", "dup-sso")
+	if err := os.MkdirAll(filepath.Join(dup, "specs", "auth"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	delta, err := os.ReadFile(filepath.Join(projectRoot, "spectr", "changes", "add-sso", "specs", "auth", "spec.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dup, "specs", "auth", "spec.md"), delta, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dup, "proposal.md"), []byte("# Change: Dup\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd = &ArchiveCmd{ChangeIDs: []string{"add-sso", "dup-sso"}, Yes: true}
+	if err := ArchiveBatch(cmd, projectRoot); err == nil {
+		t.Fatal("Expected the cumulative validation to fail")
+	}
+
+	spec, err := os.ReadFile(filepath.Join(projectRoot, "spectr", "specs", "auth", "spec.md"))
+	if err != nil || string(spec) != batchBaseSpec {
+		t.Errorf("Expected specs to be untouched, got %v:\n%s", err, spec)
+	}
+	if _, err := os.Stat(filepath.Join(projectRoot, "spectr", "changes", "add-sso")); err != nil {
+		t.Errorf("Expected add-sso to stay active: %v", err)
+	}
+}
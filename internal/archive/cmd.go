@@ -6,18 +6,36 @@ import "fmt"
 
 // ArchiveCmd represents the archive command configuration
 type ArchiveCmd struct {
-	ChangeID    string `arg:"" optional:"" help:"Change ID to archive"`
-	Yes         bool   `name:"yes" short:"y" help:"Skip confirmation"`
-	SkipSpecs   bool   `name:"skip-specs" help:"Skip spec updates"`
-	NoValidate  bool   `name:"no-validate" help:"Skip validation"`
-	Interactive bool   `short:"I" name:"interactive" help:"Interactive mode"`
-	PR          bool   `name:"pr" help:"Create PR after archive"`
+	// ChangeID is the change archived by Archive; Run sets it from a
+	// single ChangeIDs entry
+	ChangeID      string   `kong:"-"`
+	ChangeIDs     []string `arg:"" optional:"" name:"change-id" help:"Change IDs to archive; several are archived together"`
+	AllComplete   bool     `name:"all-complete" help:"Archive every approved change whose tasks are all complete"`
+	Yes           bool     `name:"yes" short:"y" help:"Skip confirmation"`
+	SkipSpecs     bool     `name:"skip-specs" help:"Skip spec updates"`
+	NoValidate    bool     `name:"no-validate" help:"Skip validation"`
+	Interactive   bool     `short:"I" name:"interactive" help:"Interactive mode"`
+	PR            bool     `name:"pr" help:"Create PR after archive"`
+	Remote        string   `name:"remote" help:"Git remote for the PR (default: origin)"`
+	Base          string   `name:"base" help:"Target branch of the PR (default: default branch)"`
+	Draft         *bool    `name:"draft" negatable:"" help:"Open the PR as a draft (--no-draft overrides the config)"`
+	Labels        []string `name:"label" help:"Label to add to the PR (repeatable)"`
+	Reviewers     []string `name:"reviewer" help:"Reviewer to request on the PR (repeatable)"`
+	TitleTemplate string   `name:"title-template" help:"Go template of the PR title, e.g. '{{.ChangeID}}: archive'"`
 }
 
 // Run executes the archive command
 func (c *ArchiveCmd) Run() error {
 	// Pass empty string to use current working directory
-	err := Archive(c, "")
+	var err error
+	if c.AllComplete || len(c.ChangeIDs) > 1 {
+		err = ArchiveBatch(c, "")
+	} else {
+		if len(c.ChangeIDs) == 1 {
+			c.ChangeID = c.ChangeIDs[0]
+		}
+		err = Archive(c, "")
+	}
 	if err != nil {
 		return fmt.Errorf("archive failed: %w", err)
 	}
@@ -0,0 +1,86 @@
+package archive
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// capabilityRename is a RENAMED delta of a capability
+type capabilityRename struct {
+	capability string
+	op         parsers.RenameOp
+}
+
+// rewriteRenamedLinks points requirement links to names renamed by the
+// updates at the new names. Both the merged specs and every other spec
+// below specsDir are rewritten; specs that change are added to
+// mergedSpecs. It returns the number of links rewritten.
+func rewriteRenamedLinks(
+	updates []SpecUpdate,
+	mergedSpecs map[string]string,
+	specsDir string,
+) (int, error) {
+	var renames []capabilityRename
+	for _, update := range updates {
+		deltaPlan, err := parsers.ParseDeltaSpec(update.Source)
+		if err != nil {
+			return 0, fmt.Errorf("parse delta spec %s: %w", update.Source, err)
+		}
+		for _, op := range deltaPlan.Renamed {
+			renames = append(renames, capabilityRename{update.Capability, op})
+		}
+	}
+	if len(renames) == 0 {
+		return 0, nil
+	}
+
+	paths := make(map[string]bool, len(mergedSpecs))
+	for path := range mergedSpecs {
+		paths[path] = true
+	}
+	err := filepath.WalkDir(specsDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && d.Name() == "spec.md" {
+			paths[path] = true
+		}
+
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return 0, fmt.Errorf("walk specs: %w", err)
+	}
+
+	total := 0
+	for path := range paths {
+		content, merged := mergedSpecs[path]
+		if !merged {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return 0, fmt.Errorf("read spec %s: %w", path, err)
+			}
+			content = string(data)
+		}
+
+		self := discovery.CapabilityID(specsDir, filepath.Dir(path))
+		rewritten := 0
+		for _, rename := range renames {
+			var n int
+			content, n = parsers.RewriteRequirementLinks(
+				content, self, rename.capability, rename.op.From, rename.op.To,
+			)
+			rewritten += n
+		}
+		if rewritten > 0 {
+			mergedSpecs[path] = content
+			total += rewritten
+		}
+	}
+
+	return total, nil
+}
@@ -0,0 +1,55 @@
+package archive
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRewriteRenamedLinks(t *testing.T) {
+	tmpDir := t.TempDir()
+	specsDir := filepath.Join(tmpDir, "spectr", "specs")
+	delta := filepath.Join(tmpDir, "spectr", "changes", "rename", "specs", "auth", "spec.md")
+
+	files := map[string]string{
+		delta: "## RENAMED Requirements\n" +
+			"- FROM: `### Requirement: User Login`\n" +
+			"- TO: `### Requirement: Sign In`\n",
+		filepath.Join(specsDir, "billing", "spec.md"):     "Needs [[auth#User Login]].\n",
+		filepath.Join(specsDir, "api", "keys", "spec.md"): "Unrelated [[auth#User Logout]].\n",
+	}
+	for path, content := range files {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	authSpec := filepath.Join(specsDir, "auth", "spec.md")
+	mergedSpecs := map[string]string{
+		authSpec: "### Requirement: Sign In\nSee [[#User Login]].\n",
+	}
+	updates := []SpecUpdate{{Source: delta, Target: authSpec, Capability: "auth"}}
+
+	n, err := rewriteRenamedLinks(updates, mergedSpecs, specsDir)
+	if err != nil {
+		t.Fatalf("rewriteRenamedLinks failed: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("Expected 2 links rewritten, got %d", n)
+	}
+
+	if !strings.Contains(mergedSpecs[authSpec], "[[#Sign In]]") {
+		t.Errorf("Merged spec not rewritten: %q", mergedSpecs[authSpec])
+	}
+	billing := mergedSpecs[filepath.Join(specsDir, "billing", "spec.md")]
+	if billing != "Needs [[auth#Sign In]].\n" {
+		t.Errorf("Inbound link not rewritten: %q", billing)
+	}
+	if _, ok := mergedSpecs[filepath.Join(specsDir, "api", "keys", "spec.md")]; ok {
+		t.Error("Spec without affected links should not be rewritten")
+	}
+}
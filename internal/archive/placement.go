@@ -0,0 +1,234 @@
+package archive
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+var requirementHeaderPattern = regexp.MustCompile(`^###\s+Requirement:`)
+
+// requirementSegment is a piece of the Requirements section: either a
+// requirement block, identified by its position among the section's
+// requirements, or free text such as a "###" grouping heading and the
+// prose under it
+type requirementSegment struct {
+	text string
+	// requirement is the position of the requirement, or -1 for text
+	requirement int
+	// group is the grouping heading that starts a text segment, if any
+	group string
+}
+
+// splitRequirementSegments splits the Requirements section into
+// requirement blocks and the text around them
+func splitRequirementSegments(section string) []requirementSegment {
+	var segments []requirementSegment
+	current := requirementSegment{requirement: -1}
+	count := 0
+
+	for _, line := range strings.Split(section, newlineChar) {
+		switch {
+		case requirementHeaderPattern.MatchString(line):
+			segments = append(segments, current)
+			current = requirementSegment{requirement: count}
+			count++
+		case parsers.IsRequirementGroupHeading(line):
+			segments = append(segments, current)
+			current = requirementSegment{
+				requirement: -1,
+				group: strings.TrimSpace(
+					strings.TrimPrefix(strings.TrimSpace(line), "###"),
+				),
+			}
+		}
+		current.text += line + newlineChar
+	}
+
+	return append(segments, current)
+}
+
+// countRequirementHeaders counts requirement headers in content
+func countRequirementHeaders(content string) int {
+	count := 0
+	for _, line := range strings.Split(content, newlineChar) {
+		if requirementHeaderPattern.MatchString(line) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// sectionLayout decides where ADDED requirements go. Requirements are
+// anchored before or after an existing requirement, before or after
+// another ADDED requirement, at the end of a group, or at the end of the
+// Requirements section.
+type sectionLayout struct {
+	index       *parsers.RequirementIndex
+	beforeBase  map[int][]parsers.RequirementBlock
+	afterBase   map[int][]parsers.RequirementBlock
+	beforeAdded map[string][]parsers.RequirementBlock
+	afterAdded  map[string][]parsers.RequirementBlock
+	groupEnd    map[string][]parsers.RequirementBlock
+	atEnd       []parsers.RequirementBlock
+	added       []parsers.RequirementBlock
+
+	pieces  []string
+	emitted map[string]bool
+}
+
+// newSectionLayout resolves the placement of each added requirement
+func newSectionLayout(
+	index *parsers.RequirementIndex,
+	added []parsers.RequirementBlock,
+	placements map[string]parsers.Placement,
+	groups []string,
+) *sectionLayout {
+	layout := &sectionLayout{
+		index:       index,
+		added:       added,
+		beforeBase:  make(map[int][]parsers.RequirementBlock),
+		afterBase:   make(map[int][]parsers.RequirementBlock),
+		beforeAdded: make(map[string][]parsers.RequirementBlock),
+		afterAdded:  make(map[string][]parsers.RequirementBlock),
+		groupEnd:    make(map[string][]parsers.RequirementBlock),
+		emitted:     make(map[string]bool),
+	}
+
+	addedNames := make(map[string]bool, len(added))
+	for _, req := range added {
+		addedNames[parsers.NormalizeRequirementName(req.Name)] = true
+	}
+	groupNames := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		groupNames[parsers.NormalizeRequirementName(group)] = true
+	}
+
+	for _, req := range added {
+		placement := placements[req.Name]
+		target := placement.Target()
+		normalized := parsers.NormalizeRequirementName(target)
+
+		switch {
+		case placement.Group != "" && groupNames[normalized]:
+			layout.groupEnd[normalized] = append(layout.groupEnd[normalized], req)
+		case placement.After != "" || placement.Before != "":
+			before := placement.Before != ""
+			if i, ok := layout.findBase(target); ok {
+				if before {
+					layout.beforeBase[i] = append(layout.beforeBase[i], req)
+				} else {
+					layout.afterBase[i] = append(layout.afterBase[i], req)
+				}
+			} else if addedNames[normalized] &&
+				normalized != parsers.NormalizeRequirementName(req.Name) {
+				if before {
+					layout.beforeAdded[normalized] = append(layout.beforeAdded[normalized], req)
+				} else {
+					layout.afterAdded[normalized] = append(layout.afterAdded[normalized], req)
+				}
+			} else {
+				layout.atEnd = append(layout.atEnd, req)
+			}
+		default:
+			layout.atEnd = append(layout.atEnd, req)
+		}
+	}
+
+	return layout
+}
+
+// findBase looks up a placement target by stable ID, then by name,
+// ignoring removed requirements
+func (l *sectionLayout) findBase(target string) (int, bool) {
+	i, ok := l.index.Find(target, "")
+	if !ok {
+		i, ok = l.index.Find("", target)
+	}
+	if !ok || l.index.IsRemoved(i) {
+		return 0, false
+	}
+
+	return i, true
+}
+
+// render lays out the Requirements section. offset is the index
+// position of the section's first requirement.
+func (l *sectionLayout) render(segments []requirementSegment, offset int) string {
+	covered := make(map[int]bool)
+	group := ""
+	// end is the index position after the section's last requirement
+	end := offset
+
+	for _, segment := range segments {
+		if segment.requirement >= 0 {
+			end = max(end, segment.requirement+offset+1)
+		}
+		if segment.requirement < 0 {
+			if segment.group != "" {
+				l.emitAll(l.groupEnd[group])
+				group = parsers.NormalizeRequirementName(segment.group)
+			}
+			l.write(segment.text)
+
+			continue
+		}
+
+		i := segment.requirement + offset
+		if i >= l.index.Len() {
+			l.write(segment.text)
+
+			continue
+		}
+		covered[i] = true
+
+		l.emitAll(l.beforeBase[i])
+		if !l.index.IsRemoved(i) {
+			l.write(l.index.Get(i).Raw)
+		}
+		l.emitAll(l.afterBase[i])
+	}
+	l.emitAll(l.groupEnd[group])
+
+	// Requirements of the section not laid out above are kept at its
+	// end, as are additions whose anchor could not be found. Requirements
+	// outside the section stay where they are, in the text around it.
+	for i := offset; i < min(end, l.index.Len()); i++ {
+		if !covered[i] && !l.index.IsRemoved(i) {
+			l.write(l.index.Get(i).Raw)
+		}
+	}
+	l.emitAll(l.atEnd)
+	// Additions anchored to each other in a cycle
+	l.emitAll(l.added)
+
+	if len(l.pieces) == 0 {
+		return ""
+	}
+
+	return strings.Join(l.pieces, newlineChar+newlineChar) + newlineChar
+}
+
+// emitAll writes added requirements along with those anchored to them
+func (l *sectionLayout) emitAll(reqs []parsers.RequirementBlock) {
+	for _, req := range reqs {
+		normalized := parsers.NormalizeRequirementName(req.Name)
+		if l.emitted[normalized] {
+			continue
+		}
+		l.emitted[normalized] = true
+
+		l.emitAll(l.beforeAdded[normalized])
+		l.write(req.Raw)
+		l.emitAll(l.afterAdded[normalized])
+	}
+}
+
+// write appends a non-blank piece of the section
+func (l *sectionLayout) write(text string) {
+	if trimmed := strings.Trim(text, newlineChar); strings.TrimSpace(trimmed) != "" {
+		l.pieces = append(l.pieces, trimmed)
+	}
+}
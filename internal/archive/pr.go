@@ -3,39 +3,99 @@ package archive
 import (
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
-	"strings"
 
+	"github.com/connerohnesorge/spectr/internal/config"
 	"github.com/connerohnesorge/spectr/internal/git"
 )
 
-// PRContext holds the context needed for PR creation
+// PRContext holds the context needed for PR creation. Its fields are
+// also the data of the title, commit message, and PR body templates.
 type PRContext struct {
-	ChangeID     string
-	ArchiveName  string
+	// ChangeID is the archived change; a batch lists its changes,
+	// separated by commas
+	ChangeID string
+	// ArchiveName is the archive directory of a single change
+	ArchiveName string
+	// Archives lists the archived changes in archive order
+	Archives     []ArchivedChange
 	SkipSpecs    bool
 	OpCounts     OperationCounts
 	Capabilities []string
 	SpectrRoot   string
+	// Remote is the git remote named by --remote; empty uses the
+	// configured remote or origin
+	Remote string
+	// Base is the target branch; empty means the default branch
+	Base string
+	// Draft opens the pull request as a draft; it is resolved from
+	// draftFlag and the config
+	Draft bool
+	// draftFlag is --draft or --no-draft, nil when neither was given
+	draftFlag *bool
+	Labels    []string
+	Reviewers []string
+	// TitleTemplate is a Go template of the PR title
+	TitleTemplate string
+	// Branch is the branch holding the archive commit
+	Branch string
+	// SpecDiff is the unified diff of the merged specs
+	SpecDiff string
+}
+
+// ArchivedChange is a change archived into changes/archive/ArchiveName
+type ArchivedChange struct {
+	ChangeID    string
+	ArchiveName string
+}
+
+// applyPRDefaults fills the PR options not set by flags from the config
+func applyPRDefaults(ctx *PRContext, defaults config.PR) {
+	defaults.Apply(&ctx.Base, &ctx.Labels, &ctx.Reviewers)
+	ctx.Draft = defaults.IsDraft(ctx.draftFlag)
+	if ctx.TitleTemplate == "" {
+		ctx.TitleTemplate = defaults.TitleTemplate
+	}
 }
 
 // createPR orchestrates the PR creation workflow after successful archive
 func createPR(ctx PRContext) error {
 	fmt.Println("\nCreating pull request...")
 
-	platform, err := validateAndDetectPlatform()
+	cfg, err := config.Load(filepath.Dir(ctx.SpectrRoot))
 	if err != nil {
-		return err
+		msg := "%w. Archive completed successfully. Create PR manually"
+
+		return fmt.Errorf(msg, err)
+	}
+	applyPRDefaults(&ctx, cfg.PR)
+
+	templates, err := loadPRTemplates(ctx.SpectrRoot, ctx.TitleTemplate)
+	if err != nil {
+		msg := "%w. Archive completed successfully. Create PR manually"
+
+		return fmt.Errorf(msg, err)
+	}
+
+	remote := ctx.Remote
+	if remote == "" {
+		remote = cfg.Remote
+	}
+	target, err := git.ResolveTarget(remote, cfg.Platforms, cfg.Tokens)
+	if err != nil {
+		msg := "%w. Archive completed successfully. Create PR manually"
+
+		return fmt.Errorf(msg, err)
 	}
 
-	baseBranchName := fmt.Sprintf("archive-%s", ctx.ChangeID)
+	baseBranchName := fmt.Sprintf("archive-%s", ctx.slug())
 	branchName := git.GenerateUniqueBranchName(baseBranchName)
+	ctx.Branch = branchName
 
 	// Create temporary worktree directory
 	tempPath := filepath.Join(
 		os.TempDir(),
-		fmt.Sprintf("spectr-archive-%s", ctx.ChangeID),
+		fmt.Sprintf("spectr-archive-%s", ctx.slug()),
 	)
 
 	// Ensure cleanup on exit
@@ -53,80 +113,96 @@ func createPR(ctx PRContext) error {
 
 	fmt.Printf("Created worktree at: %s\n", tempPath)
 
-	// Run archive operations in the worktree
-	archiveCmd := &ArchiveCmd{
-		ChangeID:  ctx.ChangeID,
-		SkipSpecs: ctx.SkipSpecs,
-		Yes:       true,  // Non-interactive mode for worktree operations
-		PR:        false, // Prevent recursive PR creation
+	// In a monorepo the project may live below the repository root, so
+	// the archive runs at the same place inside the worktree
+	projectDir, err := git.WorktreePath(filepath.Dir(ctx.SpectrRoot), tempPath)
+	if err != nil {
+		return err
 	}
 
-	if err := Archive(archiveCmd, tempPath); err != nil {
+	// Run archive operations in the worktree
+	if err := archiveInWorktree(ctx, projectDir); err != nil {
 		return fmt.Errorf("archive in worktree: %w", err)
 	}
 
 	// Stage and commit in worktree
-	err = prepareBranchAndCommit(ctx, tempPath)
+	err = prepareBranchAndCommit(&ctx, templates, projectDir)
 	if err != nil {
 		return err
 	}
 
 	// Push and create PR from worktree
-	prURL, err := pushAndCreatePR(ctx, platform, branchName, tempPath)
+	pr, err := pushAndCreatePR(ctx, templates, target, projectDir)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("\n✓ Pull request created: %s\n", prURL)
+	fmt.Printf("\n✓ Pull request created: %s\n", pr.URL)
 
 	return nil
 }
 
-// validateAndDetectPlatform validates git environment and detects platform.
-// It checks git repository status, detects the platform, and verifies CLI tool
-// installation. Returns platform and error if any validation fails.
-func validateAndDetectPlatform() (git.Platform, error) {
-	if err := validateGitEnvironment(); err != nil {
-		return git.PlatformUnknown, err
+// slug names the branch and worktree of the PR after its change, or
+// after the number of changes of a batch
+func (ctx PRContext) slug() string {
+	if len(ctx.Archives) > 1 {
+		return fmt.Sprintf("%d-changes", len(ctx.Archives))
 	}
 
-	platform, remoteURL, err := git.DetectPlatform()
-	if err != nil {
-		msg := "detect git platform: %w. " +
-			"Archive completed successfully. Create PR manually"
+	return ctx.ChangeID
+}
 
-		return git.PlatformUnknown, fmt.Errorf(msg, err)
+// archiveInWorktree repeats the archive of ctx in the worktree project
+// at projectDir
+func archiveInWorktree(ctx PRContext, projectDir string) error {
+	archiveCmd := &ArchiveCmd{
+		ChangeID:  ctx.ChangeID,
+		SkipSpecs: ctx.SkipSpecs,
+		Yes:       true,  // Non-interactive mode for worktree operations
+		PR:        false, // Prevent recursive PR creation
 	}
-
-	if platform == git.PlatformUnknown {
-		msg := "could not detect git hosting platform. Remote URL: %s. " +
-			"Archive completed successfully. Create PR manually"
-
-		return git.PlatformUnknown, fmt.Errorf(msg, remoteURL)
+	if len(ctx.Archives) <= 1 {
+		return Archive(archiveCmd, projectDir)
 	}
 
-	if err := git.CheckCLIToolInstalled(platform); err != nil {
-		msg := "%w. Archive completed successfully. Create PR manually"
-
-		return git.PlatformUnknown, fmt.Errorf(msg, err)
+	archiveCmd.ChangeID = ""
+	for _, archived := range ctx.Archives {
+		archiveCmd.ChangeIDs = append(archiveCmd.ChangeIDs, archived.ChangeID)
 	}
 
-	return platform, nil
+	return ArchiveBatch(archiveCmd, projectDir)
 }
 
 // prepareBranchAndCommit stages files and commits in the worktree.
-// It stages the archive files and specs, then commits with an automatically
-// generated message. The branch is already created by CreateWorktree.
+// It stages the archive files and specs, records the spec diff in ctx,
+// then commits with the rendered commit message. The branch is already
+// created by CreateWorktree.
 func prepareBranchAndCommit(
-	ctx PRContext,
+	ctx *PRContext,
+	templates *prTemplates,
 	workingDir string,
 ) error {
-	if err := stageArchiveFiles(ctx, workingDir); err != nil {
+	if err := stageArchiveFiles(*ctx, workingDir); err != nil {
 		return err
 	}
 
-	commitMsg := buildCommitMessage(ctx)
-	if err := commitInWorktree(commitMsg, workingDir); err != nil {
+	if !ctx.SkipSpecs {
+		specsDir := filepath.Join(workingDir, "spectr", "specs")
+		diff, err := git.StagedDiff(workingDir, specsDir)
+		if err != nil {
+			return err
+		}
+		ctx.SpecDiff = diff
+	}
+
+	commitMsg, err := templates.renderCommit(*ctx)
+	if err != nil {
+		msg := "%w. Archive completed. Branch created. " +
+			"Commit manually and push"
+
+		return fmt.Errorf(msg, err)
+	}
+	if err := git.CommitInWorktree(workingDir, commitMsg); err != nil {
 		msg := "%v. Archive completed. Branch created. " +
 			"Commit manually and push"
 
@@ -137,45 +213,60 @@ func prepareBranchAndCommit(
 }
 
 // pushAndCreatePR pushes the branch and creates a pull request.
-// It pushes the archive branch to origin and creates a PR/MR using the
-// platform-specific CLI tool (gh, glab, or tea). Returns PR URL on success.
+// It pushes the archive branch to the target remote and creates a PR/MR
+// through the forge API, or the platform-specific CLI tool when no token
+// is configured.
 func pushAndCreatePR(
 	ctx PRContext,
-	platform git.Platform,
-	branchName,
+	templates *prTemplates,
+	target git.Target,
 	workingDir string,
-) (string, error) {
-	if err := pushFromWorktree(branchName, workingDir); err != nil {
+) (*git.PullRequest, error) {
+	if err := git.PushFromWorktree(workingDir, target.Remote, ctx.Branch); err != nil {
 		msg := "%v. Archive completed. Branch created and committed. " +
 			"Push manually"
 
-		return "", fmt.Errorf(msg, err)
+		return nil, fmt.Errorf(msg, err)
 	}
 
-	// Build PR options with title and body
-	prOpts := git.PROptions{
-		Title:  buildPRTitle(ctx.ChangeID),
-		Body:   buildPRBody(ctx),
-		Branch: branchName,
+	prOpts, err := buildPROptions(ctx, templates)
+	if err != nil {
+		msg := "%v. Archive completed. Branch pushed. Create PR manually"
+
+		return nil, fmt.Errorf(msg, err)
 	}
 
-	prURL, err := git.CreatePR(platform, prOpts)
+	pr, err := target.CreatePR(prOpts)
 	if err != nil {
 		msg := "%v. Archive completed. Branch pushed. Create PR manually"
 
-		return "", fmt.Errorf(msg, err)
+		return nil, fmt.Errorf(msg, err)
 	}
 
-	return prURL, nil
+	return pr, nil
 }
 
-// validateGitEnvironment checks if git is properly configured
-func validateGitEnvironment() error {
-	if err := git.IsGitRepository(); err != nil {
-		return err
+// buildPROptions renders the title and body of the pull request and
+// collects its options
+func buildPROptions(ctx PRContext, templates *prTemplates) (git.PROptions, error) {
+	title, err := templates.renderTitle(ctx)
+	if err != nil {
+		return git.PROptions{}, err
+	}
+	body, err := templates.renderBody(ctx)
+	if err != nil {
+		return git.PROptions{}, err
 	}
 
-	return git.HasOriginRemote()
+	return git.PROptions{
+		Title:     title,
+		Body:      body,
+		Branch:    ctx.Branch,
+		Base:      ctx.Base,
+		Draft:     ctx.Draft,
+		Labels:    ctx.Labels,
+		Reviewers: ctx.Reviewers,
+	}, nil
 }
 
 // stageArchiveFiles stages the archived directory and updated specs
@@ -183,13 +274,23 @@ func stageArchiveFiles(ctx PRContext, workingDir string) error {
 	// Construct paths relative to the worktree's spectr root
 	worktreeSpectrRoot := filepath.Join(workingDir, "spectr")
 
-	paths := []string{
-		filepath.Join(
-			worktreeSpectrRoot,
-			"changes",
-			"archive",
-			ctx.ArchiveName,
-		),
+	archives := ctx.Archives
+	if len(archives) == 0 {
+		archives = []ArchivedChange{{ctx.ChangeID, ctx.ArchiveName}}
+	}
+
+	var paths []string
+	for _, archived := range archives {
+		paths = append(paths,
+			filepath.Join(
+				worktreeSpectrRoot,
+				"changes",
+				"archive",
+				archived.ArchiveName,
+			),
+			// The change moved into the archive, so stage its removal
+			filepath.Join(worktreeSpectrRoot, "changes", archived.ChangeID),
+		)
 	}
 
 	// Add specs directory if specs were updated
@@ -197,7 +298,7 @@ func stageArchiveFiles(ctx PRContext, workingDir string) error {
 		paths = append(paths, filepath.Join(worktreeSpectrRoot, "specs"))
 	}
 
-	if err := stageFilesInWorktree(paths, workingDir); err != nil {
+	if err := git.StageInWorktree(workingDir, paths); err != nil {
 		return err
 	}
 
@@ -205,52 +306,3 @@ func stageArchiveFiles(ctx PRContext, workingDir string) error {
 
 	return nil
 }
-
-// stageFilesInWorktree stages files in the specified worktree directory
-func stageFilesInWorktree(paths []string, workingDir string) error {
-	args := append([]string{"-C", workingDir, "add"}, paths...)
-	cmd := exec.Command("git", args...)
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		msg := "stage files in worktree: %w\nOutput: %s"
-
-		return fmt.Errorf(msg, err, string(output))
-	}
-
-	return nil
-}
-
-// commitInWorktree creates a commit in the specified worktree directory
-func commitInWorktree(message, workingDir string) error {
-	cmd := exec.Command("git", "-C", workingDir, "commit", "-F", "-")
-	cmd.Stdin = strings.NewReader(message)
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		msg := "commit in worktree: %w\nOutput: %s"
-
-		return fmt.Errorf(msg, err, string(output))
-	}
-
-	return nil
-}
-
-// pushFromWorktree pushes the branch from the specified worktree directory
-func pushFromWorktree(branchName, workingDir string) error {
-	cmd := exec.Command(
-		"git",
-		"-C",
-		workingDir,
-		"push",
-		"-u",
-		"origin",
-		branchName,
-	)
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		msg := "push from worktree: %w\nOutput: %s"
-
-		return fmt.Errorf(msg, err, string(output))
-	}
-
-	return nil
-}
@@ -12,13 +12,20 @@ func buildCommitMessage(ctx PRContext) string {
 	var sb strings.Builder
 
 	sb.WriteString(fmt.Sprintf("Archive: %s\n\n", ctx.ChangeID))
-	sb.WriteString(
-		fmt.Sprintf(
-			"Completed change '%s' archived to changes/archive/%s/\n",
-			ctx.ChangeID,
-			ctx.ArchiveName,
-		),
-	)
+	if len(ctx.Archives) > 1 {
+		sb.WriteString("Completed changes archived:\n")
+		for _, archived := range ctx.Archives {
+			fmt.Fprintf(&sb, "- %s to changes/archive/%s/\n", archived.ChangeID, archived.ArchiveName)
+		}
+	} else {
+		sb.WriteString(
+			fmt.Sprintf(
+				"Completed change '%s' archived to changes/archive/%s/\n",
+				ctx.ChangeID,
+				ctx.ArchiveName,
+			),
+		)
+	}
 
 	if !ctx.SkipSpecs && ctx.OpCounts.Total() > 0 {
 		sb.WriteString("\nSpec operations applied:\n")
@@ -26,9 +33,19 @@ func buildCommitMessage(ctx PRContext) string {
 		sb.WriteString(fmt.Sprintf("~ %d modified\n", ctx.OpCounts.Modified))
 		sb.WriteString(fmt.Sprintf("- %d removed\n", ctx.OpCounts.Removed))
 		sb.WriteString(fmt.Sprintf("→ %d renamed\n", ctx.OpCounts.Renamed))
+		if ctx.OpCounts.Scenarios > 0 {
+			sb.WriteString(fmt.Sprintf("± %d scenario(s) changed\n", ctx.OpCounts.Scenarios))
+		}
 	}
 
-	sb.WriteString(fmt.Sprintf("\nChange-Id: %s\n", ctx.ChangeID))
+	sb.WriteString("\n")
+	if len(ctx.Archives) > 1 {
+		for _, archived := range ctx.Archives {
+			fmt.Fprintf(&sb, "Change-Id: %s\n", archived.ChangeID)
+		}
+	} else {
+		fmt.Fprintf(&sb, "Change-Id: %s\n", ctx.ChangeID)
+	}
 
 	return sb.String()
 }
@@ -46,12 +63,25 @@ func buildPRBody(ctx PRContext) string {
 	var sb strings.Builder
 
 	sb.WriteString("## Archive Summary\n\n")
-	fmt.Fprintf(&sb, "Archived completed change: `%s`\n\n", ctx.ChangeID)
-	fmt.Fprintf(
-		&sb,
-		"Location: `spectr/changes/archive/%s/`\n\n",
-		ctx.ArchiveName,
-	)
+	if len(ctx.Archives) > 1 {
+		sb.WriteString("Archived completed changes, in order:\n")
+		for _, archived := range ctx.Archives {
+			fmt.Fprintf(
+				&sb,
+				"- `%s` → `spectr/changes/archive/%s/`\n",
+				archived.ChangeID,
+				archived.ArchiveName,
+			)
+		}
+		sb.WriteString("\n")
+	} else {
+		fmt.Fprintf(&sb, "Archived completed change: `%s`\n\n", ctx.ChangeID)
+		fmt.Fprintf(
+			&sb,
+			"Location: `spectr/changes/archive/%s/`\n\n",
+			ctx.ArchiveName,
+		)
+	}
 
 	sb.WriteString("## Spec Updates\n\n")
 	switch {
@@ -62,7 +92,11 @@ func buildPRBody(ctx PRContext) string {
 		fmt.Fprintf(&sb, "- **+ %d added**\n", ctx.OpCounts.Added)
 		fmt.Fprintf(&sb, "- **~ %d modified**\n", ctx.OpCounts.Modified)
 		fmt.Fprintf(&sb, "- **- %d removed**\n", ctx.OpCounts.Removed)
-		fmt.Fprintf(&sb, "- **→ %d renamed**\n\n", ctx.OpCounts.Renamed)
+		fmt.Fprintf(&sb, "- **→ %d renamed**\n", ctx.OpCounts.Renamed)
+		if ctx.OpCounts.Scenarios > 0 {
+			fmt.Fprintf(&sb, "- **± %d scenario(s) changed**\n", ctx.OpCounts.Scenarios)
+		}
+		sb.WriteString("\n")
 
 		writeCapabilities(&sb, ctx.Capabilities)
 	default:
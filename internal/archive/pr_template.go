@@ -0,0 +1,130 @@
+package archive
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/connerohnesorge/spectr/internal/config"
+)
+
+// Names of the user-overridable templates, looked up in
+// spectr/templates/ of the project and then in the templates directory
+// of the user config
+const (
+	templatesDir          = "templates"
+	commitMessageTemplate = "commit-message.tmpl"
+	prBodyTemplate        = "pr-body.md.tmpl"
+)
+
+// prTemplates holds the templates of a pull request. A nil template
+// uses the built-in text.
+type prTemplates struct {
+	title  *template.Template
+	commit *template.Template
+	body   *template.Template
+}
+
+// templateFuncs are the functions available to PR templates
+var templateFuncs = template.FuncMap{
+	"join": strings.Join,
+}
+
+// loadPRTemplates parses the title template and the commit message and
+// PR body templates found for the project, so template errors surface
+// before any branch is created
+func loadPRTemplates(spectrRoot, titleTemplate string) (*prTemplates, error) {
+	templates := &prTemplates{}
+
+	if titleTemplate != "" {
+		tmpl, err := parseTemplate("title", titleTemplate)
+		if err != nil {
+			return nil, err
+		}
+		templates.title = tmpl
+	}
+
+	var err error
+	templates.commit, err = findTemplate(spectrRoot, commitMessageTemplate)
+	if err != nil {
+		return nil, err
+	}
+	templates.body, err = findTemplate(spectrRoot, prBodyTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// findTemplate parses the project's template called name, or else the
+// user's. It returns nil when neither exists.
+func findTemplate(spectrRoot, name string) (*template.Template, error) {
+	paths := []string{filepath.Join(spectrRoot, templatesDir, name)}
+	if userDir, err := config.UserDir(); err == nil {
+		paths = append(paths, filepath.Join(userDir, templatesDir, name))
+	}
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read template: %w", err)
+		}
+
+		return parseTemplate(path, string(data))
+	}
+
+	return nil, nil
+}
+
+// parseTemplate parses text as a template called name
+func parseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).
+		Funcs(templateFuncs).
+		Option("missingkey=error").
+		Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse template %s: %w", name, err)
+	}
+
+	return tmpl, nil
+}
+
+// render executes tmpl with ctx, or returns fallback when tmpl is nil
+func render(tmpl *template.Template, ctx PRContext, fallback func(PRContext) string) (string, error) {
+	if tmpl == nil {
+		return fallback(ctx), nil
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, ctx); err != nil {
+		return "", fmt.Errorf("render template %s: %w", tmpl.Name(), err)
+	}
+
+	return buf.String(), nil
+}
+
+// renderTitle renders the PR title
+func (t *prTemplates) renderTitle(ctx PRContext) (string, error) {
+	title, err := render(t.title, ctx, func(ctx PRContext) string {
+		return buildPRTitle(ctx.ChangeID)
+	})
+
+	return strings.TrimSpace(title), err
+}
+
+// renderCommit renders the commit message
+func (t *prTemplates) renderCommit(ctx PRContext) (string, error) {
+	return render(t.commit, ctx, buildCommitMessage)
+}
+
+// renderBody renders the PR body
+func (t *prTemplates) renderBody(ctx PRContext) (string, error) {
+	return render(t.body, ctx, buildPRBody)
+}
@@ -0,0 +1,157 @@
+package archive
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/connerohnesorge/spectr/internal/config"
+)
+
+// writeTemplate writes a template called name below dir/templates
+func writeTemplate(t *testing.T, dir, name, content string) {
+	t.Helper()
+	path := filepath.Join(dir, templatesDir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadPRTemplates_Defaults(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	spectrRoot := t.TempDir()
+
+	templates, err := loadPRTemplates(spectrRoot, "")
+	if err != nil {
+		t.Fatalf("loadPRTemplates failed: %v", err)
+	}
+
+	ctx := PRContext{ChangeID: "add-auth", ArchiveName: "2025-01-01-add-auth"}
+	title, _ := templates.renderTitle(ctx)
+	commit, _ := templates.renderCommit(ctx)
+	body, _ := templates.renderBody(ctx)
+	if title != buildPRTitle("add-auth") || commit != buildCommitMessage(ctx) || body != buildPRBody(ctx) {
+		t.Error("Expected the built-in title, commit message, and body without templates")
+	}
+}
+
+func TestLoadPRTemplates_Overrides(t *testing.T) {
+	userDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", userDir)
+	spectrRoot := t.TempDir()
+
+	writeTemplate(t, spectrRoot, commitMessageTemplate, "archive({{.ChangeID}}): {{join .Capabilities \", \"}}\n")
+	writeTemplate(t, filepath.Join(userDir, "spectr"), commitMessageTemplate, "user commit\n")
+	writeTemplate(t, filepath.Join(userDir, "spectr"), prBodyTemplate, "Base {{.Base}}\n```diff\n{{.SpecDiff}}```\n")
+
+	templates, err := loadPRTemplates(spectrRoot, "  {{.ChangeID}} [{{.Branch}}]  ")
+	if err != nil {
+		t.Fatalf("loadPRTemplates failed: %v", err)
+	}
+
+	ctx := PRContext{
+		ChangeID:     "add-auth",
+		Capabilities: []string{"auth", "users"},
+		Base:         "develop",
+		Branch:       "archive-add-auth",
+		SpecDiff:     "+new line\n",
+	}
+
+	title, err := templates.renderTitle(ctx)
+	if err != nil || title != "add-auth [archive-add-auth]" {
+		t.Errorf("renderTitle() = %q, %v", title, err)
+	}
+	commit, err := templates.renderCommit(ctx)
+	if err != nil || commit != "archive(add-auth): auth, users\n" {
+		t.Errorf("Expected the project commit template, got %q, %v", commit, err)
+	}
+	body, err := templates.renderBody(ctx)
+	if err != nil || body != "Base develop\n```diff\n+new line\n```\n" {
+		t.Errorf("Expected the user body template, got %q, %v", body, err)
+	}
+}
+
+func TestLoadPRTemplates_Errors(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	if _, err := loadPRTemplates(t.TempDir(), "{{.ChangeID"); err == nil {
+		t.Error("Expected a parse error for the title template")
+	}
+
+	spectrRoot := t.TempDir()
+	writeTemplate(t, spectrRoot, prBodyTemplate, "{{.Unknown}}")
+	templates, err := loadPRTemplates(spectrRoot, "")
+	if err != nil {
+		t.Fatalf("loadPRTemplates failed: %v", err)
+	}
+	if _, err := templates.renderBody(PRContext{}); err == nil {
+		t.Error("Expected an error for an unknown field")
+	}
+}
+
+func TestApplyPRDefaults(t *testing.T) {
+	draft := true
+	defaults := config.PR{
+		Base:          "develop",
+		Draft:         &draft,
+		Labels:        []string{"spectr"},
+		Reviewers:     []string{"alice"},
+		TitleTemplate: "{{.ChangeID}}",
+	}
+
+	ctx := PRContext{Base: "main", Labels: []string{"docs"}}
+	applyPRDefaults(&ctx, defaults)
+
+	want := PRContext{
+		Base:          "main",
+		Draft:         true,
+		Labels:        []string{"docs"},
+		Reviewers:     []string{"alice"},
+		TitleTemplate: "{{.ChangeID}}",
+	}
+	if !reflect.DeepEqual(ctx, want) {
+		t.Errorf("applyPRDefaults() = %+v, want %+v", ctx, want)
+	}
+
+	// --no-draft wins over a config that opens drafts
+	noDraft := false
+	ctx = PRContext{draftFlag: &noDraft}
+	applyPRDefaults(&ctx, defaults)
+	if ctx.Draft {
+		t.Error("Expected --no-draft to override the config")
+	}
+}
+
+func TestBuildPROptions(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	templates, err := loadPRTemplates(t.TempDir(), "Archive {{.ChangeID}} into {{.Base}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := PRContext{
+		ChangeID:  "add-auth",
+		Branch:    "archive-add-auth",
+		Base:      "develop",
+		Draft:     true,
+		Labels:    []string{"spectr"},
+		Reviewers: []string{"alice"},
+	}
+	opts, err := buildPROptions(ctx, templates)
+	if err != nil {
+		t.Fatalf("buildPROptions failed: %v", err)
+	}
+	if opts.Title != "Archive add-auth into develop" || opts.Branch != "archive-add-auth" ||
+		opts.Base != "develop" || !opts.Draft ||
+		!reflect.DeepEqual(opts.Labels, ctx.Labels) || !reflect.DeepEqual(opts.Reviewers, ctx.Reviewers) {
+		t.Errorf("Unexpected options: %+v", opts)
+	}
+	if !strings.Contains(opts.Body, "## Archive Summary") {
+		t.Errorf("Expected the built-in body, got %q", opts.Body)
+	}
+}
@@ -51,6 +51,23 @@ func TestBuildCommitMessage(t *testing.T) {
 				"Change-Id: test-change",
 			},
 		},
+		{
+			name: "batch",
+			ctx: PRContext{
+				ChangeID: "add-sso, tune-sso",
+				Archives: []ArchivedChange{
+					{"add-sso", "2025-11-20-add-sso"},
+					{"tune-sso", "2025-11-20-tune-sso"},
+				},
+				SkipSpecs: true,
+			},
+			want: []string{
+				"Archive: add-sso, tune-sso",
+				"- add-sso to changes/archive/2025-11-20-add-sso/",
+				"- tune-sso to changes/archive/2025-11-20-tune-sso/",
+				"Change-Id: add-sso\nChange-Id: tune-sso\n",
+			},
+		},
 	}
 
 	for _, tt := range tests {
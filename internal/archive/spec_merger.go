@@ -36,14 +36,20 @@ func MergeSpec(
 
 	// If spec doesn't exist, create skeleton and only allow ADDED operations
 	if !specExists {
-		if len(deltaPlan.Modified) > 0 || len(deltaPlan.Removed) > 0 || len(deltaPlan.Renamed) > 0 {
+		if len(deltaPlan.Modified) > 0 || len(deltaPlan.Removed) > 0 || len(deltaPlan.Renamed) > 0 ||
+			deltaPlan.HasScenarioDeltas() {
 			return "", counts, fmt.Errorf(
 				"target spec does not exist; only ADDED requirements are allowed for new specs",
 			)
 		}
 		skeleton := generateSpecSkeleton(baseSpecPath)
-		merged, addCount := applyAdded(skeleton, deltaPlan.Added)
-		counts.Added = addCount
+		merged := reconstructSpec(
+			skeleton,
+			parsers.NewRequirementIndex(nil),
+			deltaPlan.Added,
+			deltaPlan.AddedPlacements,
+		)
+		counts.Added = len(deltaPlan.Added)
 
 		return merged, counts, nil
 	}
@@ -60,158 +66,222 @@ func MergeSpec(
 		return "", counts, fmt.Errorf("parse base spec: %w", err)
 	}
 
-	// Build requirement map (normalized name -> block)
-	reqMap := make(map[string]parsers.RequirementBlock)
-	for _, req := range baseReqs {
-		normalized := parsers.NormalizeRequirementName(req.Name)
-		reqMap[normalized] = req
-	}
-
-	// Apply operations in order: RENAMED -> REMOVED -> MODIFIED -> ADDED
-	reqMap, renameCount := applyRenamed(reqMap, deltaPlan.Renamed)
-	counts.Renamed = renameCount
-
-	reqMap, removeCount := applyRemoved(reqMap, deltaPlan.Removed)
-	counts.Removed = removeCount
+	// Index requirements by stable ID, falling back to normalized name
+	index := parsers.NewRequirementIndex(baseReqs)
 
-	reqMap, modifyCount := applyModified(reqMap, deltaPlan.Modified)
-	counts.Modified = modifyCount
+	// Apply operations in order:
+	// RENAMED -> REMOVED -> MODIFIED -> scenario deltas -> ADDED
+	counts.Renamed = applyRenamed(index, deltaPlan.Renamed)
+	counts.Removed = applyRemoved(index, deltaPlan.Removed, deltaPlan.RemovedIDs)
+	counts.Modified = applyModified(index, deltaPlan.Modified)
+	counts.Scenarios = applyScenarioDeltas(index, deltaPlan)
 
-	// ADDED requirements will be appended at the end
+	// ADDED requirements are inserted where their placement hints say,
+	// or appended at the end
 	counts.Added = len(deltaPlan.Added)
 
 	// Reconstruct spec
-	merged := reconstructSpec(string(baseContent), reqMap, deltaPlan.Added)
+	merged := reconstructSpec(
+		string(baseContent),
+		index,
+		deltaPlan.Added,
+		deltaPlan.AddedPlacements,
+	)
 
 	return merged, counts, nil
 }
 
-// applyRenamed updates requirement names in the map
+// applyRenamed updates requirement names in place. Renamed requirements
+// keep their position and stable ID.
 func applyRenamed(
-	reqMap map[string]parsers.RequirementBlock,
+	index *parsers.RequirementIndex,
 	renames []parsers.RenameOp,
-) (map[string]parsers.RequirementBlock, int) {
+) int {
 	count := 0
 	for _, op := range renames {
-		fromNorm := parsers.NormalizeRequirementName(op.From)
-		toNorm := parsers.NormalizeRequirementName(op.To)
-
-		req, exists := reqMap[fromNorm]
+		i, exists := index.Find(op.ID, op.From)
 		if !exists {
 			continue
 		}
 
-		// Update the header line
-		req.HeaderLine = "### Requirement: " + op.To
+		req := index.Get(i)
+		// Update the header line, keeping any ID suffix
+		req.HeaderLine = parsers.RenameRequirementHeader(
+			req.HeaderLine,
+			req.Name,
+			op.To,
+		)
 		// Update the name
 		req.Name = op.To
 		// Update the raw content (first line)
-		lines := strings.Split(req.Raw, "\n")
-		if len(lines) > 0 {
-			lines[0] = req.HeaderLine
-			req.Raw = strings.Join(lines, "\n")
-		}
-		// Remove old key and add with new key
-		delete(reqMap, fromNorm)
-		reqMap[toNorm] = req
+		req.Raw = replaceHeaderLine(req.Raw, req.HeaderLine)
+		index.Replace(i, req)
 		count++
 	}
 
-	return reqMap, count
+	return count
 }
 
-// applyRemoved removes requirements from the map
+// applyRemoved removes requirements, matching by stable ID when the
+// REMOVED header carries one
 func applyRemoved(
-	reqMap map[string]parsers.RequirementBlock,
+	index *parsers.RequirementIndex,
 	removed []string,
-) (map[string]parsers.RequirementBlock, int) {
+	ids map[string]string,
+) int {
 	count := 0
 	for _, name := range removed {
-		normalized := parsers.NormalizeRequirementName(name)
-		if _, exists := reqMap[normalized]; exists {
-			delete(reqMap, normalized)
+		if i, exists := index.Find(ids[name], name); exists {
+			index.Remove(i)
 			count++
 		}
 	}
 
-	return reqMap, count
+	return count
 }
 
-// applyModified replaces requirements in the map
+// applyModified replaces requirements in place. A MODIFIED block with a
+// stable ID may also change the requirement's name; a block without an
+// ID inherits the ID of the requirement it replaces.
 func applyModified(
-	reqMap map[string]parsers.RequirementBlock,
+	index *parsers.RequirementIndex,
 	modified []parsers.RequirementBlock,
-) (map[string]parsers.RequirementBlock, int) {
+) int {
 	count := 0
 	for _, mod := range modified {
-		normalized := parsers.NormalizeRequirementName(mod.Name)
-		if _, exists := reqMap[normalized]; exists {
-			reqMap[normalized] = mod
-			count++
+		i, exists := index.Find(mod.ID, mod.Name)
+		if !exists {
+			continue
 		}
+
+		if base := index.Get(i); mod.ID == "" && base.ID != "" {
+			mod.ID = base.ID
+			mod.HeaderLine = parsers.FormatRequirementHeader(mod.Name, mod.ID)
+			mod.Raw = replaceHeaderLine(mod.Raw, mod.HeaderLine)
+		}
+		index.Replace(i, mod)
+		count++
 	}
 
-	return reqMap, count
+	return count
 }
 
-// applyAdded adds new requirements to spec skeleton
-func applyAdded(
-	skeleton string,
-	added []parsers.RequirementBlock,
-) (string, int) {
-	if len(added) == 0 {
-		return skeleton, 0
+// applyScenarioDeltas edits the scenarios of existing requirements in
+// place: REMOVED scenarios are dropped, MODIFIED scenarios replace the
+// scenario of the same name, and ADDED scenarios are appended after the
+// requirement's last scenario. Requirements are looked up after renames,
+// so deltas refer to them by their new name.
+func applyScenarioDeltas(
+	index *parsers.RequirementIndex,
+	plan *parsers.DeltaPlan,
+) int {
+	count := 0
+
+	edit := func(
+		deltas []parsers.ScenarioDelta,
+		apply func([]parsers.ScenarioBlock, parsers.ScenarioBlock) ([]parsers.ScenarioBlock, bool),
+	) {
+		for _, delta := range deltas {
+			i, exists := index.Find(delta.RequirementID, delta.Requirement)
+			if !exists {
+				continue
+			}
+
+			req := index.Get(i)
+			body, scenarios := parsers.SplitRequirementScenarios(req.Raw)
+			changed := false
+			for _, scenario := range delta.Scenarios {
+				var ok bool
+				if scenarios, ok = apply(scenarios, scenario); ok {
+					changed = true
+					count++
+				}
+			}
+			if changed {
+				req.Raw = parsers.JoinRequirementScenarios(body, scenarios)
+				index.Replace(i, req)
+			}
+		}
 	}
 
-	var result strings.Builder
-	result.WriteString(skeleton)
-	result.WriteString("\n")
+	edit(plan.RemovedScenarios, func(
+		scenarios []parsers.ScenarioBlock,
+		removed parsers.ScenarioBlock,
+	) ([]parsers.ScenarioBlock, bool) {
+		j := findScenario(scenarios, removed.Name)
+		if j < 0 {
+			return scenarios, false
+		}
 
-	for _, req := range added {
-		result.WriteString(strings.TrimRight(req.Raw, newlineChar))
-		result.WriteString("\n\n")
+		return append(scenarios[:j], scenarios[j+1:]...), true
+	})
+	edit(plan.ModifiedScenarios, func(
+		scenarios []parsers.ScenarioBlock,
+		modified parsers.ScenarioBlock,
+	) ([]parsers.ScenarioBlock, bool) {
+		j := findScenario(scenarios, modified.Name)
+		if j < 0 {
+			return scenarios, false
+		}
+		scenarios[j] = modified
+
+		return scenarios, true
+	})
+	edit(plan.AddedScenarios, func(
+		scenarios []parsers.ScenarioBlock,
+		added parsers.ScenarioBlock,
+	) ([]parsers.ScenarioBlock, bool) {
+		return append(scenarios, added), true
+	})
+
+	return count
+}
+
+// findScenario returns the position of the named scenario, or -1
+func findScenario(scenarios []parsers.ScenarioBlock, name string) int {
+	for i, scenario := range scenarios {
+		if parsers.NormalizeRequirementName(scenario.Name) ==
+			parsers.NormalizeRequirementName(name) {
+			return i
+		}
 	}
 
-	return result.String(), len(added)
+	return -1
 }
 
-// reconstructSpec rebuilds the spec from preamble,
-// updated requirements, and added requirements
+// replaceHeaderLine swaps the first line of a requirement block
+func replaceHeaderLine(raw, headerLine string) string {
+	lines := strings.Split(raw, "\n")
+	if len(lines) > 0 {
+		lines[0] = headerLine
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// reconstructSpec rebuilds the spec from its preamble, the Requirements
+// section with updated requirements and added requirements placed, and
+// whatever follows the Requirements section
 func reconstructSpec(
 	baseContent string,
-	reqMap map[string]parsers.RequirementBlock,
+	index *parsers.RequirementIndex,
 	added []parsers.RequirementBlock,
+	placements map[string]parsers.Placement,
 ) string {
 	// Split spec into: preamble, requirements section, after
-	preamble, reqsContent, after := splitSpec(baseContent)
-
-	// Extract original requirement order from base content
-	orderedReqs := extractOrderedRequirements(reqsContent, reqMap)
-
-	// Build requirements section
-	var reqsBuilder strings.Builder
-	for i := range orderedReqs {
-		if i > 0 {
-			reqsBuilder.WriteString(newlineChar)
-		}
-		reqsBuilder.WriteString(
-			strings.TrimRight(orderedReqs[i].Raw, newlineChar),
-		)
-		reqsBuilder.WriteString(newlineChar)
-	}
+	preamble, requirements, after := splitSpec(baseContent)
 
-	// Add new requirements at the end
-	for _, req := range added {
-		reqsBuilder.WriteString(newlineChar)
-		reqsBuilder.WriteString(strings.TrimRight(req.Raw, newlineChar))
-		reqsBuilder.WriteString(newlineChar)
-	}
+	layout := newSectionLayout(index, added, placements, parsers.RequirementGroups(baseContent))
+	offset := countRequirementHeaders(preamble)
 
 	// Combine all parts
 	var result strings.Builder
 	result.WriteString(preamble)
-	result.WriteString(reqsBuilder.String())
-	result.WriteString(after)
+	result.WriteString(layout.render(splitRequirementSegments(requirements), offset))
+	if after != "" {
+		result.WriteString(newlineChar)
+		result.WriteString(after)
+	}
 
 	// Normalize blank lines (collapse 3+ newlines to 2)
 	output := result.String()
@@ -249,43 +319,6 @@ func splitSpec(content string) (preamble, requirements, after string) {
 	return preamble, requirements, after
 }
 
-// extractOrderedRequirements preserves requirement ordering
-// from original content
-func extractOrderedRequirements(
-	reqsContent string,
-	reqMap map[string]parsers.RequirementBlock,
-) []parsers.RequirementBlock {
-	var ordered []parsers.RequirementBlock
-
-	// Find requirement headers in order
-	reqPattern := regexp.MustCompile(`(?m)^###\s+Requirement:\s*(.+)$`)
-	matches := reqPattern.FindAllStringSubmatch(reqsContent, -1)
-
-	for _, match := range matches {
-		if len(match) <= 1 {
-			continue
-		}
-
-		name := strings.TrimSpace(match[1])
-		normalized := parsers.NormalizeRequirementName(name)
-		req, exists := reqMap[normalized]
-		if !exists {
-			continue
-		}
-
-		ordered = append(ordered, req)
-		// Remove from map so we don't add duplicates
-		delete(reqMap, normalized)
-	}
-
-	// Add any remaining requirements from map (shouldn't happen in normal flow)
-	for _, req := range reqMap {
-		ordered = append(ordered, req)
-	}
-
-	return ordered
-}
-
 // generateSpecSkeleton creates a new spec skeleton for a capability
 func generateSpecSkeleton(targetPath string) string {
 	// Extract capability name from path
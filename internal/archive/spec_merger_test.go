@@ -464,3 +464,511 @@ func TestFormatCapabilityName(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeSpec_RequirementIDs(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	baseContent := `# Auth Specification
+
+## Purpose
+Authentication.
+
+## Requirements
+
+### Requirement: Login {#AUTH-001}
+The system SHALL log users in.
+
+### Requirement: Logout {#AUTH-002}
+The system SHALL log users out.
+
+### Requirement: Legacy Session {#AUTH-003}
+The system SHALL keep legacy sessions.
+
+### Requirement: Audit
+The system SHALL audit logins.
+`
+	deltaContent := `## MODIFIED Requirements
+
+### Requirement: Sign In {#AUTH-001}
+The system SHALL sign users in.
+
+### Requirement: Audit
+The system SHALL audit every login.
+
+## REMOVED Requirements
+
+### Requirement: Old Session Name {#AUTH-003}
+`
+	basePath := filepath.Join(tmpDir, "spec.md")
+	deltaPath := filepath.Join(tmpDir, "delta.md")
+	if err := os.WriteFile(basePath, []byte(baseContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(deltaPath, []byte(deltaContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	merged, counts, err := MergeSpec(basePath, deltaPath, true)
+	if err != nil {
+		t.Fatalf("MergeSpec failed: %v", err)
+	}
+
+	if counts.Modified != 2 || counts.Removed != 1 {
+		t.Errorf("Expected 2 modified and 1 removed, got %+v", counts)
+	}
+
+	// MODIFIED by ID renames in place
+	signIn := strings.Index(merged, "### Requirement: Sign In {#AUTH-001}")
+	logout := strings.Index(merged, "### Requirement: Logout {#AUTH-002}")
+	if signIn < 0 || logout < 0 || signIn > logout {
+		t.Errorf("Expected 'Sign In' to replace 'Login' in place:\n%s", merged)
+	}
+	if strings.Contains(merged, "Requirement: Login") {
+		t.Error("Old requirement name should be gone")
+	}
+
+	// REMOVED by ID ignores the stale name
+	if strings.Contains(merged, "Legacy Session") {
+		t.Error("Requirement removed by ID should be gone")
+	}
+
+	// MODIFIED without an ID keeps the body it was given
+	if !strings.Contains(merged, "The system SHALL audit every login.") {
+		t.Error("Audit requirement should be modified")
+	}
+}
+
+func TestMergeSpec_ModifiedInheritsID(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	baseContent := `# Auth Specification
+
+## Requirements
+
+### Requirement: Login {#AUTH-001}
+The system SHALL log users in.
+`
+	deltaContent := `## MODIFIED Requirements
+
+### Requirement: Login
+The system SHALL log users in with MFA.
+`
+	basePath := filepath.Join(tmpDir, "spec.md")
+	deltaPath := filepath.Join(tmpDir, "delta.md")
+	if err := os.WriteFile(basePath, []byte(baseContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(deltaPath, []byte(deltaContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	merged, _, err := MergeSpec(basePath, deltaPath, true)
+	if err != nil {
+		t.Fatalf("MergeSpec failed: %v", err)
+	}
+
+	if !strings.Contains(merged, "### Requirement: Login {#AUTH-001}\nThe system SHALL log users in with MFA.") {
+		t.Errorf("Expected modified requirement to keep ID AUTH-001:\n%s", merged)
+	}
+}
+
+func TestMergeSpec_ScenarioDeltas(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	baseContent := `# Auth Specification
+
+## Purpose
+Authentication.
+
+## Requirements
+
+### Requirement: Login
+The system SHALL log users in.
+
+#### Scenario: Valid credentials
+- **WHEN** credentials are valid
+- **THEN** the user is logged in
+
+#### Scenario: Legacy token
+- **WHEN** a legacy token is presented
+- **THEN** the user is logged in
+
+### Requirement: Logout
+The system SHALL log users out.
+
+#### Scenario: Logout
+- **WHEN** the user logs out
+- **THEN** the session ends
+`
+	deltaContent := `## RENAMED Requirements
+
+- FROM: ` + "`### Requirement: Login`" + `
+- TO: ` + "`### Requirement: Sign In`" + `
+
+## ADDED Scenarios
+
+### Requirement: Sign In
+
+#### Scenario: Remember me
+- **WHEN** the user ticks remember me
+- **THEN** the session persists
+
+## MODIFIED Scenarios
+
+### Requirement: Sign In
+
+#### Scenario: Valid credentials
+- **WHEN** credentials are valid
+- **THEN** the user is signed in
+
+## REMOVED Scenarios
+
+### Requirement: Sign In
+
+#### Scenario: Legacy token
+`
+	basePath := filepath.Join(tmpDir, "spec.md")
+	deltaPath := filepath.Join(tmpDir, "delta.md")
+	if err := os.WriteFile(basePath, []byte(baseContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(deltaPath, []byte(deltaContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	merged, counts, err := MergeSpec(basePath, deltaPath, true)
+	if err != nil {
+		t.Fatalf("MergeSpec failed: %v", err)
+	}
+
+	if counts.Renamed != 1 || counts.Scenarios != 3 {
+		t.Errorf("Expected 1 rename and 3 scenario operations, got %+v", counts)
+	}
+
+	want := `### Requirement: Sign In
+The system SHALL log users in.
+
+#### Scenario: Valid credentials
+- **WHEN** credentials are valid
+- **THEN** the user is signed in
+
+#### Scenario: Remember me
+- **WHEN** the user ticks remember me
+- **THEN** the session persists
+
+### Requirement: Logout`
+	if !strings.Contains(merged, want) {
+		t.Errorf("Merged spec missing surgically edited requirement:\n%s", merged)
+	}
+	if strings.Contains(merged, "Legacy token") {
+		t.Error("Removed scenario should be gone")
+	}
+}
+
+func TestMergeSpec_ScenarioDeltasRequireExistingSpec(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	deltaContent := `## ADDED Scenarios
+
+### Requirement: Login
+
+#### Scenario: Remember me
+- **WHEN** the user ticks remember me
+- **THEN** the session persists
+`
+	deltaPath := filepath.Join(tmpDir, "delta.md")
+	if err := os.WriteFile(deltaPath, []byte(deltaContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err := MergeSpec(filepath.Join(tmpDir, "spec.md"), deltaPath, false)
+	if err == nil {
+		t.Error("Expected error for scenario deltas against a new spec")
+	}
+}
+
+func TestMergeSpec_AddedPlacement(t *testing.T) {
+	baseContent := `# Auth Specification
+
+## Purpose
+Authentication.
+
+## Requirements
+
+### Sessions
+
+### Requirement: Login
+The system SHALL log users in.
+
+#### Scenario: Login
+- **WHEN** credentials are valid
+- **THEN** the user is logged in
+
+### Requirement: Logout
+The system SHALL log users out.
+
+#### Scenario: Logout
+- **WHEN** the user logs out
+- **THEN** the session ends
+
+### Tokens
+
+### Requirement: Token Refresh
+The system SHALL refresh tokens.
+
+#### Scenario: Refresh
+- **WHEN** a token expires
+- **THEN** it is refreshed
+`
+
+	tests := []struct {
+		name  string
+		delta string
+		order []string
+	}{
+		{
+			name: "after requirement",
+			delta: `## ADDED Requirements
+
+### Requirement: Session Timeout
+<!-- after: Login -->
+The system SHALL expire idle sessions.
+
+#### Scenario: Idle
+- **WHEN** a session is idle
+- **THEN** it expires
+`,
+			order: []string{
+				"### Sessions",
+				"### Requirement: Login",
+				"### Requirement: Session Timeout",
+				"### Requirement: Logout",
+				"### Tokens",
+			},
+		},
+		{
+			name: "before requirement",
+			delta: `## ADDED Requirements
+
+### Requirement: Token Issue
+<!-- before: Token Refresh -->
+The system SHALL issue tokens.
+
+#### Scenario: Issue
+- **WHEN** a user logs in
+- **THEN** a token is issued
+`,
+			order: []string{
+				"### Tokens",
+				"### Requirement: Token Issue",
+				"### Requirement: Token Refresh",
+			},
+		},
+		{
+			name: "end of group",
+			delta: `## ADDED Requirements
+
+### Requirement: Session Timeout
+<!-- group: Sessions -->
+The system SHALL expire idle sessions.
+
+#### Scenario: Idle
+- **WHEN** a session is idle
+- **THEN** it expires
+`,
+			order: []string{
+				"### Requirement: Logout",
+				"### Requirement: Session Timeout",
+				"### Tokens",
+			},
+		},
+		{
+			name: "chained after another added requirement",
+			delta: `## ADDED Requirements
+
+### Requirement: Idle Warning
+<!-- after: Session Timeout -->
+The system SHALL warn before expiry.
+
+#### Scenario: Warning
+- **WHEN** a session is about to expire
+- **THEN** the user is warned
+
+### Requirement: Session Timeout
+<!-- after: Login -->
+The system SHALL expire idle sessions.
+
+#### Scenario: Idle
+- **WHEN** a session is idle
+- **THEN** it expires
+`,
+			order: []string{
+				"### Requirement: Login",
+				"### Requirement: Session Timeout",
+				"### Requirement: Idle Warning",
+				"### Requirement: Logout",
+			},
+		},
+		{
+			name: "no hint appends to end",
+			delta: `## ADDED Requirements
+
+### Requirement: Audit
+The system SHALL audit logins.
+
+#### Scenario: Audit
+- **WHEN** a user logs in
+- **THEN** the login is recorded
+`,
+			order: []string{
+				"### Requirement: Token Refresh",
+				"### Requirement: Audit",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			basePath := filepath.Join(tmpDir, "spec.md")
+			deltaPath := filepath.Join(tmpDir, "delta.md")
+			if err := os.WriteFile(basePath, []byte(baseContent), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(deltaPath, []byte(tt.delta), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			merged, _, err := MergeSpec(basePath, deltaPath, true)
+			if err != nil {
+				t.Fatalf("MergeSpec failed: %v", err)
+			}
+
+			if strings.Contains(merged, "<!--") {
+				t.Errorf("Placement hint should be stripped:\n%s", merged)
+			}
+
+			last := -1
+			for _, heading := range tt.order {
+				pos := strings.Index(merged, heading+"\n")
+				if pos < 0 {
+					t.Fatalf("Merged spec missing %q:\n%s", heading, merged)
+				}
+				if pos < last {
+					t.Errorf("%q is out of order:\n%s", heading, merged)
+				}
+				last = pos
+			}
+		})
+	}
+}
+
+func TestMergeSpec_GroupsSurviveRemoval(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	baseContent := `# Auth Specification
+
+## Purpose
+Authentication.
+
+## Requirements
+
+### Sessions
+
+### Requirement: Login
+The system SHALL log users in.
+
+#### Scenario: Login
+- **WHEN** credentials are valid
+- **THEN** the user is logged in
+
+### Tokens
+
+### Requirement: Token Refresh
+The system SHALL refresh tokens.
+
+#### Scenario: Refresh
+- **WHEN** a token expires
+- **THEN** it is refreshed
+`
+	deltaContent := `## REMOVED Requirements
+
+### Requirement: Login
+`
+	basePath := filepath.Join(tmpDir, "spec.md")
+	deltaPath := filepath.Join(tmpDir, "delta.md")
+	if err := os.WriteFile(basePath, []byte(baseContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(deltaPath, []byte(deltaContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	merged, _, err := MergeSpec(basePath, deltaPath, true)
+	if err != nil {
+		t.Fatalf("MergeSpec failed: %v", err)
+	}
+
+	if !strings.Contains(merged, "### Sessions\n\n### Tokens\n\n### Requirement: Token Refresh") {
+		t.Errorf("Group headings should be preserved:\n%s", merged)
+	}
+	if strings.Contains(merged, "Requirement: Login") {
+		t.Errorf("Removed requirement should be gone:\n%s", merged)
+	}
+}
+
+func TestMergeSpec_RequirementsOutsideSection(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	baseContent := `# Auth Specification
+
+## Purpose
+Authentication.
+
+## Requirements
+
+### Requirement: Login
+The system SHALL log users in.
+
+#### Scenario: Login
+- **WHEN** credentials are valid
+- **THEN** the user is logged in
+
+## Notes
+
+### Requirement: Legacy
+The system SHALL accept legacy tokens.
+`
+	deltaContent := `## ADDED Requirements
+
+### Requirement: Logout
+The system SHALL log users out.
+
+#### Scenario: Logout
+- **WHEN** the user logs out
+- **THEN** the session ends
+`
+	basePath := filepath.Join(tmpDir, "spec.md")
+	deltaPath := filepath.Join(tmpDir, "delta.md")
+	if err := os.WriteFile(basePath, []byte(baseContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(deltaPath, []byte(deltaContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	merged, _, err := MergeSpec(basePath, deltaPath, true)
+	if err != nil {
+		t.Fatalf("MergeSpec failed: %v", err)
+	}
+
+	if n := strings.Count(merged, "### Requirement: Legacy"); n != 1 {
+		t.Errorf("Legacy should appear once, got %d:\n%s", n, merged)
+	}
+	if !strings.Contains(merged, "## Notes\n\n### Requirement: Legacy") {
+		t.Errorf("Legacy should stay under Notes:\n%s", merged)
+	}
+	if strings.Index(merged, "Requirement: Logout") > strings.Index(merged, "## Notes") {
+		t.Errorf("Logout should be added to the Requirements section:\n%s", merged)
+	}
+}
@@ -2,9 +2,10 @@ package archive
 
 // SpecUpdate represents a spec file to update during archive
 type SpecUpdate struct {
-	Source string // Path to delta spec in change
-	Target string // Path to main spec in spectr/specs
-	Exists bool   // Does target spec already exist?
+	Source     string // Path to delta spec in change
+	Target     string // Path to main spec in spectr/specs
+	Capability string // Capability ID, e.g. "auth" or "api/auth"
+	Exists     bool   // Does target spec already exist?
 }
 
 // OperationCounts tracks the number of each delta operation applied
@@ -13,9 +14,26 @@ type OperationCounts struct {
 	Modified int
 	Removed  int
 	Renamed  int
+	// Scenarios counts scenario-level operations on existing requirements
+	Scenarios int
+	// Links counts requirement links rewritten to renamed requirements;
+	// it is not part of the total
+	Links int
 }
 
 // Add increments the total operation count
 func (oc *OperationCounts) Total() int {
-	return oc.Added + oc.Modified + oc.Removed + oc.Renamed
+	return oc.Added + oc.Modified + oc.Removed + oc.Renamed + oc.Scenarios
+}
+
+// plus returns the sum of oc and other
+func (oc OperationCounts) plus(other OperationCounts) OperationCounts {
+	return OperationCounts{
+		Added:     oc.Added + other.Added,
+		Modified:  oc.Modified + other.Modified,
+		Removed:   oc.Removed + other.Removed,
+		Renamed:   oc.Renamed + other.Renamed,
+		Scenarios: oc.Scenarios + other.Scenarios,
+		Links:     oc.Links + other.Links,
+	}
 }
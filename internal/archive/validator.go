@@ -117,7 +117,12 @@ func CheckDuplicatesAndConflicts(deltaPlan *parsers.DeltaPlan) error {
 	nameSets := buildNameSets(deltaPlan)
 
 	// Check for cross-section conflicts
-	return checkCrossSectionConflicts(nameSets)
+	if err := checkCrossSectionConflicts(nameSets); err != nil {
+		return err
+	}
+
+	// Check scenario deltas against whole-requirement operations
+	return validation.CheckScenarioDeltaConflicts(deltaPlan)
 }
 
 type nameSets struct {
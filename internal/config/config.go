@@ -0,0 +1,191 @@
+// Package config loads spectr settings from the user config file and
+// the project config file. Both are JSON:
+//
+//	~/.config/spectr/config.json   (user, see os.UserConfigDir)
+//	<project>/spectr/config.json   (project)
+//
+// Project settings override user settings. Secrets such as forge tokens
+// belong in the user file, which is never committed; a project file
+// with tokens is rejected.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+)
+
+// FileName is the name of the user and project config files
+const FileName = "config.json"
+
+// Config holds spectr settings
+type Config struct {
+	// Tokens maps forge hosts such as "github.com" to API tokens. Only
+	// the user config may set it.
+	Tokens map[string]string `json:"tokens,omitempty"`
+	// Platforms maps forge hosts to the platform they run, such as
+	// "git.example.com": "bitbucket", for hosts whose name does not
+	// reveal it
+	Platforms map[string]string `json:"platforms,omitempty"`
+	// Remote is the git remote pull requests are pushed to; empty means
+	// origin
+	Remote string `json:"remote,omitempty"`
+	// PR holds defaults of the pull requests spectr opens
+	PR PR `json:"pr"`
+}
+
+// PR holds defaults of the pull requests spectr opens. Command-line
+// flags take precedence.
+type PR struct {
+	// Base is the target branch; empty means the default branch
+	Base string `json:"base,omitempty"`
+	// Draft opens pull requests as drafts
+	Draft *bool `json:"draft,omitempty"`
+	// Labels are added to every pull request
+	Labels []string `json:"labels,omitempty"`
+	// Reviewers are requested on every pull request
+	Reviewers []string `json:"reviewers,omitempty"`
+	// TitleTemplate is a Go template of the pull request title
+	TitleTemplate string `json:"titleTemplate,omitempty"`
+}
+
+// Apply fills the pull request options not set by flags from p
+func (p PR) Apply(base *string, labels, reviewers *[]string) {
+	if *base == "" {
+		*base = p.Base
+	}
+	if len(*labels) == 0 {
+		*labels = p.Labels
+	}
+	if len(*reviewers) == 0 {
+		*reviewers = p.Reviewers
+	}
+}
+
+// IsDraft reports whether pull requests are opened as drafts. flag is
+// the value of --draft or --no-draft, nil when neither was given, and
+// wins over the config in both directions.
+func (p PR) IsDraft(flag *bool) bool {
+	if flag != nil {
+		return *flag
+	}
+
+	return p.Draft != nil && *p.Draft
+}
+
+// UserDir returns the directory of the user config file
+func UserDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locate user config directory: %w", err)
+	}
+
+	return filepath.Join(dir, "spectr"), nil
+}
+
+// UserPath returns the path of the user config file
+func UserPath() (string, error) {
+	dir, err := UserDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, FileName), nil
+}
+
+// ProjectPath returns the path of the config file of the project at
+// projectRoot
+func ProjectPath(projectRoot string) string {
+	return filepath.Join(projectRoot, "spectr", FileName)
+}
+
+// Load reads the user config and the config of the project at
+// projectRoot. Missing files are not an error.
+func Load(projectRoot string) (*Config, error) {
+	cfg := &Config{}
+
+	// Without a home directory there is no user config
+	if userPath, err := UserPath(); err == nil {
+		if err := readFile(userPath, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	project := &Config{}
+	projectPath := ProjectPath(projectRoot)
+	if err := readFile(projectPath, project); err != nil {
+		return nil, err
+	}
+	// The project file is committed, so it must not hold secrets
+	if project.Tokens != nil {
+		return nil, fmt.Errorf(
+			"invalid config %s: tokens belong in the user config, not the project config",
+			projectPath,
+		)
+	}
+	cfg.merge(project)
+
+	return cfg, nil
+}
+
+// readFile decodes the config file at path into cfg, rejecting unknown
+// fields. A missing file leaves cfg unchanged.
+func readFile(path string, cfg *Config) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(cfg); err != nil {
+		return fmt.Errorf("invalid config %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// merge overrides the settings of c with those set in other
+func (c *Config) merge(other *Config) {
+	if len(other.Tokens) > 0 {
+		if c.Tokens == nil {
+			c.Tokens = make(map[string]string)
+		}
+		maps.Copy(c.Tokens, other.Tokens)
+	}
+	if len(other.Platforms) > 0 {
+		if c.Platforms == nil {
+			c.Platforms = make(map[string]string)
+		}
+		maps.Copy(c.Platforms, other.Platforms)
+	}
+	if other.Remote != "" {
+		c.Remote = other.Remote
+	}
+	c.PR.merge(other.PR)
+}
+
+// merge overrides the settings of p with those set in other
+func (p *PR) merge(other PR) {
+	if other.Base != "" {
+		p.Base = other.Base
+	}
+	if other.Draft != nil {
+		p.Draft = other.Draft
+	}
+	if other.Labels != nil {
+		p.Labels = other.Labels
+	}
+	if other.Reviewers != nil {
+		p.Reviewers = other.Reviewers
+	}
+	if other.TitleTemplate != "" {
+		p.TitleTemplate = other.TitleTemplate
+	}
+}
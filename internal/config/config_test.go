@@ -0,0 +1,142 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeConfig writes content to path, creating its directory
+func writeConfig(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	userDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", userDir)
+	projectRoot := t.TempDir()
+
+	writeConfig(t, filepath.Join(userDir, "spectr", FileName),
+		`{"tokens": {"github.com": "user-token", "gitlab.com": "gitlab-token"}, "remote": "upstream",
+		  "pr": {"draft": true, "labels": ["user"], "reviewers": ["alice"]}}`)
+	writeConfig(t, ProjectPath(projectRoot),
+		`{"platforms": {"git.example.com": "bitbucket"},
+		  "pr": {"base": "develop", "draft": false, "labels": ["spectr"]}}`)
+
+	cfg, err := Load(projectRoot)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.Tokens["github.com"] != "user-token" || cfg.Tokens["gitlab.com"] != "gitlab-token" {
+		t.Errorf("Unexpected tokens: %v", cfg.Tokens)
+	}
+	if cfg.Platforms["git.example.com"] != "bitbucket" {
+		t.Errorf("Unexpected platforms: %v", cfg.Platforms)
+	}
+	if cfg.Remote != "upstream" {
+		t.Errorf("Expected the user remote, got %q", cfg.Remote)
+	}
+	pr := cfg.PR
+	if pr.Base != "develop" || pr.Draft == nil || *pr.Draft ||
+		len(pr.Labels) != 1 || pr.Labels[0] != "spectr" ||
+		len(pr.Reviewers) != 1 || pr.Reviewers[0] != "alice" {
+		t.Errorf("Unexpected PR defaults: %+v", pr)
+	}
+}
+
+func TestLoad_ProjectTokens(t *testing.T) {
+	userDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", userDir)
+	writeConfig(t, filepath.Join(userDir, "spectr", FileName), `{"tokens": {"github.com": "user-token"}}`)
+
+	for _, content := range []string{`{"tokens": {"github.com": "project-token"}}`, `{"tokens": {}}`} {
+		projectRoot := t.TempDir()
+		writeConfig(t, ProjectPath(projectRoot), content)
+
+		_, err := Load(projectRoot)
+		if err == nil || !strings.Contains(err.Error(), "tokens belong in the user config") {
+			t.Errorf("Expected project tokens to be rejected for %s, got %v", content, err)
+		}
+	}
+}
+
+func TestLoad_MissingFiles(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg, err := Load(t.TempDir())
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(cfg.Tokens) != 0 {
+		t.Errorf("Expected an empty config, got %+v", cfg)
+	}
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	for _, content := range []string{`{"tokenz": {}}`, `{"tokens": [`} {
+		projectRoot := t.TempDir()
+		writeConfig(t, ProjectPath(projectRoot), content)
+
+		_, err := Load(projectRoot)
+		if err == nil || !strings.Contains(err.Error(), "invalid config") {
+			t.Errorf("Expected invalid config error for %s, got %v", content, err)
+		}
+	}
+}
+
+func TestPRApply(t *testing.T) {
+	draft := true
+	defaults := PR{
+		Base:      "develop",
+		Draft:     &draft,
+		Labels:    []string{"spectr"},
+		Reviewers: []string{"alice"},
+	}
+
+	base := ""
+	var labels, reviewers []string
+	defaults.Apply(&base, &labels, &reviewers)
+	if base != "develop" || labels[0] != "spectr" || reviewers[0] != "alice" {
+		t.Errorf("Defaults not applied: %s %v %v", base, labels, reviewers)
+	}
+
+	base, labels, reviewers = "main", []string{"flag"}, []string{"bob"}
+	defaults.Apply(&base, &labels, &reviewers)
+	if base != "main" || labels[0] != "flag" || reviewers[0] != "bob" {
+		t.Errorf("Flags overridden: %s %v %v", base, labels, reviewers)
+	}
+}
+
+func TestPRIsDraft(t *testing.T) {
+	yes, no := true, false
+
+	tests := []struct {
+		name   string
+		config *bool
+		flag   *bool
+		want   bool
+	}{
+		{"no config, no flag", nil, nil, false},
+		{"config only", &yes, nil, true},
+		{"--draft", nil, &yes, true},
+		{"--no-draft overrides config", &yes, &no, false},
+		{"--draft overrides config", &no, &yes, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (PR{Draft: tt.config}).IsDraft(tt.flag); got != tt.want {
+				t.Errorf("IsDraft() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
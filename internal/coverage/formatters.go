@@ -0,0 +1,96 @@
+package coverage
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// statusIndicators maps each status to its text-mode marker
+var statusIndicators = map[Status]string{
+	StatusPassing: "✓",
+	StatusFailing: "✗",
+	StatusSkipped: "~",
+	StatusNotRun:  "?",
+	StatusLinked:  "•",
+	StatusMissing: "-",
+}
+
+// FormatText formats a coverage report grouped by capability and
+// requirement, followed by a summary line.
+func FormatText(report *Report) string {
+	if len(report.Scenarios) == 0 {
+		return "No scenarios found"
+	}
+
+	var sb strings.Builder
+	var capability, requirement string
+	for _, sc := range report.Scenarios {
+		if sc.Capability != capability {
+			if capability != "" {
+				sb.WriteString("\n")
+			}
+			capability = sc.Capability
+			requirement = ""
+			fmt.Fprintf(&sb, "%s\n", capability)
+		}
+		if sc.Requirement != requirement {
+			requirement = sc.Requirement
+			fmt.Fprintf(&sb, "  %s\n", requirement)
+		}
+
+		fmt.Fprintf(
+			&sb,
+			"    %s %s [%s]",
+			statusIndicators[sc.Status],
+			sc.Scenario,
+			sc.Status,
+		)
+		if len(sc.Tests) > 0 {
+			names := make([]string, 0, len(sc.Tests))
+			for _, test := range sc.Tests {
+				names = append(names, test.Name())
+			}
+			fmt.Fprintf(&sb, " %s", strings.Join(names, ", "))
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(formatSummary(report.Summary))
+
+	return sb.String()
+}
+
+// formatSummary renders the one-line coverage summary
+func formatSummary(summary Summary) string {
+	percentage := 0
+	if summary.Total > 0 {
+		percentage = summary.Covered() * 100 / summary.Total
+	}
+
+	return fmt.Sprintf(
+		"%d/%d scenarios covered (%d%%): "+
+			"%d passing, %d failing, %d skipped, %d not run, "+
+			"%d linked, %d missing",
+		summary.Covered(),
+		summary.Total,
+		percentage,
+		summary.Passing,
+		summary.Failing,
+		summary.Skipped,
+		summary.NotRun,
+		summary.Linked,
+		summary.Missing,
+	)
+}
+
+// FormatJSON formats a coverage report as indented JSON
+func FormatJSON(report *Report) (string, error) {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	return string(data), nil
+}
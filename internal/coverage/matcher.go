@@ -0,0 +1,216 @@
+package coverage
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// capabilitySeparator splits "capability#Scenario" tag references
+const capabilitySeparator = "#"
+
+// BuildReport discovers all spec scenarios and Go tests under
+// projectPath, links them according to opts, and returns the report.
+func BuildReport(projectPath string, opts Options) (*Report, error) {
+	specIDs, err := discovery.GetSpecs(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover specs: %w", err)
+	}
+
+	for _, capability := range opts.Capabilities {
+		if !slices.Contains(specIDs, capability) {
+			return nil, fmt.Errorf("spec '%s' not found", capability)
+		}
+	}
+
+	tests, err := ScanTests(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan tests: %w", err)
+	}
+
+	convention := opts.Convention
+	if convention == "" {
+		convention = ConventionAny
+	}
+
+	report := &Report{Scenarios: make([]ScenarioCoverage, 0)}
+	for _, specID := range specIDs {
+		if len(opts.Capabilities) > 0 &&
+			!slices.Contains(opts.Capabilities, specID) {
+			continue
+		}
+
+		specPath := filepath.Join(
+			projectPath, "spectr", "specs", specID, "spec.md",
+		)
+		reqs, err := parsers.ParseRequirements(specPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", specPath, err)
+		}
+
+		for _, req := range reqs {
+			for _, scenario := range parsers.ParseScenarios(req.Raw) {
+				linked := linkTests(specID, scenario, tests, convention)
+				report.Scenarios = append(report.Scenarios, ScenarioCoverage{
+					Capability:  specID,
+					Requirement: req.Name,
+					Scenario:    scenario,
+					Status:      scenarioStatus(linked, opts.Results),
+					Tests:       linked,
+				})
+			}
+		}
+	}
+
+	report.Summary = summarize(report.Scenarios)
+
+	return report, nil
+}
+
+// linkTests returns all tests linked to a scenario by tag or convention
+func linkTests(
+	capability, scenario string,
+	tests []TestRef,
+	convention Convention,
+) []TestRef {
+	linked := make([]TestRef, 0)
+	scenarioKey := matchKey(scenario)
+	if scenarioKey == "" {
+		return linked
+	}
+
+	for _, test := range tests {
+		if tagMatches(capability, scenario, test.Tags) ||
+			nameMatches(scenarioKey, test, convention) {
+			linked = append(linked, test)
+		}
+	}
+
+	return linked
+}
+
+// tagMatches reports whether any explicit tag refers to the scenario.
+// Tags are either "Scenario Name" or "capability#Scenario Name".
+func tagMatches(capability, scenario string, tags []string) bool {
+	want := parsers.NormalizeRequirementName(scenario)
+	for _, tag := range tags {
+		name := tag
+		if tagCap, tagName, ok := strings.Cut(tag, capabilitySeparator); ok {
+			if strings.TrimSpace(tagCap) != capability {
+				continue
+			}
+			name = tagName
+		}
+		if parsers.NormalizeRequirementName(name) == want {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nameMatches reports whether a test name matches the scenario under
+// the given convention. Function names match when the scenario key
+// equals the name without the Test prefix, or any underscore-separated
+// suffix of it (TestMergeSpec_AddedOnly matches "Added only").
+func nameMatches(scenarioKey string, test TestRef, convention Convention) bool {
+	switch convention {
+	case ConventionTag:
+		return false
+	case ConventionFunc:
+		if test.Subtest != "" {
+			return false
+		}
+	case ConventionSubtest:
+		if test.Subtest == "" {
+			return false
+		}
+	case ConventionAny:
+	}
+
+	if test.Subtest != "" {
+		return matchKey(test.Subtest) == scenarioKey
+	}
+
+	parts := strings.Split(strings.TrimPrefix(test.Func, testFuncPrefix), "_")
+	for i := range parts {
+		if matchKey(strings.Join(parts[i:], "")) == scenarioKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchKey reduces a name to lowercase letters and digits so that
+// "Missing Purpose section", "MissingPurposeSection" and
+// "missing_purpose_section" compare equal.
+func matchKey(name string) string {
+	var sb strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(unicode.ToLower(r))
+		}
+	}
+
+	return sb.String()
+}
+
+// scenarioStatus derives a scenario's status from its linked tests
+func scenarioStatus(linked []TestRef, results Results) Status {
+	if len(linked) == 0 {
+		return StatusMissing
+	}
+	if results == nil {
+		return StatusLinked
+	}
+
+	var passed, skipped bool
+	for _, test := range linked {
+		switch results[test.Name()] {
+		case OutcomeFail:
+			return StatusFailing
+		case OutcomePass:
+			passed = true
+		case OutcomeSkip:
+			skipped = true
+		}
+	}
+
+	switch {
+	case passed:
+		return StatusPassing
+	case skipped:
+		return StatusSkipped
+	default:
+		return StatusNotRun
+	}
+}
+
+// summarize counts scenarios by status
+func summarize(scenarios []ScenarioCoverage) Summary {
+	summary := Summary{Total: len(scenarios)}
+	for _, sc := range scenarios {
+		switch sc.Status {
+		case StatusPassing:
+			summary.Passing++
+		case StatusFailing:
+			summary.Failing++
+		case StatusSkipped:
+			summary.Skipped++
+		case StatusNotRun:
+			summary.NotRun++
+		case StatusLinked:
+			summary.Linked++
+		case StatusMissing:
+			summary.Missing++
+		}
+	}
+
+	return summary
+}
@@ -0,0 +1,155 @@
+package coverage
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+const coverageSpec = `# Validation Specification
+
+## Purpose
+
+Validation purpose.
+
+## Requirements
+
+### Requirement: Spec File Validation
+The system SHALL validate specs.
+
+#### Scenario: Missing Purpose section
+- **WHEN** purpose is missing
+- **THEN** an error is reported
+
+#### Scenario: Valid spec
+- **WHEN** the spec is valid
+- **THEN** validation passes
+
+#### Scenario: Untested behavior
+- **WHEN** nobody wrote a test
+- **THEN** it is missing
+`
+
+const coverageTests = `package pkg
+
+import "testing"
+
+// spectr:scenario validation#Missing Purpose section
+func TestPurposeCheck(t *testing.T) {}
+
+func TestValidate(t *testing.T) {
+	t.Run("Valid spec", func(t *testing.T) {})
+}
+`
+
+func setupCoverageProject(t *testing.T) string {
+	t.Helper()
+	tmpDir := t.TempDir()
+	writeTestFile(
+		t,
+		filepath.Join(tmpDir, "spectr", "specs", "validation"),
+		"spec.md",
+		coverageSpec,
+	)
+	writeTestFile(t, filepath.Join(tmpDir, "pkg"), "pkg_test.go", coverageTests)
+
+	return tmpDir
+}
+
+func TestBuildReport(t *testing.T) {
+	projectPath := setupCoverageProject(t)
+
+	tests := []struct {
+		name       string
+		results    Results
+		convention Convention
+		want       map[string]Status
+	}{
+		{
+			name: "no results",
+			want: map[string]Status{
+				"Missing Purpose section": StatusLinked,
+				"Valid spec":              StatusLinked,
+				"Untested behavior":       StatusMissing,
+			},
+		},
+		{
+			name: "with results",
+			results: Results{
+				"TestPurposeCheck":        OutcomePass,
+				"TestValidate/Valid_spec": OutcomeFail,
+			},
+			want: map[string]Status{
+				"Missing Purpose section": StatusPassing,
+				"Valid spec":              StatusFailing,
+				"Untested behavior":       StatusMissing,
+			},
+		},
+		{
+			name:       "tag convention ignores names",
+			convention: ConventionTag,
+			results:    Results{},
+			want: map[string]Status{
+				"Missing Purpose section": StatusNotRun,
+				"Valid spec":              StatusMissing,
+				"Untested behavior":       StatusMissing,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := BuildReport(projectPath, Options{
+				Convention: tt.convention,
+				Results:    tt.results,
+			})
+			if err != nil {
+				t.Fatalf("BuildReport failed: %v", err)
+			}
+
+			if report.Summary.Total != len(tt.want) {
+				t.Errorf("Expected %d scenarios, got %d", len(tt.want), report.Summary.Total)
+			}
+			for _, sc := range report.Scenarios {
+				if sc.Status != tt.want[sc.Scenario] {
+					t.Errorf("Scenario %q: status %q, want %q",
+						sc.Scenario, sc.Status, tt.want[sc.Scenario])
+				}
+			}
+		})
+	}
+}
+
+func TestBuildReport_UnknownCapability(t *testing.T) {
+	projectPath := setupCoverageProject(t)
+
+	_, err := BuildReport(projectPath, Options{Capabilities: []string{"nope"}})
+	if err == nil {
+		t.Error("Expected error for unknown capability")
+	}
+}
+
+func TestNameMatches(t *testing.T) {
+	tests := []struct {
+		name       string
+		scenario   string
+		test       TestRef
+		convention Convention
+		want       bool
+	}{
+		{"func full name", "Added only", TestRef{Func: "TestAddedOnly"}, ConventionAny, true},
+		{"func suffix", "Added only", TestRef{Func: "TestMergeSpec_AddedOnly"}, ConventionAny, true},
+		{"func partial", "Added", TestRef{Func: "TestMergeSpec_AddedOnly"}, ConventionAny, false},
+		{"subtest", "Valid spec", TestRef{Func: "TestX", Subtest: "valid_spec"}, ConventionAny, true},
+		{"subtest only skips func", "Added only", TestRef{Func: "TestAddedOnly"}, ConventionSubtest, false},
+		{"func only skips subtest", "Valid spec", TestRef{Func: "TestX", Subtest: "Valid spec"}, ConventionFunc, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := nameMatches(matchKey(tt.scenario), tt.test, tt.convention)
+			if got != tt.want {
+				t.Errorf("nameMatches(%q, %+v) = %v, want %v", tt.scenario, tt.test, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package coverage
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Outcome is the final result of a single test run
+type Outcome string
+
+const (
+	// OutcomePass indicates the test passed
+	OutcomePass Outcome = "pass"
+	// OutcomeFail indicates the test failed
+	OutcomeFail Outcome = "fail"
+	// OutcomeSkip indicates the test was skipped
+	OutcomeSkip Outcome = "skip"
+)
+
+// Results maps go test names (Func or Func/Subtest) to their outcome
+type Results map[string]Outcome
+
+// testEvent mirrors the subset of `go test -json` events we consume
+type testEvent struct {
+	Action  string `json:"Action"`
+	Package string `json:"Package"`
+	Test    string `json:"Test"`
+}
+
+// ParseTestJSON reads `go test -json` output and records the final
+// outcome of every test. Non-JSON lines (e.g. build output) are ignored.
+// When the same test name appears in several packages a failure wins
+// over a skip, and a skip over a pass.
+func ParseTestJSON(r io.Reader) (Results, error) {
+	results := make(Results)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "{") {
+			continue
+		}
+
+		var event testEvent
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			continue
+		}
+		if event.Test == "" {
+			continue
+		}
+
+		outcome := Outcome(event.Action)
+		switch outcome {
+		case OutcomePass, OutcomeFail, OutcomeSkip:
+			results.record(event.Test, outcome)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read test results: %w", err)
+	}
+
+	return results, nil
+}
+
+// record stores an outcome, keeping the most severe one per name
+func (r Results) record(name string, outcome Outcome) {
+	if outcomeRank(outcome) >= outcomeRank(r[name]) {
+		r[name] = outcome
+	}
+}
+
+// outcomeRank orders outcomes by severity
+func outcomeRank(outcome Outcome) int {
+	switch outcome {
+	case OutcomeFail:
+		return 3
+	case OutcomeSkip:
+		return 2
+	case OutcomePass:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,40 @@
+package coverage
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseTestJSON(t *testing.T) {
+	input := `{"Action":"run","Package":"a","Test":"TestFoo"}
+{"Action":"pass","Package":"a","Test":"TestFoo"}
+{"Action":"fail","Package":"a","Test":"TestBar/sub_case"}
+{"Action":"skip","Package":"a","Test":"TestBaz"}
+{"Action":"pass","Package":"b","Test":"TestBaz"}
+{"Action":"pass","Package":"a"}
+# build output that is not JSON
+`
+
+	results, err := ParseTestJSON(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseTestJSON failed: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		want Outcome
+	}{
+		{"TestFoo", OutcomePass},
+		{"TestBar/sub_case", OutcomeFail},
+		{"TestBaz", OutcomeSkip},
+	}
+	for _, tt := range tests {
+		if got := results[tt.name]; got != tt.want {
+			t.Errorf("results[%q] = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	if len(results) != len(tests) {
+		t.Errorf("Expected %d results, got %d", len(tests), len(results))
+	}
+}
@@ -0,0 +1,220 @@
+package coverage
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// scenarioTagPrefix marks an explicit scenario link in a test comment
+	scenarioTagPrefix = "spectr:scenario"
+	testFileSuffix    = "_test.go"
+	testFuncPrefix    = "Test"
+)
+
+// skippedDirs are directory names never descended into when scanning
+var skippedDirs = map[string]bool{
+	".git":         true,
+	"vendor":       true,
+	"node_modules": true,
+	"testdata":     true,
+}
+
+// ScanTests walks projectPath for *_test.go files and returns every
+// top-level test function and statically named subtest it declares.
+func ScanTests(projectPath string) ([]TestRef, error) {
+	var refs []TestRef
+
+	err := filepath.WalkDir(
+		projectPath,
+		func(path string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if path != projectPath && (skippedDirs[d.Name()] ||
+					strings.HasPrefix(d.Name(), ".")) {
+					return filepath.SkipDir
+				}
+
+				return nil
+			}
+			if !strings.HasSuffix(d.Name(), testFileSuffix) {
+				return nil
+			}
+
+			relPath, err := filepath.Rel(projectPath, path)
+			if err != nil {
+				relPath = path
+			}
+
+			fileRefs, err := scanTestFile(path, filepath.ToSlash(relPath))
+			if err != nil {
+				// Unparseable test files are skipped, not fatal
+				return nil
+			}
+			refs = append(refs, fileRefs...)
+
+			return nil
+		},
+	)
+
+	return refs, err
+}
+
+// scanTestFile parses a single test file and extracts test references
+func scanTestFile(path, relPath string) ([]TestRef, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []TestRef
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || !isTestFunc(fn) {
+			continue
+		}
+
+		funcRef := TestRef{
+			Func: fn.Name.Name,
+			File: relPath,
+			Line: fset.Position(fn.Pos()).Line,
+			Tags: tagsInRange(file.Comments, fn.Pos(), fn.End()),
+		}
+		if fn.Doc != nil {
+			funcRef.Tags = append(funcRef.Tags, tagsFromGroup(fn.Doc)...)
+		}
+		refs = append(refs, funcRef)
+		refs = append(refs, subtestsOf(fset, fn, funcRef)...)
+	}
+
+	return refs, nil
+}
+
+// isTestFunc reports whether fn is a top-level Go test function
+func isTestFunc(fn *ast.FuncDecl) bool {
+	if fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, testFuncPrefix) {
+		return false
+	}
+
+	params := fn.Type.Params.List
+	if len(params) != 1 {
+		return false
+	}
+
+	star, ok := params[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := star.X.(*ast.SelectorExpr)
+
+	return ok && sel.Sel.Name == "T"
+}
+
+// subtestsOf collects statically named subtests within a test function:
+// t.Run("literal", ...) calls and `name: "literal"` fields of table
+// entries, which conventionally become t.Run(tt.name, ...) subtests.
+func subtestsOf(
+	fset *token.FileSet,
+	fn *ast.FuncDecl,
+	parent TestRef,
+) []TestRef {
+	var refs []TestRef
+	seen := make(map[string]bool)
+
+	add := func(name string, pos token.Pos) {
+		if name == "" || seen[name] {
+			return
+		}
+		seen[name] = true
+		refs = append(refs, TestRef{
+			Func:    parent.Func,
+			Subtest: name,
+			File:    parent.File,
+			Line:    fset.Position(pos).Line,
+		})
+	}
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.CallExpr:
+			sel, ok := node.Fun.(*ast.SelectorExpr)
+			if ok && sel.Sel.Name == "Run" && len(node.Args) == 2 {
+				add(stringLiteral(node.Args[0]), node.Pos())
+			}
+		case *ast.KeyValueExpr:
+			key, ok := node.Key.(*ast.Ident)
+			if ok && strings.EqualFold(key.Name, "name") {
+				add(stringLiteral(node.Value), node.Pos())
+			}
+		}
+
+		return true
+	})
+
+	return refs
+}
+
+// stringLiteral returns the value of a string literal expression,
+// or "" when expr is not a string literal
+func stringLiteral(expr ast.Expr) string {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return ""
+	}
+
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return ""
+	}
+
+	return value
+}
+
+// tagsInRange returns scenario tags from comments between start and end
+func tagsInRange(
+	groups []*ast.CommentGroup,
+	start, end token.Pos,
+) []string {
+	var tags []string
+	for _, group := range groups {
+		if group.Pos() < start || group.End() > end {
+			continue
+		}
+		tags = append(tags, tagsFromGroup(group)...)
+	}
+
+	return tags
+}
+
+// tagsFromGroup extracts `spectr:scenario <ref>` values from a comment group
+func tagsFromGroup(group *ast.CommentGroup) []string {
+	var tags []string
+	for _, comment := range group.List {
+		text := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
+		text = strings.TrimSpace(strings.TrimPrefix(text, "/*"))
+		text = strings.TrimSpace(strings.TrimSuffix(text, "*/"))
+		if !strings.HasPrefix(text, scenarioTagPrefix) {
+			continue
+		}
+		ref := strings.TrimSpace(strings.TrimPrefix(text, scenarioTagPrefix))
+		if ref != "" {
+			tags = append(tags, ref)
+		}
+	}
+
+	return tags
+}
+
+// goTestSubtestName rewrites a subtest name the way the testing package
+// does when reporting it: spaces become underscores.
+func goTestSubtestName(name string) string {
+	return strings.ReplaceAll(name, " ", "_")
+}
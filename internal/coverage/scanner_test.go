@@ -0,0 +1,93 @@
+package coverage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testDirPerm = 0o755
+
+func writeTestFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, testDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestScanTests(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeTestFile(t, filepath.Join(tmpDir, "pkg"), "foo_test.go", `package pkg
+
+import "testing"
+
+// spectr:scenario validation#Missing Purpose section
+func TestMissingPurpose(t *testing.T) {}
+
+func TestTable(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{name: "Valid spec"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {})
+	}
+	t.Run("Explicit subtest", func(t *testing.T) {
+		// spectr:scenario Inner tag
+	})
+}
+
+func helper(t *testing.T) {}
+
+func BenchmarkFoo(b *testing.B) {}
+`)
+	// Files under testdata must be ignored
+	writeTestFile(t, filepath.Join(tmpDir, "testdata"), "bar_test.go", `package x
+
+import "testing"
+
+func TestIgnored(t *testing.T) {}
+`)
+
+	refs, err := ScanTests(tmpDir)
+	if err != nil {
+		t.Fatalf("ScanTests failed: %v", err)
+	}
+
+	names := make(map[string]TestRef)
+	for _, ref := range refs {
+		names[ref.Name()] = ref
+	}
+
+	expected := []string{
+		"TestMissingPurpose",
+		"TestTable",
+		"TestTable/Valid_spec",
+		"TestTable/Explicit_subtest",
+	}
+	if len(refs) != len(expected) {
+		t.Errorf("Expected %d refs, got %d: %v", len(expected), len(refs), refs)
+	}
+	for _, name := range expected {
+		if _, ok := names[name]; !ok {
+			t.Errorf("Expected test %q to be found", name)
+		}
+	}
+
+	purpose := names["TestMissingPurpose"]
+	if len(purpose.Tags) != 1 || purpose.Tags[0] != "validation#Missing Purpose section" {
+		t.Errorf("Unexpected tags for TestMissingPurpose: %v", purpose.Tags)
+	}
+	if purpose.File != "pkg/foo_test.go" {
+		t.Errorf("Expected relative file path, got %q", purpose.File)
+	}
+
+	table := names["TestTable"]
+	if len(table.Tags) != 1 || table.Tags[0] != "Inner tag" {
+		t.Errorf("Expected body tag on TestTable, got %v", table.Tags)
+	}
+}
@@ -0,0 +1,109 @@
+// Package coverage links spec scenarios to Go tests and reports which
+// scenarios have passing, failing, or missing tests.
+//
+// Scenarios are matched to tests either by naming convention (the
+// scenario name normalized against test function or t.Run subtest names)
+// or by explicit tags in test comments:
+//
+//	// spectr:scenario validation#Missing Purpose section
+//
+// Test outcomes are optionally taken from `go test -json` output.
+package coverage
+
+// Status represents the coverage status of a single scenario
+type Status string
+
+const (
+	// StatusPassing indicates all linked tests passed
+	StatusPassing Status = "passing"
+	// StatusFailing indicates at least one linked test failed
+	StatusFailing Status = "failing"
+	// StatusSkipped indicates linked tests ran but were all skipped
+	StatusSkipped Status = "skipped"
+	// StatusNotRun indicates linked tests exist but none appear in results
+	StatusNotRun Status = "not-run"
+	// StatusLinked indicates linked tests exist and no results were supplied
+	StatusLinked Status = "linked"
+	// StatusMissing indicates no test is linked to the scenario
+	StatusMissing Status = "missing"
+)
+
+// Convention controls which test names are considered when matching
+// scenarios by name. Explicit tags are always honored.
+type Convention string
+
+const (
+	// ConventionAny matches test function names and subtest names
+	ConventionAny Convention = "any"
+	// ConventionFunc matches only top-level test function names
+	ConventionFunc Convention = "func"
+	// ConventionSubtest matches only t.Run and table-driven subtest names
+	ConventionSubtest Convention = "subtest"
+	// ConventionTag disables name matching, only explicit tags link tests
+	ConventionTag Convention = "tag"
+)
+
+// TestRef identifies a single Go test or subtest found in source
+type TestRef struct {
+	// Func is the top-level test function name (e.g. TestParseDelta)
+	Func string `json:"func"`
+	// Subtest is the t.Run name, empty for the function itself
+	Subtest string `json:"subtest,omitempty"`
+	// File is the test file path relative to the project root
+	File string `json:"file"`
+	// Line is the 1-indexed line where the test or subtest is declared
+	Line int `json:"line"`
+	// Tags holds scenario references from spectr:scenario comments
+	Tags []string `json:"-"`
+}
+
+// Name returns the test name as reported by `go test` (Func/Subtest
+// with spaces replaced by underscores)
+func (r TestRef) Name() string {
+	if r.Subtest == "" {
+		return r.Func
+	}
+
+	return r.Func + "/" + goTestSubtestName(r.Subtest)
+}
+
+// ScenarioCoverage is the coverage result for a single scenario
+type ScenarioCoverage struct {
+	Capability  string    `json:"capability"`
+	Requirement string    `json:"requirement"`
+	Scenario    string    `json:"scenario"`
+	Status      Status    `json:"status"`
+	Tests       []TestRef `json:"tests"`
+}
+
+// Summary aggregates scenario counts by status
+type Summary struct {
+	Total   int `json:"total"`
+	Passing int `json:"passing"`
+	Failing int `json:"failing"`
+	Skipped int `json:"skipped"`
+	NotRun  int `json:"notRun"`
+	Linked  int `json:"linked"`
+	Missing int `json:"missing"`
+}
+
+// Covered returns the number of scenarios with at least one linked test
+func (s Summary) Covered() int {
+	return s.Total - s.Missing
+}
+
+// Report is the complete coverage report for a project
+type Report struct {
+	Scenarios []ScenarioCoverage `json:"scenarios"`
+	Summary   Summary            `json:"summary"`
+}
+
+// Options configures how a coverage report is built
+type Options struct {
+	// Capabilities restricts the report to these spec IDs (empty = all)
+	Capabilities []string
+	// Convention controls name-based matching
+	Convention Convention
+	// Results holds parsed `go test -json` outcomes (nil = not supplied)
+	Results Results
+}
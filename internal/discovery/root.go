@@ -0,0 +1,149 @@
+package discovery
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// RootEnv names the environment variable that overrides project root
+// discovery when no root was set explicitly
+const RootEnv = "SPECTR_ROOT"
+
+// explicitRoot is the project root set through SetRoot
+var explicitRoot string
+
+// skippedWorkspaceDirs are never searched for spectr roots
+var skippedWorkspaceDirs = map[string]bool{
+	"node_modules": true,
+	"vendor":       true,
+}
+
+// IsRoot reports whether dir is a project root: it holds a spectr/
+// directory with a project.md, specs/ or changes/ inside
+func IsRoot(dir string) bool {
+	for _, name := range []string{"project.md", "specs", "changes"} {
+		if _, err := os.Stat(filepath.Join(dir, "spectr", name)); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FindRoot returns the nearest directory at or above start that is a
+// project root. When there is none, start itself is returned so that
+// commands report a missing spectr/ directory where they were run.
+func FindRoot(start string) string {
+	for dir := start; ; {
+		if IsRoot(dir) {
+			return dir
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return start
+		}
+		dir = parent
+	}
+}
+
+// SetRoot sets the project root ProjectRoot resolves to, as given by
+// the global --root flag. An empty root restores discovery.
+func SetRoot(root string) {
+	explicitRoot = root
+}
+
+// ProjectRoot resolves the project root commands operate on: the
+// directory set by SetRoot, else the one given by SPECTR_ROOT, otherwise
+// the nearest root above the working directory
+func ProjectRoot() (string, error) {
+	if explicitRoot != "" {
+		abs, err := filepath.Abs(explicitRoot)
+		if err != nil {
+			return "", fmt.Errorf("resolve --root: %w", err)
+		}
+
+		return abs, nil
+	}
+	if root := os.Getenv(RootEnv); root != "" {
+		abs, err := filepath.Abs(root)
+		if err != nil {
+			return "", fmt.Errorf("resolve %s: %w", RootEnv, err)
+		}
+
+		return abs, nil
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current directory: %w", err)
+	}
+
+	return FindRoot(wd), nil
+}
+
+// WorkspaceRoot returns the top of the repository containing start,
+// i.e. the nearest ancestor with a .git entry. Without one, start is
+// the workspace.
+func WorkspaceRoot(start string) string {
+	for dir := start; ; {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return dir
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return start
+		}
+		dir = parent
+	}
+}
+
+// FindRoots returns every project root at or below workspace, sorted.
+// Hidden directories, node_modules and vendor are not searched.
+func FindRoots(workspace string) ([]string, error) {
+	var roots []string
+	err := filepath.WalkDir(workspace, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		name := d.Name()
+		if path != workspace &&
+			(strings.HasPrefix(name, ".") || skippedWorkspaceDirs[name]) {
+			return filepath.SkipDir
+		}
+
+		// A root's own spectr/ directory holds no further roots
+		if name == "spectr" && IsRoot(filepath.Dir(path)) {
+			return filepath.SkipDir
+		}
+
+		if IsRoot(path) {
+			roots = append(roots, path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search workspace: %w", err)
+	}
+
+	sort.Strings(roots)
+
+	return roots, nil
+}
+
+// RootLabel returns the path of root relative to workspace with forward
+// slashes, or "." for the workspace itself. Workspace mode prefixes
+// item IDs with it, e.g. "services/api:add-auth".
+func RootLabel(workspace, root string) string {
+	return CapabilityID(workspace, root)
+}
@@ -0,0 +1,121 @@
+package discovery
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// createRoot creates a project root with a spectr/project.md below dir
+func createRoot(t *testing.T, dir string) {
+	t.Helper()
+	spectrDir := filepath.Join(dir, "spectr")
+	if err := os.MkdirAll(spectrDir, testDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(
+		filepath.Join(spectrDir, "project.md"),
+		[]byte("# Project"),
+		testFilePerm,
+	); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFindRoot(t *testing.T) {
+	tmpDir := t.TempDir()
+	service := filepath.Join(tmpDir, "services", "api")
+	createRoot(t, service)
+
+	nested := filepath.Join(service, "internal", "handlers")
+	if err := os.MkdirAll(nested, testDirPerm); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := FindRoot(nested); got != service {
+		t.Errorf("FindRoot(%s) = %s, want %s", nested, got, service)
+	}
+	if got := FindRoot(service); got != service {
+		t.Errorf("FindRoot(%s) = %s, want %s", service, got, service)
+	}
+
+	// Without a root above, the start directory is returned
+	other := filepath.Join(tmpDir, "services")
+	if got := FindRoot(other); got != other {
+		t.Errorf("FindRoot(%s) = %s, want %s", other, got, other)
+	}
+}
+
+func TestProjectRoot_Env(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv(RootEnv, tmpDir)
+
+	root, err := ProjectRoot()
+	if err != nil {
+		t.Fatalf("ProjectRoot failed: %v", err)
+	}
+	if root != tmpDir {
+		t.Errorf("Expected %s, got %s", tmpDir, root)
+	}
+}
+
+func TestProjectRoot_SetRoot(t *testing.T) {
+	explicit := t.TempDir()
+	t.Setenv(RootEnv, t.TempDir())
+	SetRoot(explicit)
+	t.Cleanup(func() { SetRoot("") })
+
+	root, err := ProjectRoot()
+	if err != nil {
+		t.Fatalf("ProjectRoot failed: %v", err)
+	}
+	if root != explicit {
+		t.Errorf("Expected the explicit root %s over %s, got %s", explicit, RootEnv, root)
+	}
+}
+
+func TestFindRoots(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".git"), testDirPerm); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dir := range []string{
+		".",
+		"services/api",
+		"services/billing",
+		"node_modules/pkg",
+		".cache/old",
+	} {
+		createRoot(t, filepath.Join(tmpDir, filepath.FromSlash(dir)))
+	}
+
+	// A spectr/ directory without project.md, specs/ or changes/ is no root
+	if err := os.MkdirAll(filepath.Join(tmpDir, "tools", "spectr"), testDirPerm); err != nil {
+		t.Fatal(err)
+	}
+
+	nested := filepath.Join(tmpDir, "services", "api", "internal")
+	if err := os.MkdirAll(nested, testDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	workspace := WorkspaceRoot(nested)
+	if workspace != tmpDir {
+		t.Fatalf("WorkspaceRoot(%s) = %s, want %s", nested, workspace, tmpDir)
+	}
+
+	roots, err := FindRoots(workspace)
+	if err != nil {
+		t.Fatalf("FindRoots failed: %v", err)
+	}
+
+	var labels []string
+	for _, root := range roots {
+		labels = append(labels, RootLabel(workspace, root))
+	}
+	want := []string{".", "services/api", "services/billing"}
+	if !slices.Equal(labels, want) {
+		t.Errorf("Expected roots %v, got %v", want, labels)
+	}
+}
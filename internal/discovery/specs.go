@@ -2,13 +2,16 @@ package discovery
 
 import (
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 )
 
-// GetSpecs finds all specs in spectr/specs/ that contain spec.md
+// GetSpecs finds all specs in spectr/specs/ that contain spec.md.
+// Capabilities may be nested: specs/api/auth/spec.md is returned as
+// "api/auth", always with forward slashes.
 func GetSpecs(projectPath string) ([]string, error) {
 	specsDir := filepath.Join(projectPath, "spectr", "specs")
 
@@ -17,28 +20,29 @@ func GetSpecs(projectPath string) ([]string, error) {
 		return make([]string, 0), nil
 	}
 
-	entries, err := os.ReadDir(specsDir)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read specs directory: %w", err)
-	}
-
 	var specs []string
-	for _, entry := range entries {
-		// Skip non-directories
-		if !entry.IsDir() {
-			continue
+	err := filepath.WalkDir(specsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() || path == specsDir {
+			return nil
 		}
 
 		// Skip hidden directories
-		if strings.HasPrefix(entry.Name(), ".") {
-			continue
+		if strings.HasPrefix(d.Name(), ".") {
+			return filepath.SkipDir
 		}
 
 		// Check if spec.md exists
-		specPath := filepath.Join(specsDir, entry.Name(), "spec.md")
-		if _, err := os.Stat(specPath); err == nil {
-			specs = append(specs, entry.Name())
+		if _, err := os.Stat(filepath.Join(path, "spec.md")); err == nil {
+			specs = append(specs, CapabilityID(specsDir, path))
 		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read specs directory: %w", err)
 	}
 
 	// Sort alphabetically for consistency
@@ -47,9 +51,22 @@ func GetSpecs(projectPath string) ([]string, error) {
 	return specs, nil
 }
 
-// GetSpecIDs returns a list of spec IDs (directory names under spectr/specs/)
+// GetSpecIDs returns a list of spec IDs (paths under spectr/specs/ of
+// the directories holding a spec.md, e.g. "auth" or "api/auth")
 // Returns empty slice (not error) if the directory doesn't exist
 // Results are sorted alphabetically for consistency
 func GetSpecIDs(projectRoot string) ([]string, error) {
 	return GetSpecs(projectRoot)
 }
+
+// CapabilityID returns the capability ID of a capability directory
+// below specsDir, e.g. "api/auth" for specs/api/auth. It works for both
+// spectr/specs and the specs directory of a change.
+func CapabilityID(specsDir, capabilityDir string) string {
+	rel, err := filepath.Rel(specsDir, capabilityDir)
+	if err != nil {
+		return filepath.ToSlash(filepath.Base(capabilityDir))
+	}
+
+	return filepath.ToSlash(rel)
+}
@@ -3,6 +3,7 @@ package discovery
 import (
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 )
 
@@ -206,3 +207,57 @@ func TestGetSpecIDs_MissingSpecMd(t *testing.T) {
 		t.Errorf("Expected 0 specs (no spec.md), got %d", len(specs))
 	}
 }
+
+func TestGetSpecs_Nested(t *testing.T) {
+	tmpDir := t.TempDir()
+	specsDir := filepath.Join(tmpDir, "spectr", "specs")
+
+	// "api" holds a spec of its own as well as nested capabilities;
+	// "platform" only groups nested capabilities
+	for _, name := range []string{
+		"api",
+		"api/auth",
+		"api/billing/invoices",
+		"platform/storage",
+		"platform/.drafts/queue",
+	} {
+		specDir := filepath.Join(specsDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(specDir, testDirPerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(
+			filepath.Join(specDir, "spec.md"),
+			[]byte("# Test Spec"),
+			testFilePerm,
+		); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	specs, err := GetSpecs(tmpDir)
+	if err != nil {
+		t.Fatalf("GetSpecs failed: %v", err)
+	}
+
+	want := []string{"api", "api/auth", "api/billing/invoices", "platform/storage"}
+	if !slices.Equal(specs, want) {
+		t.Errorf("Expected %v, got %v", want, specs)
+	}
+}
+
+func TestCapabilityID(t *testing.T) {
+	specsDir := filepath.Join("spectr", "specs")
+	tests := []struct {
+		dir  string
+		want string
+	}{
+		{filepath.Join(specsDir, "auth"), "auth"},
+		{filepath.Join(specsDir, "api", "auth"), "api/auth"},
+	}
+
+	for _, tt := range tests {
+		if got := CapabilityID(specsDir, tt.dir); got != tt.want {
+			t.Errorf("CapabilityID(%q) = %q, want %q", tt.dir, got, tt.want)
+		}
+	}
+}
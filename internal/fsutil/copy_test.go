@@ -0,0 +1,33 @@
+package fsutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCopyDir(t *testing.T) {
+	src := t.TempDir()
+	for path, content := range map[string]string{
+		"proposal.md":        "proposal",
+		"specs/auth/spec.md": "delta",
+	} {
+		path = filepath.Join(src, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dst := filepath.Join(t.TempDir(), "change")
+	if err := CopyDir(src, dst); err != nil {
+		t.Fatalf("CopyDir failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dst, "specs", "auth", "spec.md"))
+	if err != nil || string(data) != "delta" {
+		t.Errorf("Unexpected copy: %q, %v", data, err)
+	}
+}
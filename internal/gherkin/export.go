@@ -0,0 +1,197 @@
+package gherkin
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/parsers"
+	"github.com/connerohnesorge/spectr/internal/validation"
+)
+
+const (
+	dirPerm  = 0o755
+	filePerm = 0o644
+
+	// FeatureExt is the file extension of Gherkin feature files
+	FeatureExt = ".feature"
+)
+
+// FeatureFromSpec builds a Feature from a spec.md file
+func FeatureFromSpec(specPath string) (*Feature, error) {
+	content, err := os.ReadFile(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", specPath, err)
+	}
+
+	title, err := parsers.ExtractTitle(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("read title of %s: %w", specPath, err)
+	}
+
+	reqs, err := parsers.ParseRequirements(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", specPath, err)
+	}
+
+	feature := &Feature{
+		Name:        title,
+		Description: validation.ExtractSections(string(content))["Purpose"],
+	}
+	for _, req := range reqs {
+		feature.Rules = append(feature.Rules, Rule{
+			Name:        req.Name,
+			Description: parsers.RequirementDescription(req.Raw),
+			Scenarios:   parsers.ParseScenarioBlocks(req.Raw),
+		})
+	}
+
+	return feature, nil
+}
+
+// Format renders a Feature as Gherkin text
+func Format(feature *Feature) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Feature: %s\n", feature.Name)
+	writeDescription(&sb, feature.Description, "  ")
+	writeBackground(&sb, feature.Background, "  ")
+
+	for _, scenario := range feature.Scenarios {
+		writeScenario(&sb, scenario, "  ")
+	}
+
+	for _, rule := range feature.Rules {
+		fmt.Fprintf(&sb, "\n  Rule: %s\n", rule.Name)
+		writeDescription(&sb, rule.Description, "    ")
+		writeBackground(&sb, rule.Background, "    ")
+		for _, scenario := range rule.Scenarios {
+			writeScenario(&sb, scenario, "    ")
+		}
+	}
+
+	return sb.String()
+}
+
+// writeDescription writes free-form description lines at an indent
+func writeDescription(sb *strings.Builder, description, indent string) {
+	if description == "" {
+		return
+	}
+
+	for line := range strings.SplitSeq(description, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			sb.WriteString("\n")
+
+			continue
+		}
+		fmt.Fprintf(sb, "%s%s\n", indent, line)
+	}
+}
+
+// writeBackground writes a Background block when steps are present
+func writeBackground(
+	sb *strings.Builder,
+	steps []parsers.ScenarioStep,
+	indent string,
+) {
+	if len(steps) == 0 {
+		return
+	}
+
+	fmt.Fprintf(sb, "\n%sBackground:\n", indent)
+	writeSteps(sb, steps, indent+"  ")
+}
+
+// writeScenario writes a Scenario block with its steps
+func writeScenario(
+	sb *strings.Builder,
+	scenario parsers.Scenario,
+	indent string,
+) {
+	fmt.Fprintf(sb, "\n%sScenario: %s\n", indent, scenario.Name)
+	writeSteps(sb, scenario.Steps, indent+"  ")
+}
+
+// writeSteps writes Given/When/Then step lines
+func writeSteps(
+	sb *strings.Builder,
+	steps []parsers.ScenarioStep,
+	indent string,
+) {
+	for _, step := range steps {
+		keyword, ok := stepKeywords[step.Keyword]
+		if !ok {
+			keyword = stepKeywords[""]
+		}
+		fmt.Fprintf(sb, "%s%s %s\n", indent, keyword, step.Text)
+	}
+}
+
+// ExportResult records a feature file written by Export
+type ExportResult struct {
+	Capability string
+	Path       string
+}
+
+// Export writes one .feature file per capability into outDir.
+// When capabilities is empty every spec is exported.
+func Export(
+	projectPath, outDir string,
+	capabilities []string,
+) ([]ExportResult, error) {
+	specIDs, err := discovery.GetSpecs(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover specs: %w", err)
+	}
+
+	for _, capability := range capabilities {
+		if !slices.Contains(specIDs, capability) {
+			return nil, fmt.Errorf("spec '%s' not found", capability)
+		}
+	}
+
+	if err := os.MkdirAll(outDir, dirPerm); err != nil {
+		return nil, fmt.Errorf("create output directory: %w", err)
+	}
+
+	var results []ExportResult
+	for _, specID := range specIDs {
+		if len(capabilities) > 0 && !slices.Contains(capabilities, specID) {
+			continue
+		}
+
+		specPath := filepath.Join(
+			projectPath, "spectr", "specs", specID, "spec.md",
+		)
+		feature, err := FeatureFromSpec(specPath)
+		if err != nil {
+			return nil, err
+		}
+
+		// Nested capabilities mirror their directories: api/auth is
+		// written to <outDir>/api/auth.feature
+		outPath := filepath.Join(outDir, specID+FeatureExt)
+		if err := os.MkdirAll(filepath.Dir(outPath), dirPerm); err != nil {
+			return nil, fmt.Errorf("create output directory: %w", err)
+		}
+		if err := os.WriteFile(
+			outPath,
+			[]byte(Format(feature)),
+			filePerm,
+		); err != nil {
+			return nil, fmt.Errorf("write %s: %w", outPath, err)
+		}
+
+		results = append(results, ExportResult{
+			Capability: specID,
+			Path:       outPath,
+		})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,91 @@
+package gherkin
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const exportSpec = `# Auth Specification
+
+## Purpose
+
+Authenticate users of the system.
+
+## Requirements
+
+### Requirement: User Login
+The system SHALL authenticate users.
+
+#### Scenario: Valid credentials
+- **GIVEN** a registered user
+- **WHEN** valid credentials are submitted
+- **THEN** a session is created
+- **AND** the user is redirected
+`
+
+func TestExport(t *testing.T) {
+	tmpDir := t.TempDir()
+	specDir := filepath.Join(tmpDir, "spectr", "specs", "auth")
+	if err := os.MkdirAll(specDir, dirPerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(specDir, "spec.md"), []byte(exportSpec), filePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	outDir := filepath.Join(tmpDir, "features")
+	results, err := Export(tmpDir, outDir, nil)
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	if len(results) != 1 || results[0].Capability != "auth" {
+		t.Fatalf("Unexpected results: %+v", results)
+	}
+
+	content, err := os.ReadFile(filepath.Join(outDir, "auth.feature"))
+	if err != nil {
+		t.Fatalf("Expected feature file: %v", err)
+	}
+
+	expected := `Feature: Auth Specification
+  Authenticate users of the system.
+
+  Rule: User Login
+    The system SHALL authenticate users.
+
+    Scenario: Valid credentials
+      Given a registered user
+      When valid credentials are submitted
+      Then a session is created
+      And the user is redirected
+`
+	if string(content) != expected {
+		t.Errorf("Unexpected feature content:\n%s\nwant:\n%s", content, expected)
+	}
+
+	if _, err := Export(tmpDir, outDir, []string{"missing"}); err == nil {
+		t.Error("Expected error for unknown capability")
+	}
+}
+
+func TestExportRoundTrip(t *testing.T) {
+	feature, err := Parse(strings.NewReader(Format(&Feature{
+		Name: "Round Trip",
+		Rules: []Rule{{
+			Name:        "Thing",
+			Description: "The system SHALL do the thing.",
+		}},
+	})))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if feature.Name != "Round Trip" || len(feature.Rules) != 1 {
+		t.Fatalf("Unexpected feature: %+v", feature)
+	}
+	if feature.Rules[0].Description != "The system SHALL do the thing." {
+		t.Errorf("Unexpected rule description: %q", feature.Rules[0].Description)
+	}
+}
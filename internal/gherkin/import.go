@@ -0,0 +1,431 @@
+package gherkin
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+var (
+	keywordLinePattern = regexp.MustCompile(
+		`^(Feature|Rule|Background|Scenario Outline|Scenario Template|` +
+			`Scenario|Example|Examples|Scenarios):\s*(.*)$`,
+	)
+	stepLinePattern = regexp.MustCompile(`^(Given|When|Then|And|But|\*)\s+(.+)$`)
+	docStringFence  = regexp.MustCompile("^(\"\"\"|```)")
+	nonSlugPattern  = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// parseState tracks where the parser currently is within a feature
+type parseState struct {
+	feature      *Feature
+	rule         *Rule
+	scenario     *parsers.Scenario
+	inBackground bool
+	inExamples   bool
+	inDocString  bool
+	description  *[]string
+}
+
+// Parse reads a Gherkin feature file. Tags, comments, data tables,
+// doc strings and Examples tables are ignored; Scenario Outlines are
+// treated as plain scenarios.
+func Parse(r io.Reader) (*Feature, error) {
+	state := &parseState{}
+	var featureDesc, ruleDesc []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if docStringFence.MatchString(line) {
+			state.inDocString = !state.inDocString
+
+			continue
+		}
+		if state.inDocString || line == "" ||
+			strings.HasPrefix(line, "#") ||
+			strings.HasPrefix(line, "@") ||
+			strings.HasPrefix(line, "|") {
+			continue
+		}
+
+		if matches := keywordLinePattern.FindStringSubmatch(line); matches != nil {
+			state.flushScenario()
+			name := strings.TrimSpace(matches[2])
+
+			switch matches[1] {
+			case "Feature":
+				state.feature = &Feature{Name: name}
+				featureDesc = nil
+				state.description = &featureDesc
+			case "Rule":
+				if err := state.requireFeature(); err != nil {
+					return nil, err
+				}
+				state.flushRule(&ruleDesc)
+				state.rule = &Rule{Name: name}
+				ruleDesc = nil
+				state.description = &ruleDesc
+			case "Background":
+				state.inBackground = true
+				state.description = nil
+			case "Examples", "Scenarios":
+				state.inExamples = true
+				state.description = nil
+			default:
+				if err := state.requireFeature(); err != nil {
+					return nil, err
+				}
+				state.scenario = &parsers.Scenario{
+					Name:  name,
+					Steps: make([]parsers.ScenarioStep, 0),
+				}
+				state.description = nil
+			}
+
+			continue
+		}
+
+		if matches := stepLinePattern.FindStringSubmatch(line); matches != nil {
+			keyword, _ := spectrKeyword(matches[1])
+			state.addStep(parsers.ScenarioStep{
+				Keyword: keyword,
+				Text:    strings.TrimSpace(matches[2]),
+			})
+
+			continue
+		}
+
+		if state.description != nil {
+			*state.description = append(*state.description, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read feature: %w", err)
+	}
+	if err := state.requireFeature(); err != nil {
+		return nil, err
+	}
+
+	state.flushScenario()
+	state.flushRule(&ruleDesc)
+	state.feature.Description = strings.Join(featureDesc, "\n")
+
+	return state.feature, nil
+}
+
+// requireFeature errors when content appears before a Feature line
+func (s *parseState) requireFeature() error {
+	if s.feature == nil {
+		return errors.New("missing 'Feature:' declaration")
+	}
+
+	return nil
+}
+
+// addStep appends a step to the current scenario or background
+func (s *parseState) addStep(step parsers.ScenarioStep) {
+	switch {
+	case s.inExamples:
+		return
+	case s.scenario != nil:
+		s.scenario.Steps = append(s.scenario.Steps, step)
+	case s.inBackground && s.rule != nil:
+		s.rule.Background = append(s.rule.Background, step)
+	case s.inBackground && s.feature != nil:
+		s.feature.Background = append(s.feature.Background, step)
+	}
+}
+
+// flushScenario attaches the current scenario to its rule or feature
+func (s *parseState) flushScenario() {
+	s.inBackground = false
+	s.inExamples = false
+	if s.scenario == nil {
+		return
+	}
+
+	if s.rule != nil {
+		s.rule.Scenarios = append(s.rule.Scenarios, *s.scenario)
+	} else {
+		s.feature.Scenarios = append(s.feature.Scenarios, *s.scenario)
+	}
+	s.scenario = nil
+}
+
+// flushRule attaches the current rule to the feature
+func (s *parseState) flushRule(description *[]string) {
+	if s.rule == nil {
+		return
+	}
+
+	s.rule.Description = strings.Join(*description, "\n")
+	s.feature.Rules = append(s.feature.Rules, *s.rule)
+	s.rule = nil
+}
+
+// Requirements converts a Feature into Spectr requirement blocks. Each
+// rule becomes a requirement; scenarios outside any rule are grouped
+// into a requirement named after the feature. Background steps are
+// prepended to every scenario in their scope. Requirements without a
+// description get a placeholder SHALL statement naming the rule.
+func Requirements(feature *Feature) []parsers.RequirementBlock {
+	var reqs []parsers.RequirementBlock
+
+	if len(feature.Scenarios) > 0 {
+		reqs = append(reqs, requirementBlock(
+			feature.Name,
+			"",
+			withBackground(feature.Scenarios, feature.Background),
+		))
+	}
+
+	for _, rule := range feature.Rules {
+		background := append(
+			append([]parsers.ScenarioStep{}, feature.Background...),
+			rule.Background...,
+		)
+		reqs = append(reqs, requirementBlock(
+			rule.Name,
+			rule.Description,
+			withBackground(rule.Scenarios, background),
+		))
+	}
+
+	return reqs
+}
+
+// withBackground returns scenarios with background steps prepended
+func withBackground(
+	scenarios []parsers.Scenario,
+	background []parsers.ScenarioStep,
+) []parsers.Scenario {
+	if len(background) == 0 {
+		return scenarios
+	}
+
+	result := make([]parsers.Scenario, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		steps := append(
+			append([]parsers.ScenarioStep{}, background...),
+			scenario.Steps...,
+		)
+		result = append(result, parsers.Scenario{
+			Name:  scenario.Name,
+			Steps: steps,
+		})
+	}
+
+	return result
+}
+
+// requirementBlock renders a requirement with its scenarios as markdown
+func requirementBlock(
+	name, description string,
+	scenarios []parsers.Scenario,
+) parsers.RequirementBlock {
+	if strings.TrimSpace(description) == "" {
+		description = fmt.Sprintf("The system SHALL support %s.", name)
+	}
+
+	header := "### Requirement: " + name
+	var sb strings.Builder
+	sb.WriteString(header + "\n")
+	sb.WriteString(strings.TrimSpace(description) + "\n")
+
+	for _, scenario := range scenarios {
+		fmt.Fprintf(&sb, "\n#### Scenario: %s\n", scenario.Name)
+		for _, step := range scenario.Steps {
+			if step.Keyword == "" {
+				fmt.Fprintf(&sb, "- %s\n", step.Text)
+
+				continue
+			}
+			fmt.Fprintf(&sb, "- **%s** %s\n", step.Keyword, step.Text)
+		}
+	}
+
+	return parsers.RequirementBlock{
+		HeaderLine: header,
+		Name:       name,
+		Raw:        sb.String(),
+	}
+}
+
+// ImportOptions configures an import of feature files into a change
+type ImportOptions struct {
+	// ChangeID is the change directory to create or extend
+	ChangeID string
+	// Files are the .feature files to import
+	Files []string
+	// Capability overrides the capability derived from file names
+	Capability string
+}
+
+// ImportResult describes the delta specs written by Import
+type ImportResult struct {
+	ChangeDir string
+	// Requirements maps capability to the number of ADDED requirements
+	Requirements map[string]int
+}
+
+// Import converts feature files into ADDED requirement deltas under
+// spectr/changes/<change-id>/specs/<capability>/spec.md. The capability
+// is derived from each feature file name unless overridden. A
+// proposal.md is created when the change does not have one yet.
+// Existing delta specs are never overwritten.
+func Import(projectPath string, opts ImportOptions) (*ImportResult, error) {
+	if opts.ChangeID == "" {
+		return nil, errors.New("change ID is required")
+	}
+	if len(opts.Files) == 0 {
+		return nil, errors.New("no feature files given")
+	}
+
+	byCapability := make(map[string][]parsers.RequirementBlock)
+	var featureNames, sources []string
+	for _, path := range opts.Files {
+		feature, err := parseFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		capability := opts.Capability
+		if capability == "" {
+			capability = CapabilityFromFile(path)
+		}
+		byCapability[capability] = append(
+			byCapability[capability],
+			Requirements(feature)...,
+		)
+		featureNames = append(featureNames, feature.Name)
+		sources = append(sources, filepath.Base(path))
+	}
+
+	changeDir := filepath.Join(projectPath, "spectr", "changes", opts.ChangeID)
+	for capability := range byCapability {
+		deltaPath := filepath.Join(changeDir, "specs", capability, "spec.md")
+		if _, err := os.Stat(deltaPath); err == nil {
+			return nil, fmt.Errorf("delta spec already exists: %s", deltaPath)
+		}
+	}
+
+	result := &ImportResult{
+		ChangeDir:    changeDir,
+		Requirements: make(map[string]int),
+	}
+	for capability, reqs := range byCapability {
+		if err := writeDelta(changeDir, capability, reqs); err != nil {
+			return nil, err
+		}
+		result.Requirements[capability] = len(reqs)
+	}
+
+	if err := writeProposal(changeDir, featureNames, sources, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// parseFile opens and parses a single feature file
+func parseFile(path string) (*Feature, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", path, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	feature, err := Parse(file)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	return feature, nil
+}
+
+// CapabilityFromFile derives a kebab-case capability ID from a feature
+// file name, e.g. "User_Login.feature" becomes "user-login"
+func CapabilityFromFile(path string) string {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	slug := nonSlugPattern.ReplaceAllString(strings.ToLower(name), "-")
+
+	return strings.Trim(slug, "-")
+}
+
+// writeDelta writes an ADDED Requirements delta spec for a capability
+func writeDelta(
+	changeDir, capability string,
+	reqs []parsers.RequirementBlock,
+) error {
+	var sb strings.Builder
+	sb.WriteString("## ADDED Requirements\n")
+	for _, req := range reqs {
+		sb.WriteString("\n")
+		sb.WriteString(req.Raw)
+	}
+
+	deltaDir := filepath.Join(changeDir, "specs", capability)
+	if err := os.MkdirAll(deltaDir, dirPerm); err != nil {
+		return fmt.Errorf("create delta directory: %w", err)
+	}
+
+	deltaPath := filepath.Join(deltaDir, "spec.md")
+	if err := os.WriteFile(deltaPath, []byte(sb.String()), filePerm); err != nil {
+		return fmt.Errorf("write %s: %w", deltaPath, err)
+	}
+
+	return nil
+}
+
+// writeProposal creates proposal.md for the change if it is missing
+func writeProposal(
+	changeDir string,
+	featureNames, sources []string,
+	result *ImportResult,
+) error {
+	proposalPath := filepath.Join(changeDir, "proposal.md")
+	if _, err := os.Stat(proposalPath); err == nil {
+		return nil
+	}
+
+	capabilities := make([]string, 0, len(result.Requirements))
+	for capability := range result.Requirements {
+		capabilities = append(capabilities, capability)
+	}
+	sort.Strings(capabilities)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Change: Import %s\n\n", strings.Join(featureNames, ", "))
+	sb.WriteString("## Why\n\n")
+	fmt.Fprintf(
+		&sb,
+		"Imported from Gherkin feature files: %s\n\n",
+		strings.Join(sources, ", "),
+	)
+	sb.WriteString("## What Changes\n\n")
+	for _, capability := range capabilities {
+		fmt.Fprintf(
+			&sb,
+			"- ADDED %d requirement(s) to `%s`\n",
+			result.Requirements[capability],
+			capability,
+		)
+	}
+
+	if err := os.WriteFile(proposalPath, []byte(sb.String()), filePerm); err != nil {
+		return fmt.Errorf("write %s: %w", proposalPath, err)
+	}
+
+	return nil
+}
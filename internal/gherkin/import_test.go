@@ -0,0 +1,152 @@
+package gherkin
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const sampleFeature = `@auth
+Feature: Shopping cart
+  Customers manage items before checkout.
+
+  Background:
+    Given a logged in customer
+
+  Scenario: Cart starts empty
+    Then the cart is empty
+
+  Rule: Adding items
+    The cart SHALL accept items that are in stock.
+
+    # comment lines are ignored
+    Scenario Outline: Add an item
+      When the customer adds <item>
+      Then the cart contains <item>
+      But the stock is reduced
+      * a confirmation is shown
+      """
+      Not a step
+      """
+
+      Examples:
+        | item  |
+        | apple |
+
+  Rule: Removing items
+
+    Scenario: Remove an item
+      When the customer removes an item
+      Then the cart is empty
+`
+
+func TestParse(t *testing.T) {
+	feature, err := Parse(strings.NewReader(sampleFeature))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if feature.Name != "Shopping cart" {
+		t.Errorf("Expected feature name 'Shopping cart', got %q", feature.Name)
+	}
+	if feature.Description != "Customers manage items before checkout." {
+		t.Errorf("Unexpected feature description: %q", feature.Description)
+	}
+	if len(feature.Background) != 1 || feature.Background[0].Keyword != "GIVEN" {
+		t.Errorf("Unexpected background: %+v", feature.Background)
+	}
+	if len(feature.Scenarios) != 1 {
+		t.Errorf("Expected 1 rule-less scenario, got %d", len(feature.Scenarios))
+	}
+	if len(feature.Rules) != 2 {
+		t.Fatalf("Expected 2 rules, got %d", len(feature.Rules))
+	}
+
+	adding := feature.Rules[0]
+	if adding.Description != "The cart SHALL accept items that are in stock." {
+		t.Errorf("Unexpected rule description: %q", adding.Description)
+	}
+	if len(adding.Scenarios) != 1 {
+		t.Fatalf("Expected 1 scenario in rule, got %d", len(adding.Scenarios))
+	}
+
+	steps := adding.Scenarios[0].Steps
+	keywords := make([]string, 0, len(steps))
+	for _, step := range steps {
+		keywords = append(keywords, step.Keyword)
+	}
+	if strings.Join(keywords, ",") != "WHEN,THEN,BUT," {
+		t.Errorf("Unexpected step keywords: %v", keywords)
+	}
+}
+
+func TestParse_MissingFeature(t *testing.T) {
+	if _, err := Parse(strings.NewReader("Scenario: orphan\n")); err == nil {
+		t.Error("Expected error for scenario before Feature")
+	}
+}
+
+func TestImport(t *testing.T) {
+	tmpDir := t.TempDir()
+	featurePath := filepath.Join(tmpDir, "Shopping_Cart.feature")
+	if err := os.WriteFile(featurePath, []byte(sampleFeature), filePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Import(tmpDir, ImportOptions{
+		ChangeID: "add-cart",
+		Files:    []string{featurePath},
+	})
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	if result.Requirements["shopping-cart"] != 3 {
+		t.Errorf("Expected 3 requirements for shopping-cart, got %v", result.Requirements)
+	}
+
+	deltaPath := filepath.Join(tmpDir, "spectr", "changes", "add-cart", "specs", "shopping-cart", "spec.md")
+	content, err := os.ReadFile(deltaPath)
+	if err != nil {
+		t.Fatalf("Expected delta spec: %v", err)
+	}
+
+	for _, want := range []string{
+		"## ADDED Requirements",
+		"### Requirement: Shopping cart\nThe system SHALL support Shopping cart.",
+		"### Requirement: Adding items\nThe cart SHALL accept items that are in stock.",
+		"#### Scenario: Add an item\n- **GIVEN** a logged in customer\n- **WHEN** the customer adds <item>",
+		"- a confirmation is shown",
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("Delta spec missing %q\n%s", want, content)
+		}
+	}
+
+	proposal := filepath.Join(tmpDir, "spectr", "changes", "add-cart", "proposal.md")
+	if _, err := os.Stat(proposal); err != nil {
+		t.Errorf("Expected proposal.md to be created: %v", err)
+	}
+
+	// Importing again must not overwrite the existing delta
+	if _, err := Import(tmpDir, ImportOptions{
+		ChangeID: "add-cart",
+		Files:    []string{featurePath},
+	}); err == nil {
+		t.Error("Expected error when delta spec already exists")
+	}
+}
+
+func TestCapabilityFromFile(t *testing.T) {
+	tests := map[string]string{
+		"features/User_Login.feature": "user-login",
+		"checkout flow.feature":       "checkout-flow",
+		"api.feature":                 "api",
+	}
+	for path, want := range tests {
+		if got := CapabilityFromFile(path); got != want {
+			t.Errorf("CapabilityFromFile(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Package gherkin converts between Spectr specs and Gherkin feature
+// files. Each capability maps to a Feature, each requirement to a Rule,
+// and each scenario's bold WHEN/THEN/AND bullets to Given/When/Then steps.
+package gherkin
+
+import (
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// Feature is a parsed or generated Gherkin feature
+type Feature struct {
+	Name        string
+	Description string
+	// Background steps apply to every scenario in the feature
+	Background []parsers.ScenarioStep
+	Rules      []Rule
+	// Scenarios declared directly under the feature, outside any rule
+	Scenarios []parsers.Scenario
+}
+
+// Rule is a Gherkin rule, corresponding to a Spectr requirement
+type Rule struct {
+	Name        string
+	Description string
+	// Background steps apply to every scenario in the rule
+	Background []parsers.ScenarioStep
+	Scenarios  []parsers.Scenario
+}
+
+// stepKeywords maps Spectr step keywords to Gherkin step keywords.
+// Steps without a keyword use the Gherkin "*" bullet.
+var stepKeywords = map[string]string{
+	"GIVEN": "Given",
+	"WHEN":  "When",
+	"THEN":  "Then",
+	"AND":   "And",
+	"BUT":   "But",
+	"":      "*",
+}
+
+// spectrKeyword converts a Gherkin step keyword into a Spectr keyword
+func spectrKeyword(gherkin string) (string, bool) {
+	for spectr, keyword := range stepKeywords {
+		if strings.EqualFold(keyword, gherkin) {
+			return spectr, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,193 @@
+package git
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// azureAPIVersion is the Azure DevOps REST API version spectr uses
+const azureAPIVersion = "7.0"
+
+// AzureClient creates pull requests through the Azure DevOps REST API
+type AzureClient struct {
+	// APIURL is the collection URL: https://dev.azure.com/<organization>
+	// on Azure DevOps Services, or the collection URL of Azure DevOps
+	// Server
+	APIURL string
+	// IdentityURL serves identity lookups of reviewers; empty means APIURL
+	IdentityURL string
+	Token       string
+	// Repo has the path "<project>/<repository>"
+	Repo Repository
+	// HTTPClient overrides the default HTTP client
+	HTTPClient *http.Client
+}
+
+// azurePull is the part of an Azure DevOps pull request response spectr
+// uses
+type azurePull struct {
+	ID            int    `json:"pullRequestId"`
+	Title         string `json:"title"`
+	IsDraft       bool   `json:"isDraft"`
+	SourceRefName string `json:"sourceRefName"`
+	TargetRefName string `json:"targetRefName"`
+	Repository    struct {
+		WebURL string `json:"webUrl"`
+	} `json:"repository"`
+}
+
+// azureRepository returns the collection URL and the "<project>/<repo>"
+// repository of an Azure DevOps remote in any of these forms:
+//
+//	https://dev.azure.com/<org>/<project>/_git/<repo>
+//	https://<org>.visualstudio.com/<project>/_git/<repo>
+//	git@ssh.dev.azure.com:v3/<org>/<project>/<repo>
+//	https://<server>/<collection>/<project>/_git/<repo>
+func azureRepository(repo Repository) (string, Repository, error) {
+	parts := strings.Split(repo.Path, "/")
+
+	sshHost := repo.Host == "ssh.dev.azure.com" ||
+		repo.Host == "vs-ssh.visualstudio.com"
+	if sshHost && len(parts) == 4 && parts[0] == "v3" {
+		return "https://dev.azure.com/" + parts[1], Repository{
+			Scheme: "https",
+			Host:   "dev.azure.com",
+			Path:   parts[2] + "/" + parts[3],
+		}, nil
+	}
+
+	i := slices.Index(parts, "_git")
+	if i < 1 || i != len(parts)-2 {
+		return "", Repository{}, fmt.Errorf(
+			"remote path %q is not an Azure DevOps repository",
+			repo.Path,
+		)
+	}
+
+	collection := repo.Scheme + "://" + repo.Host
+	if i > 1 {
+		collection += "/" + strings.Join(parts[:i-1], "/")
+	}
+
+	return collection, Repository{
+		Scheme: repo.Scheme,
+		Host:   repo.Host,
+		Path:   parts[i-1] + "/" + parts[i+1],
+	}, nil
+}
+
+// azureIdentityURL returns the URL serving identity lookups for a
+// collection. Azure DevOps Services serves them from a separate host.
+func azureIdentityURL(collection string) string {
+	if org, ok := strings.CutPrefix(collection, "https://dev.azure.com/"); ok {
+		return "https://vssps.dev.azure.com/" + org
+	}
+	if org, ok := strings.CutPrefix(collection, "https://"); ok {
+		if name, ok := strings.CutSuffix(org, ".visualstudio.com"); ok {
+			return "https://" + name + ".vssps.visualstudio.com"
+		}
+	}
+
+	return collection
+}
+
+// client returns an API client for baseURL authenticated with the
+// personal access token
+func (c *AzureClient) client(baseURL string) *apiClient {
+	header := http.Header{}
+	credentials := base64.StdEncoding.EncodeToString([]byte(":" + c.Token))
+	header.Set("Authorization", "Basic "+credentials)
+
+	return newAPIClient(baseURL, header, c.HTTPClient)
+}
+
+// CreatePullRequest opens a pull request from opts.Branch. Reviewers are
+// user names or emails, resolved to identity IDs first.
+func (c *AzureClient) CreatePullRequest(opts PROptions) (*PullRequest, error) {
+	api := c.client(c.APIURL)
+	repoPath := fmt.Sprintf(
+		"/%s/_apis/git/repositories/%s",
+		url.PathEscape(c.Repo.Owner()),
+		url.PathEscape(c.Repo.Name()),
+	)
+	query := "?api-version=" + azureAPIVersion
+
+	base := opts.Base
+	if base == "" {
+		var repo struct {
+			DefaultBranch string `json:"defaultBranch"`
+		}
+		if err := api.do(http.MethodGet, repoPath+query, nil, &repo); err != nil {
+			return nil, fmt.Errorf("look up default branch: %w", err)
+		}
+		base = strings.TrimPrefix(repo.DefaultBranch, "refs/heads/")
+	}
+
+	reviewers, err := c.reviewerIDs(opts.Reviewers)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make([]map[string]string, 0, len(opts.Labels))
+	for _, label := range opts.Labels {
+		labels = append(labels, map[string]string{"name": label})
+	}
+
+	request := map[string]any{
+		"sourceRefName": "refs/heads/" + opts.Branch,
+		"targetRefName": "refs/heads/" + base,
+		"title":         opts.Title,
+		"description":   opts.Body,
+		"isDraft":       opts.Draft,
+		"labels":        labels,
+		"reviewers":     reviewers,
+	}
+
+	var pull azurePull
+	if err := api.do(http.MethodPost, repoPath+"/pullrequests"+query, request, &pull); err != nil {
+		return nil, fmt.Errorf("create Azure DevOps PR: %w", err)
+	}
+
+	return &PullRequest{
+		Number: pull.ID,
+		URL:    pull.Repository.WebURL + "/pullrequest/" + strconv.Itoa(pull.ID),
+		Title:  pull.Title,
+		Head:   strings.TrimPrefix(pull.SourceRefName, "refs/heads/"),
+		Base:   strings.TrimPrefix(pull.TargetRefName, "refs/heads/"),
+		Draft:  pull.IsDraft,
+	}, nil
+}
+
+// reviewerIDs resolves reviewer names to identity references
+func (c *AzureClient) reviewerIDs(names []string) ([]map[string]string, error) {
+	identityURL := c.IdentityURL
+	if identityURL == "" {
+		identityURL = c.APIURL
+	}
+	api := c.client(identityURL)
+
+	reviewers := make([]map[string]string, 0, len(names))
+	for _, name := range names {
+		var identities struct {
+			Value []struct {
+				ID string `json:"id"`
+			} `json:"value"`
+		}
+		path := "/_apis/identities?searchFilter=General&filterValue=" +
+			url.QueryEscape(name) + "&api-version=" + azureAPIVersion
+		if err := api.do(http.MethodGet, path, nil, &identities); err != nil {
+			return nil, fmt.Errorf("look up reviewer %s: %w", name, err)
+		}
+		if len(identities.Value) == 0 {
+			return nil, fmt.Errorf("reviewer %s not found", name)
+		}
+		reviewers = append(reviewers, map[string]string{"id": identities.Value[0].ID})
+	}
+
+	return reviewers, nil
+}
@@ -0,0 +1,129 @@
+package git
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// BitbucketClient creates pull requests through the Bitbucket Server and
+// Data Center REST API
+type BitbucketClient struct {
+	// APIURL is https://<host>[/<context>]/rest/api/1.0
+	APIURL string
+	Token  string
+	// Repo has the path "<project key>/<repository slug>"
+	Repo Repository
+	// HTTPClient overrides the default HTTP client
+	HTTPClient *http.Client
+}
+
+// bitbucketPull is the part of a Bitbucket pull request response spectr
+// uses
+type bitbucketPull struct {
+	ID      int    `json:"id"`
+	Title   string `json:"title"`
+	Draft   bool   `json:"draft"`
+	FromRef struct {
+		DisplayID string `json:"displayId"`
+	} `json:"fromRef"`
+	ToRef struct {
+		DisplayID string `json:"displayId"`
+	} `json:"toRef"`
+	Links struct {
+		Self []struct {
+			Href string `json:"href"`
+		} `json:"self"`
+	} `json:"links"`
+}
+
+// bitbucketRepository returns the API URL and the "<project>/<slug>"
+// repository of a Bitbucket Server remote. HTTPS remotes clone from
+// <context>/scm/<project>/<slug>, SSH remotes from <project>/<slug>.
+func bitbucketRepository(repo Repository) (string, Repository) {
+	context, path := "", repo.Path
+	if before, after, ok := strings.Cut("/"+repo.Path, "/scm/"); ok {
+		context, path = before, after
+	}
+
+	apiURL := repo.Scheme + "://" + repo.Host + context + "/rest/api/1.0"
+
+	return apiURL, Repository{Scheme: repo.Scheme, Host: repo.Host, Path: path}
+}
+
+// client returns the API client authenticated with the token
+func (c *BitbucketClient) client() *apiClient {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+c.Token)
+
+	return newAPIClient(c.APIURL, header, c.HTTPClient)
+}
+
+// CreatePullRequest opens a pull request from opts.Branch. Reviewers are
+// user names. Bitbucket pull requests have no labels, so opts.Labels is
+// ignored.
+func (c *BitbucketClient) CreatePullRequest(opts PROptions) (*PullRequest, error) {
+	api := c.client()
+	project, slug := c.Repo.Owner(), c.Repo.Name()
+	repoPath := fmt.Sprintf(
+		"/projects/%s/repos/%s",
+		url.PathEscape(project),
+		url.PathEscape(slug),
+	)
+
+	base := opts.Base
+	if base == "" {
+		var branch struct {
+			DisplayID string `json:"displayId"`
+		}
+		if err := api.do(http.MethodGet, repoPath+"/branches/default", nil, &branch); err != nil {
+			return nil, fmt.Errorf("look up default branch: %w", err)
+		}
+		base = branch.DisplayID
+	}
+
+	ref := func(branch string) map[string]any {
+		return map[string]any{
+			"id": "refs/heads/" + branch,
+			"repository": map[string]any{
+				"slug":    slug,
+				"project": map[string]any{"key": project},
+			},
+		}
+	}
+
+	reviewers := make([]map[string]any, 0, len(opts.Reviewers))
+	for _, name := range opts.Reviewers {
+		reviewers = append(reviewers, map[string]any{"user": map[string]any{"name": name}})
+	}
+
+	request := map[string]any{
+		"title":       opts.Title,
+		"description": opts.Body,
+		"fromRef":     ref(opts.Branch),
+		"toRef":       ref(base),
+		"reviewers":   reviewers,
+	}
+	if opts.Draft {
+		request["draft"] = true
+	}
+
+	var pull bitbucketPull
+	if err := api.do(http.MethodPost, repoPath+"/pull-requests", request, &pull); err != nil {
+		return nil, fmt.Errorf("create Bitbucket PR: %w", err)
+	}
+
+	pr := &PullRequest{
+		Number: pull.ID,
+		Title:  pull.Title,
+		Head:   pull.FromRef.DisplayID,
+		Base:   pull.ToRef.DisplayID,
+		Draft:  pull.Draft || opts.Draft,
+	}
+	if len(pull.Links.Self) > 0 {
+		pr.URL = pull.Links.Self[0].Href
+	}
+
+	return pr, nil
+}
@@ -0,0 +1,74 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// ChangedFiles returns the absolute paths of the files below dir that
+// changed since the merge base of since and HEAD: committed, staged,
+// unstaged, and untracked files. An empty since means HEAD, so only
+// uncommitted work counts. Renamed files appear under both names.
+func ChangedFiles(dir, since string) ([]string, error) {
+	resolved, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return nil, fmt.Errorf("resolve %s: %w", dir, err)
+	}
+	topLevel, err := TopLevel(resolved)
+	if err != nil {
+		return nil, err
+	}
+
+	if since == "" {
+		since = "HEAD"
+	}
+	output, err := exec.Command(gitCommand, "-C", topLevel, "merge-base", since, "HEAD").Output()
+	if err != nil {
+		// The exit status alone does not tell an unknown ref apart
+		if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+			return nil, fmt.Errorf(
+				"find merge base of %s and HEAD: %s",
+				since,
+				strings.TrimSpace(string(exitErr.Stderr)),
+			)
+		}
+
+		return nil, fmt.Errorf("find merge base of %s and HEAD: %w", since, err)
+	}
+	base := strings.TrimSpace(string(output))
+
+	diff, err := exec.Command(
+		gitCommand, "-C", topLevel,
+		"diff", "--name-only", "--no-renames", "-z", base, "--",
+	).Output()
+	if err != nil {
+		return nil, fmt.Errorf("list changed files: %w", err)
+	}
+	untracked, err := exec.Command(
+		gitCommand, "-C", topLevel,
+		"ls-files", "--others", "--exclude-standard", "-z",
+	).Output()
+	if err != nil {
+		return nil, fmt.Errorf("list untracked files: %w", err)
+	}
+
+	var files []string
+	for _, name := range bytes.Split(append(diff, untracked...), []byte{0}) {
+		if len(name) == 0 {
+			continue
+		}
+		path := filepath.Join(topLevel, filepath.FromSlash(string(name)))
+		rel, err := filepath.Rel(resolved, path)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		files = append(files, path)
+	}
+	slices.Sort(files)
+
+	return slices.Compact(files), nil
+}
@@ -0,0 +1,67 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestChangedFiles(t *testing.T) {
+	if _, err := exec.LookPath(gitCommand); err != nil {
+		t.Skip("git not available")
+	}
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	gitIn(t, dir, "init", "-q", "-b", "main")
+	gitIn(t, dir, "config", "user.name", "test")
+	gitIn(t, dir, "config", "user.email", "test@example.com")
+
+	write := func(rel, content string) {
+		t.Helper()
+		path := filepath.Join(dir, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("app/spectr/specs/auth/spec.md", "# Auth\n")
+	write("app/spectr/specs/billing/spec.md", "# Billing\n")
+	write("other/README.md", "other\n")
+	gitIn(t, dir, "add", "-A")
+	gitIn(t, dir, "commit", "-q", "-m", "init")
+
+	gitIn(t, dir, "checkout", "-q", "-b", "feature")
+	write("app/spectr/specs/auth/spec.md", "# Auth v2\n")
+	gitIn(t, dir, "commit", "-q", "-am", "auth")
+	write("app/spectr/changes/add-sso/proposal.md", "# SSO\n")
+	write("other/README.md", "changed\n")
+
+	project := filepath.Join(dir, "app")
+	got, err := ChangedFiles(project, "")
+	if err != nil {
+		t.Fatalf("ChangedFiles failed: %v", err)
+	}
+	want := []string{filepath.Join(project, "spectr", "changes", "add-sso", "proposal.md")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangedFiles(HEAD) = %v, want %v", got, want)
+	}
+
+	got, err = ChangedFiles(project, "main")
+	if err != nil {
+		t.Fatalf("ChangedFiles failed: %v", err)
+	}
+	want = append(want, filepath.Join(project, "spectr", "specs", "auth", "spec.md"))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangedFiles(main) = %v, want %v", got, want)
+	}
+
+	if _, err := ChangedFiles(project, "no-such-ref"); err == nil {
+		t.Error("Expected an error for an unknown ref")
+	}
+}
@@ -0,0 +1,219 @@
+package git
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// requestTimeout bounds each forge API request
+	requestTimeout = 30 * time.Second
+	// maxErrorBody bounds how much of an error response is reported
+	maxErrorBody = 512
+)
+
+// PullRequest describes a pull request (merge request on GitLab)
+// created on a forge
+type PullRequest struct {
+	Number int    `json:"number"`
+	URL    string `json:"url"`
+	Title  string `json:"title"`
+	Head   string `json:"head"`
+	Base   string `json:"base"`
+	Draft  bool   `json:"draft"`
+}
+
+// Forge creates pull requests through the API of a hosting platform
+type Forge interface {
+	CreatePullRequest(opts PROptions) (*PullRequest, error)
+}
+
+// tokenEnv lists the environment variables holding API tokens, in
+// order of precedence
+var tokenEnv = map[Platform][]string{
+	PlatformGitHub: {"GITHUB_TOKEN", "GH_TOKEN"},
+	PlatformGitLab: {"GITLAB_TOKEN"},
+	PlatformGitea:  {"GITEA_TOKEN", "FORGEJO_TOKEN"},
+	PlatformAzure:  {"AZURE_DEVOPS_EXT_PAT", "AZURE_DEVOPS_TOKEN"},
+}
+
+// LookupToken returns the API token for a forge host: the token
+// configured for the host, or else the first environment variable of
+// the platform that is set. Environment tokens are only used for the
+// platform's public host, so they never reach self-hosted forges.
+func LookupToken(platform Platform, host string, configured map[string]string) string {
+	if token := configured[host]; token != "" {
+		return token
+	}
+	if !IsPublicHost(platform, host) {
+		return ""
+	}
+
+	for _, name := range tokenEnv[platform] {
+		if token := os.Getenv(name); token != "" {
+			return token
+		}
+	}
+
+	return ""
+}
+
+// TokenHint describes where the API token of a platform is read from
+func TokenHint(platform Platform, host string) string {
+	names := tokenEnv[platform]
+	if len(names) == 0 || !IsPublicHost(platform, host) {
+		return fmt.Sprintf("add a token for %s to the user config", host)
+	}
+
+	return fmt.Sprintf(
+		"set %s or add a token for %s to the user config",
+		strings.Join(names, " or "),
+		host,
+	)
+}
+
+// NewForge returns the API client of platform for repo, deriving the
+// API URL from the repository host
+func NewForge(platform Platform, repo Repository, token string) (Forge, error) {
+	web := repo.Scheme + "://" + repo.Host
+	switch platform {
+	case PlatformGitHub:
+		apiURL := web + "/api/v3"
+		if repo.Host == "github.com" {
+			apiURL = "https://api.github.com"
+		}
+
+		return &GitHubClient{APIURL: apiURL, Token: token, Repo: repo}, nil
+	case PlatformGitLab:
+		return &GitLabClient{APIURL: web + "/api/v4", Token: token, Repo: repo}, nil
+	case PlatformGitea:
+		return &GiteaClient{APIURL: web + "/api/v1", Token: token, Repo: repo}, nil
+	case PlatformBitbucket:
+		if repo.Host == "bitbucket.org" {
+			return nil, errors.New(
+				"bitbucket.org (Bitbucket Cloud) is not supported, " +
+					"only Bitbucket Server and Data Center",
+			)
+		}
+		apiURL, repo := bitbucketRepository(repo)
+
+		return &BitbucketClient{APIURL: apiURL, Token: token, Repo: repo}, nil
+	case PlatformAzure:
+		collection, repo, err := azureRepository(repo)
+		if err != nil {
+			return nil, err
+		}
+
+		return &AzureClient{
+			APIURL:      collection,
+			IdentityURL: azureIdentityURL(collection),
+			Token:       token,
+			Repo:        repo,
+		}, nil
+	case PlatformUnknown:
+		fallthrough
+	default:
+		return nil, fmt.Errorf("unsupported platform: %s", platform)
+	}
+}
+
+// APIError is an unsuccessful response from a forge API
+type APIError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Message    string
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	return fmt.Sprintf(
+		"%s %s: %d %s: %s",
+		e.Method,
+		e.URL,
+		e.StatusCode,
+		http.StatusText(e.StatusCode),
+		e.Message,
+	)
+}
+
+// apiClient sends JSON requests to a forge API
+type apiClient struct {
+	baseURL string
+	header  http.Header
+	http    *http.Client
+}
+
+// newAPIClient returns a client for baseURL sending header with every
+// request. A nil httpClient uses a client with requestTimeout.
+func newAPIClient(baseURL string, header http.Header, httpClient *http.Client) *apiClient {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: requestTimeout}
+	}
+
+	return &apiClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		header:  header,
+		http:    httpClient,
+	}
+}
+
+// do sends a request with body encoded as JSON and decodes a successful
+// response into out. A nil body sends no content; a nil out discards
+// the response.
+func (c *apiClient) do(method, path string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	url := c.baseURL + path
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	for name, values := range c.header {
+		req.Header[name] = values
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", method, url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+
+		return &APIError{
+			Method:     method,
+			URL:        url,
+			StatusCode: resp.StatusCode,
+			Message:    strings.TrimSpace(string(data)),
+		}
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response of %s %s: %w", method, url, err)
+	}
+
+	return nil
+}
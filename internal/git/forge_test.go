@@ -0,0 +1,435 @@
+package git
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// recordedRequest is a request received by the fake forge
+type recordedRequest struct {
+	Method string
+	Path   string
+	Header http.Header
+	Body   map[string]any
+}
+
+// fakeForge serves canned JSON responses keyed by "METHOD /path?query"
+// and records the requests it receives
+type fakeForge struct {
+	*httptest.Server
+	responses map[string]string
+	requests  []recordedRequest
+}
+
+// newFakeForge starts a fake forge API with the given responses
+func newFakeForge(t *testing.T, responses map[string]string) *fakeForge {
+	t.Helper()
+	f := &fakeForge{responses: responses}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Method + " " + r.URL.RequestURI()
+		request := recordedRequest{Method: r.Method, Path: r.URL.RequestURI(), Header: r.Header}
+		if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+			if err := json.Unmarshal(data, &request.Body); err != nil {
+				t.Errorf("Invalid JSON body for %s: %v", key, err)
+			}
+		}
+		f.requests = append(f.requests, request)
+
+		response, ok := f.responses[key]
+		if !ok {
+			http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, response)
+	}))
+	t.Cleanup(f.Close)
+
+	return f
+}
+
+// request returns the recorded request for key, failing if it was not sent
+func (f *fakeForge) request(t *testing.T, key string) recordedRequest {
+	t.Helper()
+	for _, r := range f.requests {
+		if r.Method+" "+r.Path == key {
+			return r
+		}
+	}
+	t.Fatalf("Expected request %s, got %+v", key, f.requests)
+
+	return recordedRequest{}
+}
+
+const pullResponse = `{"number": 7, "html_url": "https://forge/owner/repo/pull/7", "title": "Archive: x",
+	"draft": true, "head": {"ref": "archive-x"}, "base": {"ref": "main"}}`
+
+func TestGitHubClient_CreatePullRequest(t *testing.T) {
+	f := newFakeForge(t, map[string]string{
+		"GET /repos/owner/repo":                              `{"default_branch": "main"}`,
+		"POST /repos/owner/repo/pulls":                       pullResponse,
+		"POST /repos/owner/repo/issues/7/labels":             `[]`,
+		"POST /repos/owner/repo/pulls/7/requested_reviewers": `{}`,
+	})
+	client := &GitHubClient{APIURL: f.URL, Token: "secret", Repo: Repository{Path: "owner/repo"}}
+
+	pr, err := client.CreatePullRequest(PROptions{
+		Title:     "Archive: x",
+		Body:      "body",
+		Branch:    "archive-x",
+		Draft:     true,
+		Labels:    []string{"spectr"},
+		Reviewers: []string{"alice", "org/core"},
+	})
+	if err != nil {
+		t.Fatalf("CreatePullRequest failed: %v", err)
+	}
+
+	want := &PullRequest{Number: 7, URL: "https://forge/owner/repo/pull/7", Title: "Archive: x", Head: "archive-x", Base: "main", Draft: true}
+	if !reflect.DeepEqual(pr, want) {
+		t.Errorf("PullRequest = %+v, want %+v", pr, want)
+	}
+
+	create := f.request(t, "POST /repos/owner/repo/pulls")
+	if got := create.Header.Get("Authorization"); got != "Bearer secret" {
+		t.Errorf("Authorization = %q", got)
+	}
+	if create.Body["base"] != "main" || create.Body["head"] != "archive-x" || create.Body["draft"] != true {
+		t.Errorf("Unexpected create body: %v", create.Body)
+	}
+	labels := f.request(t, "POST /repos/owner/repo/issues/7/labels")
+	if !reflect.DeepEqual(labels.Body["labels"], []any{"spectr"}) {
+		t.Errorf("Unexpected labels body: %v", labels.Body)
+	}
+	reviewers := f.request(t, "POST /repos/owner/repo/pulls/7/requested_reviewers")
+	if !reflect.DeepEqual(reviewers.Body["reviewers"], []any{"alice"}) ||
+		!reflect.DeepEqual(reviewers.Body["team_reviewers"], []any{"core"}) {
+		t.Errorf("Unexpected reviewers body: %v", reviewers.Body)
+	}
+}
+
+func TestGitHubClient_APIError(t *testing.T) {
+	f := newFakeForge(t, map[string]string{})
+	client := &GitHubClient{APIURL: f.URL, Token: "secret", Repo: Repository{Path: "owner/repo"}}
+
+	_, err := client.CreatePullRequest(PROptions{Title: "t", Branch: "b", Base: "main"})
+	var apiErr *APIError
+	if err == nil || !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected a 404 APIError, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "Not Found") {
+		t.Errorf("Expected the response message in %q", err)
+	}
+}
+
+func TestGitLabClient_CreatePullRequest(t *testing.T) {
+	f := newFakeForge(t, map[string]string{
+		"GET /projects/group%2Fsub%2Frepo":                 `{"default_branch": "develop"}`,
+		"GET /users?username=alice":                        `[{"id": 42}]`,
+		"POST /projects/group%2Fsub%2Frepo/merge_requests": `{"iid": 3, "web_url": "https://gitlab/mr/3", "title": "Draft: Archive: x", "draft": true, "source_branch": "archive-x", "target_branch": "develop"}`,
+	})
+	client := &GitLabClient{APIURL: f.URL, Token: "secret", Repo: Repository{Path: "group/sub/repo"}}
+
+	pr, err := client.CreatePullRequest(PROptions{
+		Title:     "Archive: x",
+		Body:      "body",
+		Branch:    "archive-x",
+		Draft:     true,
+		Labels:    []string{"spectr", "docs"},
+		Reviewers: []string{"alice"},
+	})
+	if err != nil {
+		t.Fatalf("CreatePullRequest failed: %v", err)
+	}
+	if pr.Number != 3 || pr.URL != "https://gitlab/mr/3" || pr.Base != "develop" || !pr.Draft {
+		t.Errorf("Unexpected PullRequest: %+v", pr)
+	}
+
+	create := f.request(t, "POST /projects/group%2Fsub%2Frepo/merge_requests")
+	if got := create.Header.Get("PRIVATE-TOKEN"); got != "secret" {
+		t.Errorf("PRIVATE-TOKEN = %q", got)
+	}
+	if create.Body["title"] != "Draft: Archive: x" || create.Body["target_branch"] != "develop" ||
+		create.Body["labels"] != "spectr,docs" || !reflect.DeepEqual(create.Body["reviewer_ids"], []any{float64(42)}) {
+		t.Errorf("Unexpected create body: %v", create.Body)
+	}
+}
+
+func TestGitLabClient_UnknownReviewer(t *testing.T) {
+	f := newFakeForge(t, map[string]string{"GET /users?username=bob": `[]`})
+	client := &GitLabClient{APIURL: f.URL, Token: "secret", Repo: Repository{Path: "group/repo"}}
+
+	_, err := client.CreatePullRequest(PROptions{Title: "t", Branch: "b", Base: "main", Reviewers: []string{"bob"}})
+	if err == nil || !strings.Contains(err.Error(), "reviewer bob not found") {
+		t.Errorf("Expected unknown reviewer error, got %v", err)
+	}
+}
+
+func TestGiteaClient_CreatePullRequest(t *testing.T) {
+	f := newFakeForge(t, map[string]string{
+		"GET /repos/owner/repo/labels?limit=50&page=1":       `[{"id": 1, "name": "Spectr"}, {"id": 2, "name": "docs"}]`,
+		"POST /repos/owner/repo/pulls":                       pullResponse,
+		"POST /repos/owner/repo/pulls/7/requested_reviewers": `{}`,
+	})
+	client := &GiteaClient{APIURL: f.URL, Token: "secret", Repo: Repository{Path: "owner/repo"}}
+
+	pr, err := client.CreatePullRequest(PROptions{
+		Title:     "Archive: x",
+		Branch:    "archive-x",
+		Base:      "main",
+		Draft:     true,
+		Labels:    []string{"spectr"},
+		Reviewers: []string{"alice"},
+	})
+	if err != nil {
+		t.Fatalf("CreatePullRequest failed: %v", err)
+	}
+	if pr.Number != 7 || !pr.Draft {
+		t.Errorf("Unexpected PullRequest: %+v", pr)
+	}
+
+	create := f.request(t, "POST /repos/owner/repo/pulls")
+	if got := create.Header.Get("Authorization"); got != "token secret" {
+		t.Errorf("Authorization = %q", got)
+	}
+	if create.Body["title"] != "Draft: Archive: x" || !reflect.DeepEqual(create.Body["labels"], []any{float64(1)}) {
+		t.Errorf("Unexpected create body: %v", create.Body)
+	}
+	f.request(t, "POST /repos/owner/repo/pulls/7/requested_reviewers")
+
+	if _, err := client.CreatePullRequest(PROptions{Title: "t", Branch: "b", Base: "main", Labels: []string{"missing"}}); err == nil {
+		t.Error("Expected an error for an unknown label")
+	}
+}
+
+func TestNewForge(t *testing.T) {
+	tests := []struct {
+		platform Platform
+		repo     Repository
+		apiURL   string
+	}{
+		{PlatformGitHub, Repository{"https", "github.com", "o/r"}, "https://api.github.com"},
+		{PlatformGitHub, Repository{"https", "ghe.corp", "o/r"}, "https://ghe.corp/api/v3"},
+		{PlatformGitLab, Repository{"https", "gitlab.com", "o/r"}, "https://gitlab.com/api/v4"},
+		{PlatformGitea, Repository{"http", "git.local:3000", "o/r"}, "http://git.local:3000/api/v1"},
+		{PlatformBitbucket, Repository{"https", "bb.corp", "scm/PROJ/r"}, "https://bb.corp/rest/api/1.0"},
+		{PlatformAzure, Repository{"https", "dev.azure.com", "org/project/_git/r"}, "https://dev.azure.com/org"},
+	}
+
+	for _, tt := range tests {
+		forge, err := NewForge(tt.platform, tt.repo, "token")
+		if err != nil {
+			t.Fatalf("NewForge(%s) failed: %v", tt.platform, err)
+		}
+		var apiURL string
+		switch client := forge.(type) {
+		case *GitHubClient:
+			apiURL = client.APIURL
+		case *GitLabClient:
+			apiURL = client.APIURL
+		case *GiteaClient:
+			apiURL = client.APIURL
+		case *BitbucketClient:
+			apiURL = client.APIURL
+		case *AzureClient:
+			apiURL = client.APIURL
+		}
+		if apiURL != tt.apiURL {
+			t.Errorf("NewForge(%s, %s) API URL = %q, want %q", tt.platform, tt.repo.Host, apiURL, tt.apiURL)
+		}
+	}
+
+	if _, err := NewForge(PlatformUnknown, Repository{}, ""); err == nil {
+		t.Error("Expected an error for an unknown platform")
+	}
+	if _, err := NewForge(PlatformBitbucket, Repository{"https", "bitbucket.org", "ws/r"}, ""); err == nil {
+		t.Error("Expected an error for Bitbucket Cloud")
+	}
+}
+
+func TestLookupToken(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "")
+	t.Setenv("GH_TOKEN", "gh-env")
+	t.Setenv("GITLAB_TOKEN", "gitlab-env")
+	configured := map[string]string{"gitlab.com": "config", "gitlab.example.com": "gitlab-config"}
+
+	if got := LookupToken(PlatformGitHub, "github.com", configured); got != "gh-env" {
+		t.Errorf("Expected the environment token, got %q", got)
+	}
+	if got := LookupToken(PlatformGitLab, "gitlab.com", configured); got != "config" {
+		t.Errorf("Expected the configured token to win, got %q", got)
+	}
+	if got := LookupToken(PlatformGitLab, "gitlab.example.com", configured); got != "gitlab-config" {
+		t.Errorf("Expected the configured token, got %q", got)
+	}
+
+	// Environment tokens never go to other hosts
+	for _, host := range []string{"github.evil.com", "github.com.evil.com", "other.example.com"} {
+		if got := LookupToken(PlatformGitHub, host, configured); got != "" {
+			t.Errorf("Expected no token for %s, got %q", host, got)
+		}
+	}
+	if got := LookupToken(PlatformGitLab, "github.com", configured); got != "" {
+		t.Errorf("Expected no GitLab token for github.com, got %q", got)
+	}
+}
+
+func TestIsPublicHost(t *testing.T) {
+	tests := []struct {
+		platform Platform
+		host     string
+		want     bool
+	}{
+		{PlatformGitHub, "github.com", true},
+		{PlatformGitHub, "GitHub.com", true},
+		{PlatformGitHub, "github.evil.com", false},
+		{PlatformGitLab, "github.com", false},
+		{PlatformGitea, "codeberg.org", true},
+		{PlatformAzure, "dev.azure.com", true},
+		{PlatformAzure, "org.visualstudio.com", true},
+		{PlatformGitHub, "org.visualstudio.com", false},
+	}
+	for _, tt := range tests {
+		if got := IsPublicHost(tt.platform, tt.host); got != tt.want {
+			t.Errorf("IsPublicHost(%s, %s) = %v, want %v", tt.platform, tt.host, got, tt.want)
+		}
+	}
+}
+
+func TestBitbucketRepository(t *testing.T) {
+	tests := []struct {
+		repo   Repository
+		apiURL string
+		path   string
+	}{
+		{Repository{"https", "bb.corp", "scm/PROJ/repo"}, "https://bb.corp/rest/api/1.0", "PROJ/repo"},
+		{Repository{"https", "corp.com", "bitbucket/scm/PROJ/repo"}, "https://corp.com/bitbucket/rest/api/1.0", "PROJ/repo"},
+		{Repository{"https", "bb.corp", "proj/repo"}, "https://bb.corp/rest/api/1.0", "proj/repo"},
+	}
+
+	for _, tt := range tests {
+		apiURL, repo := bitbucketRepository(tt.repo)
+		if apiURL != tt.apiURL || repo.Path != tt.path {
+			t.Errorf("bitbucketRepository(%s) = %q, %q, want %q, %q", tt.repo.Path, apiURL, repo.Path, tt.apiURL, tt.path)
+		}
+	}
+}
+
+func TestBitbucketClient_CreatePullRequest(t *testing.T) {
+	f := newFakeForge(t, map[string]string{
+		"GET /projects/PROJ/repos/repo/branches/default": `{"displayId": "master"}`,
+		"POST /projects/PROJ/repos/repo/pull-requests": `{"id": 12, "title": "Archive: x",
+			"fromRef": {"displayId": "archive-x"}, "toRef": {"displayId": "master"},
+			"links": {"self": [{"href": "https://bb.corp/projects/PROJ/repos/repo/pull-requests/12"}]}}`,
+	})
+	client := &BitbucketClient{APIURL: f.URL, Token: "secret", Repo: Repository{Path: "PROJ/repo"}}
+
+	pr, err := client.CreatePullRequest(PROptions{
+		Title:     "Archive: x",
+		Body:      "body",
+		Branch:    "archive-x",
+		Labels:    []string{"ignored"},
+		Reviewers: []string{"alice"},
+	})
+	if err != nil {
+		t.Fatalf("CreatePullRequest failed: %v", err)
+	}
+
+	want := &PullRequest{Number: 12, URL: "https://bb.corp/projects/PROJ/repos/repo/pull-requests/12", Title: "Archive: x", Head: "archive-x", Base: "master"}
+	if !reflect.DeepEqual(pr, want) {
+		t.Errorf("PullRequest = %+v, want %+v", pr, want)
+	}
+
+	create := f.request(t, "POST /projects/PROJ/repos/repo/pull-requests")
+	if got := create.Header.Get("Authorization"); got != "Bearer secret" {
+		t.Errorf("Authorization = %q", got)
+	}
+	toRef, _ := create.Body["toRef"].(map[string]any)
+	if create.Body["description"] != "body" || toRef["id"] != "refs/heads/master" {
+		t.Errorf("Unexpected create body: %v", create.Body)
+	}
+	reviewers := []any{map[string]any{"user": map[string]any{"name": "alice"}}}
+	if !reflect.DeepEqual(create.Body["reviewers"], reviewers) {
+		t.Errorf("Unexpected reviewers: %v", create.Body["reviewers"])
+	}
+}
+
+func TestAzureRepository(t *testing.T) {
+	tests := []struct {
+		repo       Repository
+		collection string
+		path       string
+	}{
+		{Repository{"https", "dev.azure.com", "org/project/_git/repo"}, "https://dev.azure.com/org", "project/repo"},
+		{Repository{"https", "ssh.dev.azure.com", "v3/org/project/repo"}, "https://dev.azure.com/org", "project/repo"},
+		{Repository{"https", "vs-ssh.visualstudio.com", "v3/org/project/repo"}, "https://dev.azure.com/org", "project/repo"},
+		{Repository{"https", "org.visualstudio.com", "project/_git/repo"}, "https://org.visualstudio.com", "project/repo"},
+		{Repository{"https", "tfs.corp", "tfs/DefaultCollection/project/_git/repo"}, "https://tfs.corp/tfs/DefaultCollection", "project/repo"},
+	}
+
+	for _, tt := range tests {
+		collection, repo, err := azureRepository(tt.repo)
+		if err != nil {
+			t.Fatalf("azureRepository(%s) failed: %v", tt.repo.Path, err)
+		}
+		if collection != tt.collection || repo.Path != tt.path {
+			t.Errorf("azureRepository(%s) = %q, %q, want %q, %q", tt.repo.Path, collection, repo.Path, tt.collection, tt.path)
+		}
+	}
+
+	if _, _, err := azureRepository(Repository{"https", "dev.azure.com", "org/repo"}); err == nil {
+		t.Error("Expected an error for a path without _git")
+	}
+
+	if got := azureIdentityURL("https://dev.azure.com/org"); got != "https://vssps.dev.azure.com/org" {
+		t.Errorf("azureIdentityURL = %q", got)
+	}
+	if got := azureIdentityURL("https://org.visualstudio.com"); got != "https://org.vssps.visualstudio.com" {
+		t.Errorf("azureIdentityURL = %q", got)
+	}
+}
+
+func TestAzureClient_CreatePullRequest(t *testing.T) {
+	f := newFakeForge(t, map[string]string{
+		"GET /project/_apis/git/repositories/repo?api-version=7.0":                                `{"defaultBranch": "refs/heads/main"}`,
+		"GET /_apis/identities?searchFilter=General&filterValue=alice%40corp.com&api-version=7.0": `{"value": [{"id": "uuid-1"}]}`,
+		"POST /project/_apis/git/repositories/repo/pullrequests?api-version=7.0": `{"pullRequestId": 5, "title": "Archive: x",
+			"isDraft": true, "sourceRefName": "refs/heads/archive-x", "targetRefName": "refs/heads/main",
+			"repository": {"webUrl": "https://dev.azure.com/org/project/_git/repo"}}`,
+	})
+	client := &AzureClient{APIURL: f.URL, Token: "secret", Repo: Repository{Path: "project/repo"}}
+
+	pr, err := client.CreatePullRequest(PROptions{
+		Title:     "Archive: x",
+		Body:      "body",
+		Branch:    "archive-x",
+		Draft:     true,
+		Labels:    []string{"spectr"},
+		Reviewers: []string{"alice@corp.com"},
+	})
+	if err != nil {
+		t.Fatalf("CreatePullRequest failed: %v", err)
+	}
+
+	want := &PullRequest{Number: 5, URL: "https://dev.azure.com/org/project/_git/repo/pullrequest/5", Title: "Archive: x", Head: "archive-x", Base: "main", Draft: true}
+	if !reflect.DeepEqual(pr, want) {
+		t.Errorf("PullRequest = %+v, want %+v", pr, want)
+	}
+
+	create := f.request(t, "POST /project/_apis/git/repositories/repo/pullrequests?api-version=7.0")
+	if got := create.Header.Get("Authorization"); got != "Basic OnNlY3JldA==" {
+		t.Errorf("Authorization = %q", got)
+	}
+	if create.Body["targetRefName"] != "refs/heads/main" || create.Body["isDraft"] != true ||
+		!reflect.DeepEqual(create.Body["labels"], []any{map[string]any{"name": "spectr"}}) ||
+		!reflect.DeepEqual(create.Body["reviewers"], []any{map[string]any{"id": "uuid-1"}}) {
+		t.Errorf("Unexpected create body: %v", create.Body)
+	}
+}
@@ -0,0 +1,148 @@
+package git
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// giteaPageSize is the page size of Gitea list requests
+const giteaPageSize = 50
+
+// GiteaClient creates pull requests through the Gitea and Forgejo
+// REST API
+type GiteaClient struct {
+	// APIURL is https://<host>/api/v1
+	APIURL string
+	Token  string
+	Repo   Repository
+	// HTTPClient overrides the default HTTP client
+	HTTPClient *http.Client
+}
+
+// giteaPull is the part of a Gitea pull request response spectr uses
+type giteaPull struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+	Title   string `json:"title"`
+	Draft   bool   `json:"draft"`
+	Head    struct {
+		Ref string `json:"ref"`
+	} `json:"head"`
+	Base struct {
+		Ref string `json:"ref"`
+	} `json:"base"`
+}
+
+// client returns the API client authenticated with the token
+func (c *GiteaClient) client() *apiClient {
+	header := http.Header{}
+	header.Set("Authorization", "token "+c.Token)
+
+	return newAPIClient(c.APIURL, header, c.HTTPClient)
+}
+
+// CreatePullRequest opens a pull request from opts.Branch. Labels are
+// names, resolved to label IDs first; drafts get the "Draft: " title
+// prefix, which Gitea treats as work in progress.
+func (c *GiteaClient) CreatePullRequest(opts PROptions) (*PullRequest, error) {
+	api := c.client()
+	repoPath := "/repos/" + c.Repo.Path
+
+	base := opts.Base
+	if base == "" {
+		var repo struct {
+			DefaultBranch string `json:"default_branch"`
+		}
+		if err := api.do(http.MethodGet, repoPath, nil, &repo); err != nil {
+			return nil, fmt.Errorf("look up default branch: %w", err)
+		}
+		base = repo.DefaultBranch
+	}
+
+	labelIDs, err := c.labelIDs(api, repoPath, opts.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	title := opts.Title
+	if opts.Draft && !strings.HasPrefix(title, draftPrefix) {
+		title = draftPrefix + title
+	}
+
+	request := map[string]any{
+		"title": title,
+		"body":  opts.Body,
+		"head":  opts.Branch,
+		"base":  base,
+	}
+	if len(labelIDs) > 0 {
+		request["labels"] = labelIDs
+	}
+
+	var pull giteaPull
+	if err := api.do(http.MethodPost, repoPath+"/pulls", request, &pull); err != nil {
+		return nil, fmt.Errorf("create Gitea PR: %w", err)
+	}
+
+	pr := &PullRequest{
+		Number: pull.Number,
+		URL:    pull.HTMLURL,
+		Title:  pull.Title,
+		Head:   pull.Head.Ref,
+		Base:   pull.Base.Ref,
+		Draft:  pull.Draft || opts.Draft,
+	}
+
+	if len(opts.Reviewers) > 0 {
+		path := fmt.Sprintf("%s/pulls/%d/requested_reviewers", repoPath, pull.Number)
+		err := api.do(http.MethodPost, path, map[string]any{"reviewers": opts.Reviewers}, nil)
+		if err != nil {
+			return pr, fmt.Errorf("request reviewers on %s: %w", pr.URL, err)
+		}
+	}
+
+	return pr, nil
+}
+
+// labelIDs resolves label names to the IDs of the repository's labels
+func (*GiteaClient) labelIDs(api *apiClient, repoPath string, names []string) ([]int64, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	type label struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	}
+	var labels []label
+	for page := 1; ; page++ {
+		var batch []label
+		path := fmt.Sprintf("%s/labels?limit=%d&page=%d", repoPath, giteaPageSize, page)
+		if err := api.do(http.MethodGet, path, nil, &batch); err != nil {
+			return nil, fmt.Errorf("list labels: %w", err)
+		}
+		labels = append(labels, batch...)
+		if len(batch) < giteaPageSize {
+			break
+		}
+	}
+
+	ids := make([]int64, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, label := range labels {
+			if strings.EqualFold(label.Name, name) {
+				ids = append(ids, label.ID)
+				found = true
+
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("label %q does not exist in %s", name, repoPath)
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,109 @@
+package git
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GitHubClient creates pull requests through the GitHub REST API
+type GitHubClient struct {
+	// APIURL is https://api.github.com, or https://<host>/api/v3 for
+	// GitHub Enterprise Server
+	APIURL string
+	Token  string
+	Repo   Repository
+	// HTTPClient overrides the default HTTP client
+	HTTPClient *http.Client
+}
+
+// githubPull is the part of a GitHub pull request response spectr uses
+type githubPull struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+	Title   string `json:"title"`
+	Draft   bool   `json:"draft"`
+	Head    struct {
+		Ref string `json:"ref"`
+	} `json:"head"`
+	Base struct {
+		Ref string `json:"ref"`
+	} `json:"base"`
+}
+
+// client returns the API client authenticated with the token
+func (c *GitHubClient) client() *apiClient {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+c.Token)
+	header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	return newAPIClient(c.APIURL, header, c.HTTPClient)
+}
+
+// CreatePullRequest opens a pull request from opts.Branch, then adds
+// labels and requests reviewers. Reviewers of the form "org/team"
+// are requested as teams.
+func (c *GitHubClient) CreatePullRequest(opts PROptions) (*PullRequest, error) {
+	api := c.client()
+	repoPath := "/repos/" + c.Repo.Path
+
+	base := opts.Base
+	if base == "" {
+		var repo struct {
+			DefaultBranch string `json:"default_branch"`
+		}
+		if err := api.do(http.MethodGet, repoPath, nil, &repo); err != nil {
+			return nil, fmt.Errorf("look up default branch: %w", err)
+		}
+		base = repo.DefaultBranch
+	}
+
+	var pull githubPull
+	err := api.do(http.MethodPost, repoPath+"/pulls", map[string]any{
+		"title": opts.Title,
+		"body":  opts.Body,
+		"head":  opts.Branch,
+		"base":  base,
+		"draft": opts.Draft,
+	}, &pull)
+	if err != nil {
+		return nil, fmt.Errorf("create GitHub PR: %w", err)
+	}
+
+	pr := &PullRequest{
+		Number: pull.Number,
+		URL:    pull.HTMLURL,
+		Title:  pull.Title,
+		Head:   pull.Head.Ref,
+		Base:   pull.Base.Ref,
+		Draft:  pull.Draft,
+	}
+
+	if len(opts.Labels) > 0 {
+		path := fmt.Sprintf("%s/issues/%d/labels", repoPath, pull.Number)
+		if err := api.do(http.MethodPost, path, map[string]any{"labels": opts.Labels}, nil); err != nil {
+			return pr, fmt.Errorf("add labels to %s: %w", pr.URL, err)
+		}
+	}
+
+	if len(opts.Reviewers) > 0 {
+		users, teams := make([]string, 0), make([]string, 0)
+		for _, reviewer := range opts.Reviewers {
+			if _, team, ok := strings.Cut(reviewer, "/"); ok {
+				teams = append(teams, team)
+			} else {
+				users = append(users, reviewer)
+			}
+		}
+		path := fmt.Sprintf("%s/pulls/%d/requested_reviewers", repoPath, pull.Number)
+		err := api.do(http.MethodPost, path, map[string]any{
+			"reviewers":      users,
+			"team_reviewers": teams,
+		}, nil)
+		if err != nil {
+			return pr, fmt.Errorf("request reviewers on %s: %w", pr.URL, err)
+		}
+	}
+
+	return pr, nil
+}
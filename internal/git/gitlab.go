@@ -0,0 +1,106 @@
+package git
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// draftPrefix marks merge requests and Gitea pull requests as drafts
+const draftPrefix = "Draft: "
+
+// GitLabClient creates merge requests through the GitLab REST API
+type GitLabClient struct {
+	// APIURL is https://<host>/api/v4
+	APIURL string
+	Token  string
+	Repo   Repository
+	// HTTPClient overrides the default HTTP client
+	HTTPClient *http.Client
+}
+
+// gitlabMergeRequest is the part of a GitLab merge request response
+// spectr uses
+type gitlabMergeRequest struct {
+	IID          int    `json:"iid"`
+	WebURL       string `json:"web_url"`
+	Title        string `json:"title"`
+	Draft        bool   `json:"draft"`
+	SourceBranch string `json:"source_branch"`
+	TargetBranch string `json:"target_branch"`
+}
+
+// client returns the API client authenticated with the token
+func (c *GitLabClient) client() *apiClient {
+	header := http.Header{}
+	header.Set("PRIVATE-TOKEN", c.Token)
+
+	return newAPIClient(c.APIURL, header, c.HTTPClient)
+}
+
+// CreatePullRequest opens a merge request from opts.Branch. Reviewers
+// are usernames, resolved to user IDs first; drafts get the "Draft: "
+// title prefix.
+func (c *GitLabClient) CreatePullRequest(opts PROptions) (*PullRequest, error) {
+	api := c.client()
+	projectPath := "/projects/" + url.PathEscape(c.Repo.Path)
+
+	base := opts.Base
+	if base == "" {
+		var project struct {
+			DefaultBranch string `json:"default_branch"`
+		}
+		if err := api.do(http.MethodGet, projectPath, nil, &project); err != nil {
+			return nil, fmt.Errorf("look up default branch: %w", err)
+		}
+		base = project.DefaultBranch
+	}
+
+	reviewerIDs := make([]int, 0, len(opts.Reviewers))
+	for _, username := range opts.Reviewers {
+		var users []struct {
+			ID int `json:"id"`
+		}
+		path := "/users?username=" + url.QueryEscape(username)
+		if err := api.do(http.MethodGet, path, nil, &users); err != nil {
+			return nil, fmt.Errorf("look up reviewer %s: %w", username, err)
+		}
+		if len(users) == 0 {
+			return nil, fmt.Errorf("reviewer %s not found", username)
+		}
+		reviewerIDs = append(reviewerIDs, users[0].ID)
+	}
+
+	title := opts.Title
+	if opts.Draft && !strings.HasPrefix(title, draftPrefix) {
+		title = draftPrefix + title
+	}
+
+	request := map[string]any{
+		"source_branch": opts.Branch,
+		"target_branch": base,
+		"title":         title,
+		"description":   opts.Body,
+	}
+	if len(opts.Labels) > 0 {
+		request["labels"] = strings.Join(opts.Labels, ",")
+	}
+	if len(reviewerIDs) > 0 {
+		request["reviewer_ids"] = reviewerIDs
+	}
+
+	var mr gitlabMergeRequest
+	if err := api.do(http.MethodPost, projectPath+"/merge_requests", request, &mr); err != nil {
+		return nil, fmt.Errorf("create GitLab MR: %w", err)
+	}
+
+	return &PullRequest{
+		Number: mr.IID,
+		URL:    mr.WebURL,
+		Title:  mr.Title,
+		Head:   mr.SourceBranch,
+		Base:   mr.TargetBranch,
+		Draft:  mr.Draft,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package git
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Revision is a commit that changed a file
+type Revision struct {
+	// Commit is the full commit hash
+	Commit string `json:"commit"`
+	// Time is the commit time
+	Time time.Time `json:"time"`
+}
+
+// FileHistory returns the commits that changed the file at path, oldest
+// first. A file that is not tracked has no history.
+func FileHistory(path string) ([]Revision, error) {
+	cmd := exec.Command(
+		gitCommand, "-C", filepath.Dir(path),
+		"log", "--format=%H %cI", "--", filepath.Base(path),
+	)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("read history of %s: %w", path, err)
+	}
+
+	var revisions []Revision
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		commit, date, ok := strings.Cut(line, " ")
+		if !ok {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, date)
+		if err != nil {
+			return nil, fmt.Errorf("parse commit time %q: %w", date, err)
+		}
+		revisions = append(revisions, Revision{Commit: commit, Time: t})
+	}
+
+	// git log lists the newest commit first
+	for i, j := 0, len(revisions)-1; i < j; i, j = i+1, j-1 {
+		revisions[i], revisions[j] = revisions[j], revisions[i]
+	}
+
+	return revisions, nil
+}
+
+// ShowFile returns the content of the file at path as of commit
+func ShowFile(commit, path string) (string, error) {
+	cmd := exec.Command(
+		gitCommand, "-C", filepath.Dir(path),
+		"show", commit+":./"+filepath.Base(path),
+	)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("read %s at %s: %w", path, commit, err)
+	}
+
+	return string(output), nil
+}
@@ -0,0 +1,66 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// commitFile writes content to path and commits it at the given time
+func commitFile(t *testing.T, dir, path, content, date string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, args := range [][]string{
+		{"add", "-A"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", date},
+	} {
+		cmd := exec.Command(gitCommand, append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_DATE="+date, "GIT_COMMITTER_DATE="+date)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, output)
+		}
+	}
+}
+
+func TestFileHistory(t *testing.T) {
+	if _, err := exec.LookPath(gitCommand); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	if output, err := exec.Command(gitCommand, "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init failed: %v\n%s", err, output)
+	}
+	sub := filepath.Join(dir, "change")
+	if err := os.MkdirAll(sub, 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(sub, "tasks.md")
+	commitFile(t, dir, path, "one\n", "2025-01-01T10:00:00Z")
+	commitFile(t, dir, filepath.Join(dir, "other.md"), "x\n", "2025-01-02T10:00:00Z")
+	commitFile(t, dir, path, "two\n", "2025-01-03T10:00:00Z")
+
+	revisions, err := FileHistory(path)
+	if err != nil {
+		t.Fatalf("FileHistory failed: %v", err)
+	}
+	if len(revisions) != 2 {
+		t.Fatalf("Expected 2 revisions, got %+v", revisions)
+	}
+	if want := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC); !revisions[0].Time.Equal(want) {
+		t.Errorf("First revision at %v, want %v", revisions[0].Time, want)
+	}
+
+	content, err := ShowFile(revisions[0].Commit, path)
+	if err != nil || content != "one\n" {
+		t.Errorf("ShowFile = %q, %v", content, err)
+	}
+
+	if revisions, err := FileHistory(filepath.Join(sub, "missing.md")); err != nil || len(revisions) != 0 {
+		t.Errorf("Expected no history for an untracked file, got %+v, %v", revisions, err)
+	}
+}
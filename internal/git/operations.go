@@ -14,7 +14,9 @@ import (
 )
 
 const (
-	gitCommand       = "git"
+	gitCommand = "git"
+	// DefaultRemote is the remote used unless another is configured
+	DefaultRemote    = "origin"
 	branchUUIDLength = 8 // Length of UUID suffix for branch names
 )
 
@@ -30,21 +32,73 @@ func IsGitRepository() error {
 	return nil
 }
 
-// HasOriginRemote checks if the origin remote is configured
-func HasOriginRemote() error {
-	cmd := exec.Command(gitCommand, "config", "--get", "remote.origin.url")
+// TopLevel returns the root directory of the git work tree containing dir
+func TopLevel(dir string) (string, error) {
+	cmd := exec.Command(gitCommand, "-C", dir, "rev-parse", "--show-toplevel")
 	output, err := cmd.Output()
 	if err != nil {
-		return errors.New(
-			"no 'origin' remote configured. " +
-				"Run 'git remote add origin <url>'",
+		return "", fmt.Errorf("find repository root: %w", err)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// RemoteURL returns the URL of the named remote
+func RemoteURL(remote string) (string, error) {
+	key := "remote." + remote + ".url"
+	output, err := exec.Command(gitCommand, "config", "--get", key).Output()
+	if err != nil {
+		return "", fmt.Errorf(
+			"no '%s' remote configured. Run 'git remote add %s <url>'",
+			remote,
+			remote,
 		)
 	}
-	if strings.TrimSpace(string(output)) == "" {
-		return errors.New("origin remote URL is empty")
+
+	url := strings.TrimSpace(string(output))
+	if url == "" {
+		return "", fmt.Errorf("%s remote URL is empty", remote)
 	}
 
-	return nil
+	return url, nil
+}
+
+// ResolveRemote returns the remote pull requests are pushed to: the
+// named remote, or else origin, or else the only remote of a repository
+// with exactly one
+func ResolveRemote(name string) (string, error) {
+	if name != "" {
+		if _, err := RemoteURL(name); err != nil {
+			return "", err
+		}
+
+		return name, nil
+	}
+
+	if _, err := RemoteURL(DefaultRemote); err == nil {
+		return DefaultRemote, nil
+	}
+
+	output, err := exec.Command(gitCommand, "remote").Output()
+	if err != nil {
+		return "", fmt.Errorf("list remotes: %w", err)
+	}
+	remotes := strings.Fields(string(output))
+	switch len(remotes) {
+	case 0:
+		return "", fmt.Errorf(
+			"no remote configured. Run 'git remote add %s <url>'",
+			DefaultRemote,
+		)
+	case 1:
+		return remotes[0], nil
+	default:
+		return "", fmt.Errorf(
+			"no '%s' remote and several others (%s). Choose one with --remote",
+			DefaultRemote,
+			strings.Join(remotes, ", "),
+		)
+	}
 }
 
 // CreateBranch creates a new git branch with the given name
@@ -83,9 +137,9 @@ func Commit(message string) error {
 	return nil
 }
 
-// Push pushes the current branch to origin
-func Push(branchName string) error {
-	cmd := exec.Command(gitCommand, "push", "-u", "origin", branchName)
+// Push pushes the branch to remote
+func Push(remote, branchName string) error {
+	cmd := exec.Command(gitCommand, "push", "-u", remote, branchName)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("push branch: %w\nOutput: %s", err, string(output))
@@ -126,6 +180,17 @@ func BranchExists(branchName string) bool {
 	return err == nil
 }
 
+// DeleteBranch deletes a local branch, even if it is not merged
+func DeleteBranch(branchName string) error {
+	cmd := exec.Command(gitCommand, "branch", "-D", branchName)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("delete branch: %w\nOutput: %s", err, string(output))
+	}
+
+	return nil
+}
+
 // RestorePath restores a file or directory from HEAD
 func RestorePath(path string) error {
 	cmd := exec.Command(gitCommand, "checkout", "HEAD", "--", path)
@@ -0,0 +1,81 @@
+package git
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// gitIn runs git in dir, failing the test on error
+func gitIn(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command(gitCommand, append([]string{"-C", dir}, args...)...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v failed: %v\n%s", args, err, output)
+	}
+}
+
+func TestResolveRemote(t *testing.T) {
+	if _, err := exec.LookPath(gitCommand); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	gitIn(t, dir, "init", "-q")
+	t.Chdir(dir)
+
+	if _, err := ResolveRemote(""); err == nil || !strings.Contains(err.Error(), "no remote configured") {
+		t.Errorf("Expected an error without remotes, got %v", err)
+	}
+
+	gitIn(t, dir, "remote", "add", "upstream", "git@github.com:org/repo.git")
+	if remote, err := ResolveRemote(""); err != nil || remote != "upstream" {
+		t.Errorf("Expected the only remote, got %q, %v", remote, err)
+	}
+
+	gitIn(t, dir, "remote", "add", "fork", "git@github.com:me/repo.git")
+	if _, err := ResolveRemote(""); err == nil || !strings.Contains(err.Error(), "--remote") {
+		t.Errorf("Expected an ambiguity error, got %v", err)
+	}
+	if remote, err := ResolveRemote("fork"); err != nil || remote != "fork" {
+		t.Errorf("Expected the named remote, got %q, %v", remote, err)
+	}
+	if _, err := ResolveRemote("missing"); err == nil {
+		t.Error("Expected an error for a missing remote")
+	}
+
+	gitIn(t, dir, "remote", "add", "origin", "https://gitlab.com/org/repo.git")
+	if remote, err := ResolveRemote(""); err != nil || remote != DefaultRemote {
+		t.Errorf("Expected origin, got %q, %v", remote, err)
+	}
+
+	platform, url, err := DetectPlatform("upstream", nil)
+	if err != nil || platform != PlatformGitHub || url != "git@github.com:org/repo.git" {
+		t.Errorf("DetectPlatform(upstream) = %v, %q, %v", platform, url, err)
+	}
+}
+
+func TestDeleteBranch(t *testing.T) {
+	if _, err := exec.LookPath(gitCommand); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	gitIn(t, dir, "init", "-q")
+	gitIn(t, dir, "config", "user.name", "test")
+	gitIn(t, dir, "config", "user.email", "test@example.com")
+	gitIn(t, dir, "commit", "-q", "--allow-empty", "-m", "init")
+	gitIn(t, dir, "branch", "propose-x")
+	t.Chdir(dir)
+
+	if !BranchExists("propose-x") {
+		t.Fatal("Expected the branch to exist")
+	}
+	if err := DeleteBranch("propose-x"); err != nil {
+		t.Fatalf("DeleteBranch failed: %v", err)
+	}
+	if BranchExists("propose-x") {
+		t.Error("Expected the branch to be deleted")
+	}
+	if err := DeleteBranch("propose-x"); err == nil {
+		t.Error("Expected an error for a missing branch")
+	}
+}
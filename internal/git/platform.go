@@ -1,5 +1,6 @@
 // Package git provides utilities for git operations and pull request creation.
-// It supports GitHub, GitLab, and Gitea/Forgejo platforms.
+// It supports GitHub, GitLab, Gitea/Forgejo, Bitbucket Server, and Azure
+// DevOps platforms.
 package git
 
 import (
@@ -19,51 +20,174 @@ const (
 	PlatformGitLab Platform = "gitlab"
 	// PlatformGitea represents Gitea/Forgejo hosting
 	PlatformGitea Platform = "gitea"
+	// PlatformBitbucket represents Bitbucket Server and Data Center hosting
+	PlatformBitbucket Platform = "bitbucket"
+	// PlatformAzure represents Azure DevOps Services and Server hosting
+	PlatformAzure Platform = "azure"
 	// PlatformUnknown represents an unknown platform
 	PlatformUnknown Platform = "unknown"
 )
 
-// DetectPlatform detects the git hosting platform from the origin remote URL
-func DetectPlatform() (Platform, string, error) {
-	// Get the origin remote URL
-	cmd := exec.Command("git", "config", "--get", "remote.origin.url")
-	output, err := cmd.Output()
-	if err != nil {
-		return PlatformUnknown, "", fmt.Errorf("get origin remote URL: %w", err)
+// knownPlatforms lists the platforms that can be configured by name
+var knownPlatforms = []Platform{
+	PlatformGitHub,
+	PlatformGitLab,
+	PlatformGitea,
+	PlatformBitbucket,
+	PlatformAzure,
+}
+
+// ParsePlatform returns the platform called name
+func ParsePlatform(name string) (Platform, error) {
+	for _, platform := range knownPlatforms {
+		if strings.EqualFold(name, string(platform)) {
+			return platform, nil
+		}
 	}
 
-	url := strings.TrimSpace(string(output))
-	if url == "" {
-		return PlatformUnknown, "", errors.New("origin remote URL is empty")
+	names := make([]string, len(knownPlatforms))
+	for i, platform := range knownPlatforms {
+		names[i] = string(platform)
 	}
 
-	// Detect platform from URL
-	platform := detectPlatformFromURL(url)
+	return PlatformUnknown, fmt.Errorf(
+		"unknown platform %q (expected one of %s)",
+		name,
+		strings.Join(names, ", "),
+	)
+}
+
+// DetectPlatform detects the git hosting platform from the URL of the
+// named remote. hosts maps forge hosts to platform names and takes
+// precedence over detection from the URL, for self-hosted forges with
+// neutral names.
+func DetectPlatform(remote string, hosts map[string]string) (Platform, string, error) {
+	url, err := RemoteURL(remote)
+	if err != nil {
+		return PlatformUnknown, "", err
+	}
+
+	platform, err := platformForURL(url, hosts)
+	if err != nil {
+		return PlatformUnknown, url, err
+	}
 
 	return platform, url, nil
 }
 
-// detectPlatformFromURL detects the platform from a git remote URL
-func detectPlatformFromURL(url string) Platform {
-	lowerURL := strings.ToLower(url)
+// platformForURL returns the platform configured in hosts for the host
+// of url, or else the platform detected from url
+func platformForURL(url string, hosts map[string]string) (Platform, error) {
+	repo, err := ParseRemoteURL(url)
+	if err != nil {
+		return PlatformUnknown, err
+	}
 
-	// Check for GitHub
-	if strings.Contains(lowerURL, "github.com") {
-		return PlatformGitHub
+	platform, ok, err := mappedPlatform(repo, hosts)
+	if err != nil || ok {
+		return platform, err
 	}
 
-	// Check for GitLab
-	gitlabCom := strings.Contains(lowerURL, "gitlab.com")
-	gitlab := strings.Contains(lowerURL, "gitlab")
-	if gitlabCom || gitlab {
-		return PlatformGitLab
+	return detectPlatform(repo), nil
+}
+
+// mappedPlatform returns the platform configured in hosts for the host
+// of repo, and whether there is one
+func mappedPlatform(repo Repository, hosts map[string]string) (Platform, bool, error) {
+	for host, name := range hosts {
+		if !strings.EqualFold(host, repo.Host) &&
+			!strings.EqualFold(host, repo.Hostname()) {
+			continue
+		}
+		platform, err := ParsePlatform(name)
+		if err != nil {
+			return PlatformUnknown, false, fmt.Errorf("platform of %s: %w", host, err)
+		}
+
+		return platform, true, nil
 	}
 
-	// Check for Gitea/Forgejo
-	gitea := strings.Contains(lowerURL, "gitea")
-	forgejo := strings.Contains(lowerURL, "forgejo")
-	if gitea || forgejo {
-		return PlatformGitea
+	return PlatformUnknown, false, nil
+}
+
+// IsTrustedHost reports whether the platform of repo is known for
+// certain, rather than guessed from its host name: repo is on a public
+// host of platform, or its host is mapped to platform in hosts. Only
+// trusted hosts are sent API tokens, so a host such as github.evil.com
+// never receives a GitHub token.
+func IsTrustedHost(platform Platform, repo Repository, hosts map[string]string) bool {
+	if IsPublicHost(platform, repo.Hostname()) {
+		return true
+	}
+	mapped, ok, err := mappedPlatform(repo, hosts)
+
+	return err == nil && ok && mapped == platform
+}
+
+// hostedPlatforms maps the hosts of public forges to their platforms
+var hostedPlatforms = map[string]Platform{
+	"github.com":              PlatformGitHub,
+	"gitlab.com":              PlatformGitLab,
+	"gitea.com":               PlatformGitea,
+	"codeberg.org":            PlatformGitea,
+	"bitbucket.org":           PlatformBitbucket,
+	"dev.azure.com":           PlatformAzure,
+	"ssh.dev.azure.com":       PlatformAzure,
+	"vs-ssh.visualstudio.com": PlatformAzure,
+}
+
+// IsPublicHost reports whether host is a public host of platform, such
+// as github.com for GitHub
+func IsPublicHost(platform Platform, host string) bool {
+	host = strings.ToLower(host)
+	if hosted, ok := hostedPlatforms[host]; ok {
+		return hosted == platform
+	}
+
+	return platform == PlatformAzure && strings.HasSuffix(host, ".visualstudio.com")
+}
+
+// hostHints maps words in the host names of self-hosted forges, such as
+// gitlab.example.com, to their platforms. A hint only picks the CLI
+// tool; hosts detected this way are not trusted with API tokens.
+var hostHints = []struct {
+	word     string
+	platform Platform
+}{
+	{"github", PlatformGitHub},
+	{"gitlab", PlatformGitLab},
+	{"gitea", PlatformGitea},
+	{"forgejo", PlatformGitea},
+	{"bitbucket", PlatformBitbucket},
+}
+
+// detectPlatform detects the platform of a repository from its host,
+// falling back to the clone path layouts of Bitbucket Server
+// (scm/<project>/<repo>) and Azure DevOps Server (<project>/_git/<repo>).
+// Only the host and path layout count, so repository names such as
+// "gitlab-tools" do not affect detection.
+func detectPlatform(repo Repository) Platform {
+	host := repo.Hostname()
+	if platform, ok := hostedPlatforms[host]; ok {
+		return platform
+	}
+	if strings.HasSuffix(host, ".visualstudio.com") {
+		return PlatformAzure
+	}
+
+	for _, hint := range hostHints {
+		if strings.Contains(host, hint.word) {
+			return hint.platform
+		}
+	}
+
+	segments := strings.Split(repo.Path, "/")
+	n := len(segments)
+	if n >= 3 && segments[n-3] == "scm" {
+		return PlatformBitbucket
+	}
+	if n >= 3 && segments[n-2] == "_git" {
+		return PlatformAzure
 	}
 
 	return PlatformUnknown
@@ -78,6 +202,10 @@ func GetCLITool(platform Platform) (string, error) {
 		return "glab", nil
 	case PlatformGitea:
 		return "tea", nil
+	case PlatformAzure:
+		return "az", nil
+	case PlatformBitbucket:
+		return "", errors.New("bitbucket has no supported CLI tool")
 	case PlatformUnknown:
 		fallthrough
 	default:
@@ -116,7 +244,9 @@ func getInstallURL(platform Platform) string {
 		return "https://gitlab.com/gitlab-org/cli"
 	case PlatformGitea:
 		return "https://gitea.com/gitea/tea"
-	case PlatformUnknown:
+	case PlatformAzure:
+		return "https://learn.microsoft.com/cli/azure/install-azure-cli"
+	case PlatformBitbucket, PlatformUnknown:
 		fallthrough
 	default:
 		return ""
@@ -4,7 +4,7 @@ import (
 	"testing"
 )
 
-func TestDetectPlatformFromURL(t *testing.T) {
+func TestDetectPlatform(t *testing.T) {
 	tests := []struct {
 		name     string
 		url      string
@@ -45,6 +45,61 @@ func TestDetectPlatformFromURL(t *testing.T) {
 			url:      "https://forgejo.example.com/user/repo.git",
 			expected: PlatformGitea,
 		},
+		{
+			name:     "Bitbucket Server HTTPS",
+			url:      "https://bitbucket.example.com/scm/PROJ/repo.git",
+			expected: PlatformBitbucket,
+		},
+		{
+			name:     "Bitbucket Server SSH",
+			url:      "ssh://git@bitbucket.example.com:7999/proj/repo.git",
+			expected: PlatformBitbucket,
+		},
+		{
+			name:     "Bitbucket Server custom host",
+			url:      "https://code.example.com/scm/PROJ/repo.git",
+			expected: PlatformBitbucket,
+		},
+		{
+			name:     "Azure DevOps HTTPS",
+			url:      "https://org@dev.azure.com/org/project/_git/repo",
+			expected: PlatformAzure,
+		},
+		{
+			name:     "Azure DevOps SSH",
+			url:      "git@ssh.dev.azure.com:v3/org/project/repo",
+			expected: PlatformAzure,
+		},
+		{
+			name:     "Azure DevOps visualstudio.com",
+			url:      "https://org.visualstudio.com/project/_git/repo",
+			expected: PlatformAzure,
+		},
+		{
+			name:     "Azure DevOps Server",
+			url:      "https://tfs.example.com/tfs/DefaultCollection/project/_git/repo",
+			expected: PlatformAzure,
+		},
+		{
+			name:     "GitHub repository named after another forge",
+			url:      "git@github.com:user/gitlab-tools.git",
+			expected: PlatformGitHub,
+		},
+		{
+			name:     "Neutral host with forge name in path",
+			url:      "https://git.corp.example.com/team/gitea-mirror.git",
+			expected: PlatformUnknown,
+		},
+		{
+			name:     "GitHub Enterprise",
+			url:      "ssh://git@github.corp.example.com/team/repo.git",
+			expected: PlatformGitHub,
+		},
+		{
+			name:     "Codeberg",
+			url:      "https://codeberg.org/user/repo.git",
+			expected: PlatformGitea,
+		},
 		{
 			name:     "Unknown platform",
 			url:      "https://unknown.com/user/repo.git",
@@ -54,15 +109,98 @@ func TestDetectPlatformFromURL(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := detectPlatformFromURL(tt.url)
+			result, err := platformForURL(tt.url, nil)
+			if err != nil {
+				t.Fatalf("platformForURL(%q) failed: %v", tt.url, err)
+			}
 			if result != tt.expected {
-				t.Errorf("detectPlatformFromURL(%q) = %v, want %v",
+				t.Errorf("platformForURL(%q) = %v, want %v",
 					tt.url, result, tt.expected)
 			}
 		})
 	}
 }
 
+func TestPlatformForURL(t *testing.T) {
+	hosts := map[string]string{
+		"Code.Example.com":  "bitbucket",
+		"git.example.com":   "svn",
+		"forge.example.com": "gitea",
+	}
+
+	platform, err := platformForURL("git@code.example.com:proj/repo.git", hosts)
+	if err != nil || platform != PlatformBitbucket {
+		t.Errorf("Expected the configured platform, got %v, %v", platform, err)
+	}
+
+	platform, err = platformForURL("http://forge.example.com:3000/user/repo.git", hosts)
+	if err != nil || platform != PlatformGitea {
+		t.Errorf("Expected the configured platform ignoring the port, got %v, %v", platform, err)
+	}
+
+	platform, err = platformForURL("https://github.com/user/repo.git", hosts)
+	if err != nil || platform != PlatformGitHub {
+		t.Errorf("Expected detection for unmapped hosts, got %v, %v", platform, err)
+	}
+
+	if _, err := platformForURL("https://git.example.com/user/repo.git", hosts); err == nil {
+		t.Error("Expected an error for an unknown configured platform")
+	}
+}
+
+func TestPlatformForURL_LocalPath(t *testing.T) {
+	if _, err := platformForURL("/srv/git/repo.git", nil); err == nil {
+		t.Error("Expected an error for a local path remote")
+	}
+}
+
+func TestIsTrustedHost(t *testing.T) {
+	hosts := map[string]string{"code.example.com": "gitlab"}
+
+	tests := []struct {
+		url      string
+		platform Platform
+		want     bool
+	}{
+		{"https://github.com/user/repo.git", PlatformGitHub, true},
+		{"git@gitlab.com:user/repo.git", PlatformGitLab, true},
+		{"https://org.visualstudio.com/project/_git/repo", PlatformAzure, true},
+		{"https://code.example.com:8443/team/repo.git", PlatformGitLab, true},
+		{"https://code.example.com/team/repo.git", PlatformGitHub, false},
+		{"https://github.evil.com/user/repo.git", PlatformGitHub, false},
+		{"https://gitlab.example.com/user/repo.git", PlatformGitLab, false},
+		{"https://bitbucket.example.com/scm/PROJ/repo.git", PlatformBitbucket, false},
+	}
+
+	for _, tt := range tests {
+		repo, err := ParseRemoteURL(tt.url)
+		if err != nil {
+			t.Fatalf("ParseRemoteURL(%q) failed: %v", tt.url, err)
+		}
+		if got := IsTrustedHost(tt.platform, repo, hosts); got != tt.want {
+			t.Errorf("IsTrustedHost(%s, %q) = %v, want %v", tt.platform, tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestParsePlatform(t *testing.T) {
+	for name, want := range map[string]Platform{
+		"github":    PlatformGitHub,
+		"GitLab":    PlatformGitLab,
+		"gitea":     PlatformGitea,
+		"bitbucket": PlatformBitbucket,
+		"azure":     PlatformAzure,
+	} {
+		if got, err := ParsePlatform(name); err != nil || got != want {
+			t.Errorf("ParsePlatform(%q) = %v, %v, want %v", name, got, err, want)
+		}
+	}
+
+	if _, err := ParsePlatform("unknown"); err == nil {
+		t.Error("Expected an error for an unknown platform")
+	}
+}
+
 func TestGetCLITool(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -88,6 +226,18 @@ func TestGetCLITool(t *testing.T) {
 			expected: "tea",
 			wantErr:  false,
 		},
+		{
+			name:     "Azure DevOps",
+			platform: PlatformAzure,
+			expected: "az",
+			wantErr:  false,
+		},
+		{
+			name:     "Bitbucket",
+			platform: PlatformBitbucket,
+			expected: "",
+			wantErr:  true,
+		},
 		{
 			name:     "Unknown",
 			platform: PlatformUnknown,
@@ -134,6 +284,11 @@ func TestGetInstallURL(t *testing.T) {
 			platform: PlatformGitea,
 			expected: "https://gitea.com/gitea/tea",
 		},
+		{
+			name:     "Azure DevOps",
+			platform: PlatformAzure,
+			expected: "https://learn.microsoft.com/cli/azure/install-azure-cli",
+		},
 		{
 			name:     "Unknown",
 			platform: PlatformUnknown,
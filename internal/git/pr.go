@@ -8,113 +8,232 @@ import (
 
 // PROptions contains options for creating a pull request
 type PROptions struct {
-	Title  string
-	Body   string
+	Title string
+	Body  string
+	// Branch is the head branch of the pull request
 	Branch string
+	// Base is the target branch; empty means the default branch
+	Base string
+	// Draft opens the pull request as a draft
+	Draft     bool
+	Labels    []string
+	Reviewers []string
 }
 
-// CreatePR creates a pull request using the appropriate platform CLI tool
-func CreatePR(platform Platform, opts PROptions) (string, error) {
+// CreatePR opens a pull request on the repository at remoteURL, the URL
+// of the git remote named remote. It uses the forge API when a token is
+// given and falls back to the platform's CLI tool (gh, glab, tea or az)
+// otherwise.
+func CreatePR(
+	platform Platform,
+	remote, remoteURL, token string,
+	opts PROptions,
+) (*PullRequest, error) {
+	repo, err := ParseRemoteURL(remoteURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if token != "" {
+		forge, err := NewForge(platform, repo, token)
+		if err != nil {
+			return nil, err
+		}
+
+		return forge.CreatePullRequest(opts)
+	}
+
+	return createPRWithCLI(platform, cliRepo{Remote: remote, Repo: repo}, opts)
+}
+
+// cliRepo is the repository a CLI tool opens the pull request on. The
+// tools are always told the repository explicitly, since their own
+// default is usually origin rather than the remote the branch was
+// pushed to.
+type cliRepo struct {
+	// Remote is the name of the git remote
+	Remote string
+	Repo   Repository
+}
+
+// createPRWithCLI creates a pull request using the platform CLI tool
+func createPRWithCLI(platform Platform, repo cliRepo, opts PROptions) (*PullRequest, error) {
 	tool, err := GetCLITool(platform)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	// Check if tool is installed
 	if err := CheckCLIToolInstalled(platform); err != nil {
-		return "", err
+		return nil, err
 	}
 
-	// Create PR based on platform
+	// Build the arguments based on platform
+	var args []string
+	var kind string
 	switch platform {
 	case PlatformGitHub:
-		return createGitHubPR(tool, opts)
+		args, kind = githubCLIArgs(repo, opts), "GitHub PR"
 	case PlatformGitLab:
-		return createGitLabPR(tool, opts)
+		args, kind = gitlabCLIArgs(repo, opts), "GitLab MR"
 	case PlatformGitea:
-		return createGiteaPR(tool, opts)
-	case PlatformUnknown:
+		args, kind = giteaCLIArgs(repo, opts), "Gitea PR"
+	case PlatformAzure:
+		args, err = azureCLIArgs(repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		kind = "Azure DevOps PR"
+	case PlatformBitbucket, PlatformUnknown:
 		fallthrough
 	default:
-		return "", fmt.Errorf("unsupported platform: %s", platform)
+		return nil, fmt.Errorf("unsupported platform: %s", platform)
 	}
-}
-
-// createGitHubPR creates a GitHub pull request using gh CLI
-func createGitHubPR(tool string, opts PROptions) (string, error) {
-	cmd := exec.Command(tool, "pr", "create",
-		"--title", opts.Title,
-		"--body", opts.Body,
-	)
 
-	output, err := cmd.CombinedOutput()
+	output, err := exec.Command(tool, args...).CombinedOutput()
 	if err != nil {
-		msg := fmt.Sprintf(
-			"create GitHub PR: %v\nOutput: %s",
+		return nil, fmt.Errorf(
+			"create %s: %v\nOutput: %s",
+			kind,
 			err,
 			string(output),
 		)
-
-		return "", fmt.Errorf("%s", msg)
 	}
 
-	// Extract PR URL from output (gh prints the URL)
-	url := extractURL(string(output))
+	// The CLI tools print the URL of the new pull request
+	return &PullRequest{
+		URL:   extractURL(string(output)),
+		Title: opts.Title,
+		Head:  opts.Branch,
+		Base:  opts.Base,
+		Draft: opts.Draft,
+	}, nil
+}
+
+// githubCLIArgs returns the gh arguments creating the pull request. The
+// repository is given as HOST/OWNER/REPO, which also selects GitHub
+// Enterprise hosts.
+func githubCLIArgs(repo cliRepo, opts PROptions) []string {
+	args := []string{
+		"pr", "create",
+		"--repo", repo.Repo.Hostname() + "/" + repo.Repo.Path,
+		"--title", opts.Title,
+		"--body", opts.Body,
+	}
+	if opts.Branch != "" {
+		args = append(args, "--head", opts.Branch)
+	}
+	if opts.Base != "" {
+		args = append(args, "--base", opts.Base)
+	}
+	if opts.Draft {
+		args = append(args, "--draft")
+	}
+	for _, label := range opts.Labels {
+		args = append(args, "--label", label)
+	}
+	for _, reviewer := range opts.Reviewers {
+		args = append(args, "--reviewer", reviewer)
+	}
 
-	return url, nil
+	return args
 }
 
-// createGitLabPR creates a GitLab merge request using glab CLI
-func createGitLabPR(tool string, opts PROptions) (string, error) {
-	cmd := exec.Command(tool, "mr", "create",
+// gitlabCLIArgs returns the glab arguments creating the merge request.
+// The repository is given by its URL, which keeps the host and
+// subgroups.
+func gitlabCLIArgs(repo cliRepo, opts PROptions) []string {
+	args := []string{
+		"mr", "create",
+		"--repo", repo.Repo.Scheme + "://" + repo.Repo.Host + "/" + repo.Repo.Path,
 		"--title", opts.Title,
 		"--description", opts.Body,
-	)
+	}
+	if opts.Branch != "" {
+		args = append(args, "--source-branch", opts.Branch)
+	}
+	if opts.Base != "" {
+		args = append(args, "--target-branch", opts.Base)
+	}
+	if opts.Draft {
+		args = append(args, "--draft")
+	}
+	if len(opts.Labels) > 0 {
+		args = append(args, "--label", strings.Join(opts.Labels, ","))
+	}
+	if len(opts.Reviewers) > 0 {
+		args = append(args, "--reviewer", strings.Join(opts.Reviewers, ","))
+	}
 
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		msg := fmt.Sprintf(
-			"create GitLab MR: %v\nOutput: %s",
-			err,
-			string(output),
-		)
+	return args
+}
 
-		return "", fmt.Errorf("%s", msg)
+// giteaCLIArgs returns the tea arguments creating the pull request; tea
+// finds the repository through the named git remote. tea cannot request
+// reviewers or open drafts, so drafts get the "Draft: " title prefix and
+// reviewers are left out.
+func giteaCLIArgs(repo cliRepo, opts PROptions) []string {
+	title := opts.Title
+	if opts.Draft && !strings.HasPrefix(title, draftPrefix) {
+		title = draftPrefix + title
 	}
 
-	// Extract MR URL from output
-	url := extractURL(string(output))
+	args := []string{
+		"pr", "create",
+		"--remote", repo.Remote,
+		"--title", title,
+		"--description", opts.Body,
+	}
+	if opts.Branch != "" {
+		args = append(args, "--head", opts.Branch)
+	}
+	if opts.Base != "" {
+		args = append(args, "--base", opts.Base)
+	}
+	if len(opts.Labels) > 0 {
+		args = append(args, "--labels", strings.Join(opts.Labels, ","))
+	}
 
-	return url, nil
+	return args
 }
 
-// createGiteaPR creates a Gitea pull request using tea CLI
-func createGiteaPR(tool string, opts PROptions) (string, error) {
-	cmd := exec.Command(
-		tool,
-		"pr",
-		"create",
-		"--title",
-		opts.Title,
-		"--description",
-		opts.Body,
-	)
-
-	output, err := cmd.CombinedOutput()
+// azureCLIArgs returns the az arguments creating the pull request, with
+// the organization, project and repository taken from the remote URL.
+// az prints the pull request as JSON, so a query selects its web URL.
+func azureCLIArgs(repo cliRepo, opts PROptions) ([]string, error) {
+	collection, azureRepo, err := azureRepository(repo.Repo)
 	if err != nil {
-		msg := fmt.Sprintf(
-			"create Gitea PR: %v\nOutput: %s",
-			err,
-			string(output),
-		)
-
-		return "", fmt.Errorf("%s", msg)
+		return nil, err
 	}
 
-	// Extract PR URL from output
-	url := extractURL(string(output))
+	args := []string{
+		"repos", "pr", "create",
+		"--org", collection,
+		"--project", azureRepo.Owner(),
+		"--repository", azureRepo.Name(),
+		"--title", opts.Title,
+		"--description", opts.Body,
+	}
+	if opts.Branch != "" {
+		args = append(args, "--source-branch", opts.Branch)
+	}
+	if opts.Base != "" {
+		args = append(args, "--target-branch", opts.Base)
+	}
+	if opts.Draft {
+		args = append(args, "--draft", "true")
+	}
+	if len(opts.Labels) > 0 {
+		args = append(append(args, "--labels"), opts.Labels...)
+	}
+	if len(opts.Reviewers) > 0 {
+		args = append(append(args, "--reviewers"), opts.Reviewers...)
+	}
 
-	return url, nil
+	return append(args,
+		"--query", "join('', [repository.webUrl, '/pullrequest/', to_string(pullRequestId)])",
+		"--output", "tsv",
+	), nil
 }
 
 // extractURL extracts a URL from CLI tool output.
@@ -0,0 +1,127 @@
+package git
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCLIArgs(t *testing.T) {
+	opts := PROptions{
+		Title:     "Archive: x",
+		Body:      "body",
+		Branch:    "archive-x",
+		Base:      "main",
+		Draft:     true,
+		Labels:    []string{"spectr", "docs"},
+		Reviewers: []string{"alice", "bob"},
+	}
+
+	// The branch is pushed to upstream, not origin, so every tool must be
+	// told the upstream repository
+	tests := []struct {
+		name string
+		url  string
+		args func(cliRepo, PROptions) ([]string, error)
+		want []string
+	}{
+		{
+			name: "gh",
+			url:  "git@github.example.com:team/app.git",
+			args: withoutError(githubCLIArgs),
+			want: []string{
+				"pr", "create", "--repo", "github.example.com/team/app",
+				"--title", "Archive: x", "--body", "body",
+				"--head", "archive-x", "--base", "main", "--draft",
+				"--label", "spectr", "--label", "docs",
+				"--reviewer", "alice", "--reviewer", "bob",
+			},
+		},
+		{
+			name: "glab",
+			url:  "https://gitlab.com/group/sub/app.git",
+			args: withoutError(gitlabCLIArgs),
+			want: []string{
+				"mr", "create", "--repo", "https://gitlab.com/group/sub/app",
+				"--title", "Archive: x", "--description", "body",
+				"--source-branch", "archive-x", "--target-branch", "main", "--draft",
+				"--label", "spectr,docs", "--reviewer", "alice,bob",
+			},
+		},
+		{
+			name: "tea",
+			url:  "https://codeberg.org/team/app.git",
+			args: withoutError(giteaCLIArgs),
+			want: []string{
+				"pr", "create", "--remote", "upstream",
+				"--title", "Draft: Archive: x", "--description", "body",
+				"--head", "archive-x", "--base", "main", "--labels", "spectr,docs",
+			},
+		},
+		{
+			name: "az",
+			url:  "git@ssh.dev.azure.com:v3/org/project/app",
+			args: azureCLIArgs,
+			want: []string{
+				"repos", "pr", "create",
+				"--org", "https://dev.azure.com/org", "--project", "project", "--repository", "app",
+				"--title", "Archive: x", "--description", "body",
+				"--source-branch", "archive-x", "--target-branch", "main", "--draft", "true",
+				"--labels", "spectr", "docs", "--reviewers", "alice", "bob",
+				"--query", "join('', [repository.webUrl, '/pullrequest/', to_string(pullRequestId)])",
+				"--output", "tsv",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, err := ParseRemoteURL(tt.url)
+			if err != nil {
+				t.Fatalf("ParseRemoteURL(%q) failed: %v", tt.url, err)
+			}
+			got, err := tt.args(cliRepo{Remote: "upstream", Repo: repo}, opts)
+			if err != nil {
+				t.Fatalf("args failed: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("args = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// withoutError adapts argument builders that cannot fail
+func withoutError(args func(cliRepo, PROptions) []string) func(cliRepo, PROptions) ([]string, error) {
+	return func(repo cliRepo, opts PROptions) ([]string, error) {
+		return args(repo, opts), nil
+	}
+}
+
+func TestCLIArgs_Minimal(t *testing.T) {
+	repo := cliRepo{Remote: "origin", Repo: Repository{"https", "github.com", "user/repo"}}
+	got := githubCLIArgs(repo, PROptions{Title: "t", Body: "b"})
+	want := []string{"pr", "create", "--repo", "github.com/user/repo", "--title", "t", "--body", "b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("args = %q, want %q", got, want)
+	}
+}
+
+func TestAzureCLIArgs_NotAzureRepository(t *testing.T) {
+	repo := cliRepo{Remote: "origin", Repo: Repository{"https", "dev.azure.com", "org/repo"}}
+	if _, err := azureCLIArgs(repo, PROptions{Title: "t"}); err == nil {
+		t.Error("Expected an error for a remote that is not an Azure DevOps repository")
+	}
+}
+
+func TestExtractURL(t *testing.T) {
+	tests := map[string]string{
+		"https://github.com/o/r/pull/1\n":                                           "https://github.com/o/r/pull/1",
+		"Creating merge request\nSee https://gitlab.com/o/r/-/merge_requests/2 now": "https://gitlab.com/o/r/-/merge_requests/2",
+		"no url here": "no url here",
+	}
+	for output, want := range tests {
+		if got := extractURL(output); got != want {
+			t.Errorf("extractURL(%q) = %q, want %q", output, got, want)
+		}
+	}
+}
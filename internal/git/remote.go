@@ -0,0 +1,113 @@
+package git
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// Repository identifies a repository on a forge
+type Repository struct {
+	// Scheme is the scheme of the forge's web and API URLs
+	Scheme string
+	// Host is the forge host, including a port for HTTP remotes
+	Host string
+	// Path is the repository path such as "owner/name"; GitLab paths
+	// may contain subgroups
+	Path string
+}
+
+// Owner returns the path up to the repository name
+func (r Repository) Owner() string {
+	i := strings.LastIndex(r.Path, "/")
+	if i < 0 {
+		return ""
+	}
+
+	return r.Path[:i]
+}
+
+// Hostname returns the host without a port
+func (r Repository) Hostname() string {
+	if host, _, err := net.SplitHostPort(r.Host); err == nil {
+		return host
+	}
+
+	return r.Host
+}
+
+// Name returns the last element of the repository path
+func (r Repository) Name() string {
+	return r.Path[strings.LastIndex(r.Path, "/")+1:]
+}
+
+// ParseRemoteURL parses a git remote URL in any of these forms:
+//
+//	https://host/owner/name.git
+//	ssh://git@host:2222/owner/name.git
+//	git+ssh://git@host/owner/name.git
+//	git@host:owner/name.git
+//	[git@host:2222]:owner/name.git
+//
+// Like git, it treats a remote as scp-style only when no slash precedes
+// the first colon, so local paths are rejected. SSH remotes are assumed
+// to be served over HTTPS as well.
+func ParseRemoteURL(remote string) (Repository, error) {
+	remote = strings.TrimSpace(remote)
+	repo := Repository{Scheme: "https"}
+
+	// A single letter before the colon is a Windows drive, not a host
+	colon := strings.Index(remote, ":")
+	scp := colon > 1 && !strings.Contains(remote[:colon], "/")
+
+	switch {
+	case strings.Contains(remote, "://"):
+		u, err := url.Parse(remote)
+		if err != nil {
+			return Repository{}, fmt.Errorf("parse remote URL %q: %w", remote, err)
+		}
+		repo.Host = u.Host
+		if u.Scheme == "http" {
+			repo.Scheme = "http"
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			// The SSH port says nothing about the web port
+			repo.Host = u.Hostname()
+		}
+		repo.Path = u.Path
+	case strings.HasPrefix(remote, "["):
+		// scp-style with a port: [user@host:port]:path
+		end := strings.Index(remote, "]:")
+		if end < 0 {
+			return Repository{}, fmt.Errorf("unsupported remote URL %q", remote)
+		}
+		host := remote[1:end]
+		if i := strings.LastIndex(host, "@"); i >= 0 {
+			host = host[i+1:]
+		}
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		repo.Host = host
+		repo.Path = remote[end+2:]
+	case scp:
+		// scp-style: [user@]host:path
+		host, path := remote[:colon], remote[colon+1:]
+		if i := strings.LastIndex(host, "@"); i >= 0 {
+			host = host[i+1:]
+		}
+		repo.Host = host
+		repo.Path = path
+	default:
+		return Repository{}, fmt.Errorf("unsupported remote URL %q", remote)
+	}
+
+	repo.Host = strings.ToLower(repo.Host)
+	repo.Path = strings.TrimSuffix(strings.Trim(repo.Path, "/"), ".git")
+	if repo.Host == "" || !strings.Contains(repo.Path, "/") {
+		return Repository{}, fmt.Errorf("remote URL %q does not name a repository", remote)
+	}
+
+	return repo, nil
+}
@@ -0,0 +1,70 @@
+package git
+
+import "fmt"
+
+// Target is the forge repository pull requests are opened on
+type Target struct {
+	Platform Platform
+	// Remote is the name of the git remote branches are pushed to
+	Remote    string
+	RemoteURL string
+	// Token is the forge API token; without one the platform CLI is used
+	Token string
+}
+
+// ResolveTarget picks the remote (name, or else origin or the only
+// remote), detects its platform, honoring platforms configured per host,
+// and looks up the API token among the configured tokens and the
+// environment. Tokens are only used for trusted hosts (see
+// IsTrustedHost); otherwise the platform's CLI tool must be installed.
+func ResolveTarget(remote string, platforms, tokens map[string]string) (Target, error) {
+	if err := IsGitRepository(); err != nil {
+		return Target{}, err
+	}
+
+	remote, err := ResolveRemote(remote)
+	if err != nil {
+		return Target{}, err
+	}
+
+	platform, remoteURL, err := DetectPlatform(remote, platforms)
+	if err != nil {
+		return Target{}, fmt.Errorf("detect git platform: %w", err)
+	}
+	if platform == PlatformUnknown {
+		return Target{}, fmt.Errorf(
+			"could not detect git hosting platform. Remote URL: %s. "+
+				"Map its host under \"platforms\" in spectr/config.json",
+			remoteURL,
+		)
+	}
+
+	target := Target{Platform: platform, Remote: remote, RemoteURL: remoteURL}
+	repo, err := ParseRemoteURL(remoteURL)
+	if err != nil {
+		return Target{}, err
+	}
+	hint := TokenHint(platform, repo.Host)
+	if IsTrustedHost(platform, repo, platforms) {
+		target.Token = LookupToken(platform, repo.Host, tokens)
+		if target.Token != "" {
+			return target, nil
+		}
+	} else {
+		hint = fmt.Sprintf(
+			"tokens are only used once %s is mapped under \"platforms\" in spectr/config.json",
+			repo.Host,
+		)
+	}
+
+	if err := CheckCLIToolInstalled(platform); err != nil {
+		return Target{}, fmt.Errorf("no API token found (%s), and %w", hint, err)
+	}
+
+	return target, nil
+}
+
+// CreatePR opens a pull request on the target
+func (t Target) CreatePR(opts PROptions) (*PullRequest, error) {
+	return CreatePR(t.Platform, t.Remote, t.RemoteURL, t.Token, opts)
+}
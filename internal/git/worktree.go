@@ -0,0 +1,91 @@
+package git
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// WorktreePath maps path, inside the repository's main working tree, to
+// the same location inside worktree. In a monorepo the spectr project may
+// live below the repository root.
+func WorktreePath(path, worktree string) (string, error) {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", fmt.Errorf("resolve %s: %w", path, err)
+	}
+
+	topLevel, err := TopLevel(resolved)
+	if err != nil {
+		return "", err
+	}
+
+	rel, err := filepath.Rel(topLevel, resolved)
+	if err != nil {
+		return "", fmt.Errorf("locate %s in repository: %w", path, err)
+	}
+
+	return filepath.Join(worktree, rel), nil
+}
+
+// StageInWorktree stages paths, including removed ones, in the worktree
+// at dir
+func StageInWorktree(dir string, paths []string) error {
+	args := append([]string{"-C", dir, "add", "-A", "--"}, paths...)
+	output, err := exec.Command(gitCommand, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("stage files in worktree: %w\nOutput: %s", err, string(output))
+	}
+
+	return nil
+}
+
+// HasStagedChanges reports whether the worktree at dir has staged
+// changes to commit
+func HasStagedChanges(dir string) (bool, error) {
+	err := exec.Command(gitCommand, "-C", dir, "diff", "--cached", "--quiet").Run()
+	if err == nil {
+		return false, nil
+	}
+	if exitErr, ok := err.(*exec.ExitError); ok && exitErr.ExitCode() == 1 {
+		return true, nil
+	}
+
+	return false, fmt.Errorf("check staged changes: %w", err)
+}
+
+// StagedDiff returns the staged changes below path in the worktree at dir
+// as a unified diff
+func StagedDiff(dir, path string) (string, error) {
+	cmd := exec.Command(gitCommand, "-C", dir, "diff", "--cached", "--", path)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("diff staged changes: %w", err)
+	}
+
+	return string(output), nil
+}
+
+// CommitInWorktree commits the staged changes of the worktree at dir
+func CommitInWorktree(dir, message string) error {
+	cmd := exec.Command(gitCommand, "-C", dir, "commit", "-F", "-")
+	cmd.Stdin = strings.NewReader(message)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("commit in worktree: %w\nOutput: %s", err, string(output))
+	}
+
+	return nil
+}
+
+// PushFromWorktree pushes branch from the worktree at dir to remote
+func PushFromWorktree(dir, remote, branch string) error {
+	cmd := exec.Command(gitCommand, "-C", dir, "push", "-u", remote, branch)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("push from worktree: %w\nOutput: %s", err, string(output))
+	}
+
+	return nil
+}
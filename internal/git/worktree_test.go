@@ -0,0 +1,86 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWorktreeHelpers(t *testing.T) {
+	if _, err := exec.LookPath(gitCommand); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	gitIn(t, dir, "init", "-q")
+	gitIn(t, dir, "config", "user.name", "test")
+	gitIn(t, dir, "config", "user.email", "test@example.com")
+
+	specsDir := filepath.Join(dir, "spectr", "specs", "auth")
+	if err := os.MkdirAll(specsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(specsDir, "spec.md"), []byte("# Auth\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "other.txt"), []byte("other\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if staged, err := HasStagedChanges(dir); err != nil || staged {
+		t.Errorf("Expected no staged changes, got %v, %v", staged, err)
+	}
+	if err := StageInWorktree(dir, []string{filepath.Join(dir, "spectr")}); err != nil {
+		t.Fatalf("StageInWorktree failed: %v", err)
+	}
+	if staged, err := HasStagedChanges(dir); err != nil || !staged {
+		t.Errorf("Expected staged changes, got %v, %v", staged, err)
+	}
+
+	diff, err := StagedDiff(dir, filepath.Join(dir, "spectr", "specs"))
+	if err != nil {
+		t.Fatalf("StagedDiff failed: %v", err)
+	}
+	if !strings.Contains(diff, "+# Auth") || strings.Contains(diff, "other.txt") {
+		t.Errorf("Unexpected diff:\n%s", diff)
+	}
+
+	if err := CommitInWorktree(dir, "Add auth\n"); err != nil {
+		t.Fatalf("CommitInWorktree failed: %v", err)
+	}
+	if staged, err := HasStagedChanges(dir); err != nil || staged {
+		t.Errorf("Expected a clean index after committing, got %v, %v", staged, err)
+	}
+
+	// Removed paths are staged too
+	if err := os.RemoveAll(specsDir); err != nil {
+		t.Fatal(err)
+	}
+	if err := StageInWorktree(dir, []string{specsDir}); err != nil {
+		t.Fatalf("StageInWorktree of a removed path failed: %v", err)
+	}
+	if staged, _ := HasStagedChanges(dir); !staged {
+		t.Error("Expected the removal to be staged")
+	}
+}
+
+func TestWorktreePath(t *testing.T) {
+	if _, err := exec.LookPath(gitCommand); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	gitIn(t, dir, "init", "-q")
+	project := filepath.Join(dir, "services", "api")
+	if err := os.MkdirAll(project, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := WorktreePath(project, "/tmp/worktree")
+	if err != nil {
+		t.Fatalf("WorktreePath failed: %v", err)
+	}
+	if want := filepath.Join("/tmp/worktree", "services", "api"); got != want {
+		t.Errorf("WorktreePath() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,88 @@
+package graph
+
+import (
+	"slices"
+	"strings"
+)
+
+// Cycles returns the dependency cycles between active changes. Each
+// cycle lists its changes in dependency order, starting with the
+// smallest ID, and is reported once.
+func (g *Graph) Cycles() [][]string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+
+	state := make(map[string]int)
+	seen := make(map[string]bool)
+	var stack []string
+	var cycles [][]string
+
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = visiting
+		stack = append(stack, id)
+
+		for _, dep := range g.Changes[id].DependsOn {
+			other, ok := g.Changes[dep]
+			if !ok || other.Archived {
+				continue
+			}
+
+			switch state[dep] {
+			case unvisited:
+				visit(dep)
+			case visiting:
+				start := slices.Index(stack, dep)
+				cycle := rotateToMin(slices.Clone(stack[start:]))
+				key := strings.Join(cycle, "\x00")
+				if !seen[key] {
+					seen[key] = true
+					cycles = append(cycles, cycle)
+				}
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[id] = done
+	}
+
+	for _, change := range g.Active() {
+		if state[change.ID] == unvisited {
+			visit(change.ID)
+		}
+	}
+
+	return cycles
+}
+
+// CyclesThrough returns the dependency cycles that include change id
+func (g *Graph) CyclesThrough(id string) [][]string {
+	var cycles [][]string
+	for _, cycle := range g.Cycles() {
+		if slices.Contains(cycle, id) {
+			cycles = append(cycles, cycle)
+		}
+	}
+
+	return cycles
+}
+
+// FormatCycle renders a cycle as "a → b → a"
+func FormatCycle(cycle []string) string {
+	return strings.Join(append(slices.Clone(cycle), cycle[0]), " → ")
+}
+
+// rotateToMin rotates cycle so that it starts with its smallest ID
+func rotateToMin(cycle []string) []string {
+	start := 0
+	for i, id := range cycle {
+		if id < cycle[start] {
+			start = i
+		}
+	}
+
+	return slices.Concat(cycle[start:], cycle[:start])
+}
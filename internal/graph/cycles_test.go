@@ -0,0 +1,28 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCycles(t *testing.T) {
+	g := &Graph{Changes: map[string]*Change{
+		"a":    {ID: "a", DependsOn: []string{"b"}},
+		"b":    {ID: "b", DependsOn: []string{"c", "base"}},
+		"c":    {ID: "c", DependsOn: []string{"a"}},
+		"d":    {ID: "d", DependsOn: []string{"d"}},
+		"e":    {ID: "e", DependsOn: []string{"a"}},
+		"base": {ID: "base", Archived: true, DependsOn: []string{"b"}},
+	}}
+
+	want := [][]string{{"a", "b", "c"}, {"d"}}
+	if got := g.Cycles(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Cycles() = %v, want %v", got, want)
+	}
+	if got := g.CyclesThrough("e"); len(got) != 0 {
+		t.Errorf("CyclesThrough(e) = %v", got)
+	}
+	if got := FormatCycle(want[0]); got != "a → b → c → a" {
+		t.Errorf("FormatCycle() = %q", got)
+	}
+}
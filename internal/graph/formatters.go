@@ -0,0 +1,161 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Options selects what a rendered graph contains
+type Options struct {
+	// Capabilities adds the capabilities touched by each change
+	Capabilities bool
+}
+
+// node is a vertex of a rendered graph
+type node struct {
+	key   string
+	label string
+	kind  nodeKind
+}
+
+// nodeKind distinguishes how nodes are drawn
+type nodeKind int
+
+const (
+	kindActive nodeKind = iota
+	kindArchived
+	kindMissing
+	kindCapability
+)
+
+// edge is a directed edge of a rendered graph
+type edge struct {
+	from, to string
+	depends  bool
+}
+
+// layout collects the nodes and edges to render: all active changes,
+// the changes they depend on, and optionally their capabilities
+func (g *Graph) layout(opts Options) ([]node, []edge) {
+	var nodes []node
+	var edges []edge
+	added := make(map[string]bool)
+	addNode := func(n node) {
+		if !added[n.key] {
+			added[n.key] = true
+			nodes = append(nodes, n)
+		}
+	}
+
+	active := g.Active()
+	for _, change := range active {
+		addNode(node{key: "change:" + change.ID, label: change.ID, kind: kindActive})
+	}
+
+	var capabilities []string
+	for _, change := range active {
+		for _, dep := range change.DependsOn {
+			other, ok := g.Changes[dep]
+			switch {
+			case !ok:
+				addNode(node{key: "change:" + dep, label: dep + " (missing)", kind: kindMissing})
+			case other.Archived:
+				addNode(node{key: "change:" + dep, label: dep, kind: kindArchived})
+			}
+			edges = append(edges, edge{from: "change:" + change.ID, to: "change:" + dep, depends: true})
+		}
+
+		if !opts.Capabilities {
+			continue
+		}
+		for _, capability := range change.Capabilities {
+			if !slices.Contains(capabilities, capability) {
+				capabilities = append(capabilities, capability)
+			}
+			edges = append(edges, edge{from: "change:" + change.ID, to: "spec:" + capability})
+		}
+	}
+
+	slices.Sort(capabilities)
+	for _, capability := range capabilities {
+		addNode(node{key: "spec:" + capability, label: capability, kind: kindCapability})
+	}
+
+	return nodes, edges
+}
+
+// dotAttributes maps node kinds to Graphviz attributes
+var dotAttributes = map[nodeKind]string{
+	kindActive:     "shape=box",
+	kindArchived:   "shape=box, style=dashed",
+	kindMissing:    "shape=box, color=red, fontcolor=red",
+	kindCapability: "shape=ellipse",
+}
+
+// FormatDOT renders the graph in Graphviz DOT format. Dependency edges
+// point from a change to the change it depends on; dotted edges point
+// to the capabilities a change touches.
+func FormatDOT(g *Graph, opts Options) string {
+	nodes, edges := g.layout(opts)
+
+	var sb strings.Builder
+	sb.WriteString("digraph spectr {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	for _, n := range nodes {
+		fmt.Fprintf(&sb, "  %q [label=%q, %s];\n", n.key, n.label, dotAttributes[n.kind])
+	}
+	for _, e := range edges {
+		if e.depends {
+			fmt.Fprintf(&sb, "  %q -> %q [label=\"depends on\"];\n", e.from, e.to)
+		} else {
+			fmt.Fprintf(&sb, "  %q -> %q [style=dotted];\n", e.from, e.to)
+		}
+	}
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+// mermaidClasses maps node kinds to Mermaid class names
+var mermaidClasses = map[nodeKind]string{
+	kindArchived:   "archived",
+	kindMissing:    "missing",
+	kindCapability: "capability",
+}
+
+// FormatMermaid renders the graph as a Mermaid flowchart
+func FormatMermaid(g *Graph, opts Options) string {
+	nodes, edges := g.layout(opts)
+
+	ids := make(map[string]string, len(nodes))
+	var sb strings.Builder
+	sb.WriteString("graph LR\n")
+	for i, n := range nodes {
+		ids[n.key] = fmt.Sprintf("n%d", i)
+		label := strings.ReplaceAll(n.label, `"`, "#quot;")
+		if n.kind == kindCapability {
+			fmt.Fprintf(&sb, "  %s([\"%s\"])\n", ids[n.key], label)
+		} else {
+			fmt.Fprintf(&sb, "  %s[\"%s\"]\n", ids[n.key], label)
+		}
+	}
+	for _, e := range edges {
+		if e.depends {
+			fmt.Fprintf(&sb, "  %s -->|depends on| %s\n", ids[e.from], ids[e.to])
+		} else {
+			fmt.Fprintf(&sb, "  %s -.-> %s\n", ids[e.from], ids[e.to])
+		}
+	}
+
+	sb.WriteString("  classDef archived stroke-dasharray: 5 5\n")
+	sb.WriteString("  classDef missing stroke:#f00,color:#f00\n")
+	sb.WriteString("  classDef capability fill:#eef\n")
+	for _, n := range nodes {
+		if class, ok := mermaidClasses[n.kind]; ok {
+			fmt.Fprintf(&sb, "  class %s %s\n", ids[n.key], class)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,54 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatDOT(t *testing.T) {
+	g, err := Load(newProject(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := FormatDOT(g, Options{Capabilities: true})
+	for _, want := range []string{
+		"digraph spectr {",
+		`"change:a" [label="a", shape=box];`,
+		`"change:base" [label="base", shape=box, style=dashed];`,
+		`"change:gone" [label="gone (missing)", shape=box, color=red, fontcolor=red];`,
+		`"spec:api/keys" [label="api/keys", shape=ellipse];`,
+		`"change:a" -> "change:b" [label="depends on"];`,
+		`"change:a" -> "spec:auth" [style=dotted];`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("DOT output missing %q:\n%s", want, out)
+		}
+	}
+
+	if out := FormatDOT(g, Options{}); strings.Contains(out, "spec:") {
+		t.Errorf("Expected no capabilities without Options.Capabilities:\n%s", out)
+	}
+}
+
+func TestFormatMermaid(t *testing.T) {
+	g, err := Load(newProject(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := FormatMermaid(g, Options{Capabilities: true})
+	for _, want := range []string{
+		"graph LR\n",
+		`n0["a"]`,
+		`n3["base"]`,
+		"n0 -->|depends on| n1",
+		`(["auth"])`,
+		"class n3 archived",
+		"class n4 missing",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Mermaid output missing %q:\n%s", want, out)
+		}
+	}
+}
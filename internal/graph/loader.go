@@ -0,0 +1,131 @@
+package graph
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// archivePrefix matches the date prefix of archived change directories
+var archivePrefix = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}-`)
+
+// Load reads the active and archived changes below projectPath.
+// Changes whose dependencies cannot be read are loaded with Err set.
+func Load(projectPath string) (*Graph, error) {
+	changesDir := filepath.Join(projectPath, "spectr", "changes")
+	g := &Graph{Changes: make(map[string]*Change)}
+
+	// Archived changes first, in date order, so that active changes
+	// and later archives win
+	archiveDir := filepath.Join(changesDir, "archive")
+	entries, err := os.ReadDir(archiveDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read archive directory: %w", err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || !archivePrefix.MatchString(entry.Name()) {
+			continue
+		}
+		id := archivePrefix.ReplaceAllString(entry.Name(), "")
+		change := loadChange(filepath.Join(archiveDir, entry.Name()), id)
+		change.Archived = true
+		g.Changes[id] = change
+	}
+
+	active, err := discovery.GetActiveChanges(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range active {
+		g.Changes[id] = loadChange(filepath.Join(changesDir, id), id)
+	}
+
+	return g, nil
+}
+
+// loadChange reads the dependencies and capabilities of the change in dir
+func loadChange(dir, id string) *Change {
+	change := &Change{ID: id}
+
+	deps, err := parsers.ParseDependencies(filepath.Join(dir, "proposal.md"))
+	if err != nil && !os.IsNotExist(err) {
+		change.Err = fmt.Errorf("failed to read dependencies of %s: %w", id, err)
+	}
+	change.DependsOn = deps
+	if metadata, err := parsers.ParseProposalMetadata(filepath.Join(dir, "proposal.md")); err == nil {
+		change.Created = metadata.Created
+	}
+
+	specsDir := filepath.Join(dir, "specs")
+	_ = filepath.WalkDir(specsDir, func(path string, d os.DirEntry, err error) error {
+		if err == nil && !d.IsDir() && d.Name() == "spec.md" {
+			change.Capabilities = append(
+				change.Capabilities,
+				discovery.CapabilityID(specsDir, filepath.Dir(path)),
+			)
+		}
+
+		return nil
+	})
+	sort.Strings(change.Capabilities)
+
+	return change
+}
+
+// Active returns the active changes sorted by ID
+func (g *Graph) Active() []*Change {
+	var changes []*Change
+	for _, change := range g.Changes {
+		if !change.Archived {
+			changes = append(changes, change)
+		}
+	}
+	slices.SortFunc(changes, func(a, b *Change) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return changes
+}
+
+// Missing returns the dependencies of change id that are neither
+// active nor archived
+func (g *Graph) Missing(id string) []string {
+	change, ok := g.Changes[id]
+	if !ok {
+		return nil
+	}
+
+	var missing []string
+	for _, dep := range change.DependsOn {
+		if _, ok := g.Changes[dep]; !ok {
+			missing = append(missing, dep)
+		}
+	}
+
+	return missing
+}
+
+// Pending returns the dependencies of change id that are still active
+// and must be archived first
+func (g *Graph) Pending(id string) []string {
+	change, ok := g.Changes[id]
+	if !ok {
+		return nil
+	}
+
+	var pending []string
+	for _, dep := range change.DependsOn {
+		if other, ok := g.Changes[dep]; ok && !other.Archived {
+			pending = append(pending, dep)
+		}
+	}
+
+	return pending
+}
@@ -0,0 +1,73 @@
+package graph
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeChange creates a change below changesDir with the given
+// dependencies and capability deltas
+func writeChange(t *testing.T, changesDir, dir string, deps []string, capabilities ...string) {
+	t.Helper()
+	changeDir := filepath.Join(changesDir, dir)
+	if err := os.MkdirAll(changeDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	proposal := "# Change: " + dir + "\n\n## Depends On\n"
+	for _, dep := range deps {
+		proposal += "- " + dep + "\n"
+	}
+	if err := os.WriteFile(filepath.Join(changeDir, "proposal.md"), []byte(proposal), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, capability := range capabilities {
+		specDir := filepath.Join(changeDir, "specs", filepath.FromSlash(capability))
+		if err := os.MkdirAll(specDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(specDir, "spec.md"), []byte("## ADDED Requirements\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// newProject creates a project with active changes a, b, c and the
+// archived change base
+func newProject(t *testing.T) string {
+	t.Helper()
+	projectPath := t.TempDir()
+	changesDir := filepath.Join(projectPath, "spectr", "changes")
+	writeChange(t, changesDir, "archive/2025-01-01-base", nil, "auth")
+	writeChange(t, changesDir, "a", []string{"base", "b", "gone"}, "auth", "api/keys")
+	writeChange(t, changesDir, "b", []string{"c"})
+	writeChange(t, changesDir, "c", []string{"a"})
+
+	return projectPath
+}
+
+func TestLoad(t *testing.T) {
+	g, err := Load(newProject(t))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if len(g.Changes) != 4 || len(g.Active()) != 3 {
+		t.Fatalf("Unexpected changes: %+v", g.Changes)
+	}
+	if base := g.Changes["base"]; base == nil || !base.Archived {
+		t.Errorf("Expected archived change base, got %+v", base)
+	}
+	if got := g.Changes["a"].Capabilities; !reflect.DeepEqual(got, []string{"api/keys", "auth"}) {
+		t.Errorf("Capabilities = %v", got)
+	}
+	if got := g.Missing("a"); !reflect.DeepEqual(got, []string{"gone"}) {
+		t.Errorf("Missing = %v", got)
+	}
+	if got := g.Pending("a"); !reflect.DeepEqual(got, []string{"b"}) {
+		t.Errorf("Pending = %v", got)
+	}
+}
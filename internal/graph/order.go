@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ArchiveOrder sorts the active changes ids so that every change comes
+// after the changes of ids it depends on. Changes that are free to go
+// are taken by created date, then by ID; changes without a created date
+// come last. It fails when ids are part of a dependency cycle.
+func (g *Graph) ArchiveOrder(ids []string) ([]string, error) {
+	inBatch := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		inBatch[id] = true
+	}
+
+	// waiting counts the dependencies of each change still to be placed
+	waiting := make(map[string]int, len(ids))
+	for _, id := range ids {
+		if change, ok := g.Changes[id]; ok {
+			for _, dep := range change.DependsOn {
+				if inBatch[dep] {
+					waiting[id]++
+				}
+			}
+		}
+	}
+
+	order := make([]string, 0, len(ids))
+	placed := make(map[string]bool, len(ids))
+	for len(order) < len(ids) {
+		var ready []string
+		for _, id := range ids {
+			if !placed[id] && waiting[id] == 0 {
+				ready = append(ready, id)
+			}
+		}
+		if len(ready) == 0 {
+			return nil, g.cycleError(inBatch, placed)
+		}
+
+		next := slices.MinFunc(ready, g.compareCreated)
+		order = append(order, next)
+		placed[next] = true
+		for _, id := range ids {
+			if change, ok := g.Changes[id]; ok && slices.Contains(change.DependsOn, next) {
+				waiting[id]--
+			}
+		}
+	}
+
+	return order, nil
+}
+
+// compareCreated orders changes by created date, then by ID. Changes
+// without a created date sort last.
+func (g *Graph) compareCreated(a, b string) int {
+	var createdA, createdB string
+	if change, ok := g.Changes[a]; ok {
+		createdA = change.Created
+	}
+	if change, ok := g.Changes[b]; ok {
+		createdB = change.Created
+	}
+
+	switch {
+	case createdA == createdB:
+		return strings.Compare(a, b)
+	case createdA == "":
+		return 1
+	case createdB == "":
+		return -1
+	}
+
+	return strings.Compare(createdA, createdB)
+}
+
+// cycleError reports a dependency cycle between the changes of the
+// batch that could not be placed
+func (g *Graph) cycleError(inBatch, placed map[string]bool) error {
+	for _, cycle := range g.Cycles() {
+		if slices.ContainsFunc(cycle, func(id string) bool {
+			return inBatch[id] && !placed[id]
+		}) {
+			return fmt.Errorf("dependency cycle: %s", FormatCycle(cycle))
+		}
+	}
+
+	return fmt.Errorf("dependency cycle between the changes")
+}
@@ -0,0 +1,39 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestArchiveOrder(t *testing.T) {
+	g := &Graph{Changes: map[string]*Change{
+		"api":    {ID: "api", Created: "2025-03-01", DependsOn: []string{"auth", "base"}},
+		"auth":   {ID: "auth", Created: "2025-02-01"},
+		"docs":   {ID: "docs"},
+		"search": {ID: "search", Created: "2025-01-01"},
+		"ui":     {ID: "ui", Created: "2025-01-15", DependsOn: []string{"api"}},
+		"base":   {ID: "base", Archived: true},
+	}}
+
+	got, err := g.ArchiveOrder([]string{"ui", "docs", "api", "search", "auth"})
+	if err != nil {
+		t.Fatalf("ArchiveOrder failed: %v", err)
+	}
+	want := []string{"search", "auth", "api", "ui", "docs"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ArchiveOrder() = %v, want %v", got, want)
+	}
+}
+
+func TestArchiveOrder_Cycle(t *testing.T) {
+	g := &Graph{Changes: map[string]*Change{
+		"a": {ID: "a", DependsOn: []string{"b"}},
+		"b": {ID: "b", DependsOn: []string{"a"}},
+		"c": {ID: "c"},
+	}}
+
+	_, err := g.ArchiveOrder([]string{"a", "b", "c"})
+	if err == nil || err.Error() != "dependency cycle: a → b → a" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
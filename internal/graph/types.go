@@ -0,0 +1,32 @@
+// Package graph builds the dependency graph between changes and the
+// capabilities they touch.
+//
+// A change depends on other changes by listing their IDs in the
+// depends_on field of its proposal frontmatter or in a "## Depends On"
+// section. Dependencies may be active or archived changes; a change can
+// only be archived once all of its dependencies are archived.
+package graph
+
+// Change is a node of the dependency graph
+type Change struct {
+	// ID is the change ID, without the date prefix of archived changes
+	ID string
+	// Archived reports whether the change has been archived
+	Archived bool
+	// DependsOn lists the IDs of the changes this change depends on
+	DependsOn []string
+	// Created is the created date of the proposal frontmatter, if any
+	Created string
+	// Capabilities lists the capabilities the change has deltas for
+	Capabilities []string
+	// Err is set when the dependencies of the change could not be
+	// read; DependsOn is empty then
+	Err error
+}
+
+// Graph holds the active and archived changes of a project
+type Graph struct {
+	// Changes maps change IDs to changes. An active change shadows an
+	// archived change with the same ID.
+	Changes map[string]*Change
+}
@@ -0,0 +1,266 @@
+package ids
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+const (
+	filePerm = 0o644
+
+	// numberWidth is the zero-padded width of assigned ID numbers
+	numberWidth = 3
+)
+
+var numberedIDPattern = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9]*)-(\d+)$`)
+
+// Options configures an Assign run
+type Options struct {
+	// Capabilities restricts assignment to these specs; empty means all
+	Capabilities []string
+	// DryRun computes assignments without writing files
+	DryRun bool
+}
+
+// Assignment records an ID given to a requirement
+type Assignment struct {
+	Capability  string `json:"capability"`
+	Requirement string `json:"requirement"`
+	ID          string `json:"id"`
+}
+
+// specIDs holds what Assign learns about a spec's existing IDs
+type specIDs struct {
+	path string
+	reqs []parsers.RequirementBlock
+	// prefix is the prefix already used by the spec's IDs, if any
+	prefix string
+	// next is one past the highest number used with prefix
+	next int
+}
+
+// Assign gives a stable ID to every requirement that lacks one, writing
+// "{#PREFIX-NNN}" to the end of its header. Specs that already use
+// numbered IDs keep their prefix and continue after their highest
+// number; other specs get a prefix from Prefixes. IDs already used
+// anywhere in the project, including the delta specs of active changes,
+// are never handed out again.
+func Assign(projectPath string, opts Options) ([]Assignment, error) {
+	capabilities, err := discovery.GetSpecs(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover specs: %w", err)
+	}
+	sort.Strings(capabilities)
+
+	for _, capability := range opts.Capabilities {
+		if !slices.Contains(capabilities, capability) {
+			return nil, fmt.Errorf("spec '%s' not found", capability)
+		}
+	}
+
+	specs, used, err := scanSpecs(projectPath, capabilities)
+	if err != nil {
+		return nil, err
+	}
+	if err := scanChangeIDs(projectPath, used); err != nil {
+		return nil, err
+	}
+
+	// Specs with existing numbered IDs keep their prefix; the rest are
+	// assigned prefixes that do not clash with those
+	var reserved, unprefixed []string
+	for _, capability := range capabilities {
+		if prefix := specs[capability].prefix; prefix != "" {
+			reserved = append(reserved, prefix)
+		} else {
+			unprefixed = append(unprefixed, capability)
+		}
+	}
+	for capability, prefix := range Prefixes(unprefixed, reserved...) {
+		specs[capability].prefix = prefix
+		specs[capability].next = 1
+	}
+
+	var assignments []Assignment
+	for _, capability := range capabilities {
+		if len(opts.Capabilities) > 0 &&
+			!slices.Contains(opts.Capabilities, capability) {
+			continue
+		}
+
+		assigned, err := assignSpec(capability, specs[capability], used, opts.DryRun)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, assigned...)
+	}
+
+	return assignments, nil
+}
+
+// scanSpecs parses every spec and collects the IDs already in use
+func scanSpecs(
+	projectPath string,
+	capabilities []string,
+) (map[string]*specIDs, map[string]bool, error) {
+	specs := make(map[string]*specIDs, len(capabilities))
+	used := make(map[string]bool)
+
+	for _, capability := range capabilities {
+		path := filepath.Join(projectPath, "spectr", "specs", capability, "spec.md")
+		reqs, err := parsers.ParseRequirements(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse %s: %w", path, err)
+		}
+
+		spec := &specIDs{path: path, reqs: reqs}
+		counts := make(map[string]int)
+		highest := make(map[string]int)
+		for _, req := range reqs {
+			if req.ID == "" {
+				continue
+			}
+			used[req.ID] = true
+
+			matches := numberedIDPattern.FindStringSubmatch(req.ID)
+			if matches == nil {
+				continue
+			}
+			number, _ := strconv.Atoi(matches[2])
+			counts[matches[1]]++
+			highest[matches[1]] = max(highest[matches[1]], number)
+		}
+
+		// Use the most common prefix, breaking ties alphabetically
+		for prefix, count := range counts {
+			if count > counts[spec.prefix] ||
+				(count == counts[spec.prefix] && prefix < spec.prefix) {
+				spec.prefix = prefix
+			}
+		}
+		if spec.prefix != "" {
+			spec.next = highest[spec.prefix] + 1
+		}
+
+		specs[capability] = spec
+	}
+
+	return specs, used, nil
+}
+
+// scanChangeIDs adds the IDs used in the delta specs of active changes
+// to used, so IDs that arrive when a change is archived are not handed
+// out in the meantime
+func scanChangeIDs(projectPath string, used map[string]bool) error {
+	changes, err := discovery.GetActiveChanges(projectPath)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		specsDir := filepath.Join(projectPath, "spectr", "changes", change, "specs")
+		if _, err := os.Stat(specsDir); os.IsNotExist(err) {
+			continue
+		}
+		err := filepath.WalkDir(specsDir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || d.Name() != "spec.md" {
+				return nil
+			}
+
+			reqs, err := parsers.ParseRequirements(path)
+			if err != nil {
+				return fmt.Errorf("parse %s: %w", path, err)
+			}
+			for _, req := range reqs {
+				if req.ID != "" {
+					used[req.ID] = true
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assignSpec assigns IDs to one spec and rewrites its headers
+func assignSpec(
+	capability string,
+	spec *specIDs,
+	used map[string]bool,
+	dryRun bool,
+) ([]Assignment, error) {
+	var assignments []Assignment
+	// assigned maps the positions of requirements in spec.reqs to their
+	// new IDs
+	assigned := make(map[int]string)
+	for i, req := range spec.reqs {
+		if req.ID != "" {
+			continue
+		}
+
+		id := ""
+		for id == "" || used[id] {
+			id = fmt.Sprintf("%s-%0*d", spec.prefix, numberWidth, spec.next)
+			spec.next++
+		}
+		used[id] = true
+		assigned[i] = id
+
+		assignments = append(assignments, Assignment{
+			Capability:  capability,
+			Requirement: req.Name,
+			ID:          id,
+		})
+	}
+
+	if dryRun || len(assignments) == 0 {
+		return assignments, nil
+	}
+
+	content, err := os.ReadFile(spec.path)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", spec.path, err)
+	}
+
+	// Headers are matched as the parser matches them, so the n-th header
+	// line is the n-th requirement of spec.reqs
+	lines := strings.Split(string(content), "\n")
+	header := 0
+	for i, line := range lines {
+		if !parsers.RequirementHeaderPattern.MatchString(line) {
+			continue
+		}
+		if id, ok := assigned[header]; ok {
+			text, crlf := strings.CutSuffix(line, "\r")
+			lines[i] = strings.TrimRight(text, " \t") + " {#" + id + "}"
+			if crlf {
+				lines[i] += "\r"
+			}
+		}
+		header++
+	}
+
+	if err := os.WriteFile(spec.path, []byte(strings.Join(lines, "\n")), filePerm); err != nil {
+		return nil, fmt.Errorf("write %s: %w", spec.path, err)
+	}
+
+	return assignments, nil
+}
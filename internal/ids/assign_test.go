@@ -0,0 +1,159 @@
+package ids
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeSpec(t *testing.T, projectPath, capability, requirements string) string {
+	t.Helper()
+
+	dir := filepath.Join(projectPath, "spectr", "specs", capability)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "spec.md")
+	content := "# " + capability + " Specification\n\n## Purpose\nTest.\n\n## Requirements\n\n" +
+		requirements
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestAssign(t *testing.T) {
+	projectPath := t.TempDir()
+	authPath := writeSpec(t, projectPath, "auth", `### Requirement: Login {#AUTH-007}
+The system SHALL log users in.
+
+### Requirement: Logout
+The system SHALL log users out.
+`)
+	validationPath := writeSpec(t, projectPath, "validation", `### Requirement: Spec Files
+The system SHALL validate spec files.
+
+### Requirement: Change Files <!-- id: custom-id -->
+The system SHALL validate change files.
+
+### Requirement: Deltas
+The system SHALL validate deltas.
+`)
+
+	assignments, err := Assign(projectPath, Options{})
+	if err != nil {
+		t.Fatalf("Assign failed: %v", err)
+	}
+
+	want := []Assignment{
+		{Capability: "auth", Requirement: "Logout", ID: "AUTH-008"},
+		{Capability: "validation", Requirement: "Spec Files", ID: "VAL-001"},
+		{Capability: "validation", Requirement: "Deltas", ID: "VAL-002"},
+	}
+	if !reflect.DeepEqual(assignments, want) {
+		t.Errorf("Assign() = %+v, want %+v", assignments, want)
+	}
+
+	auth, _ := os.ReadFile(authPath)
+	if !strings.Contains(string(auth), "### Requirement: Logout {#AUTH-008}\n") {
+		t.Errorf("auth spec not updated:\n%s", auth)
+	}
+	validation, _ := os.ReadFile(validationPath)
+	for _, header := range []string{
+		"### Requirement: Spec Files {#VAL-001}\n",
+		"### Requirement: Change Files <!-- id: custom-id -->\n",
+		"### Requirement: Deltas {#VAL-002}\n",
+	} {
+		if !strings.Contains(string(validation), header) {
+			t.Errorf("validation spec missing %q:\n%s", header, validation)
+		}
+	}
+
+	// A second run has nothing left to do
+	assignments, err = Assign(projectPath, Options{})
+	if err != nil {
+		t.Fatalf("second Assign failed: %v", err)
+	}
+	if len(assignments) != 0 {
+		t.Errorf("Expected no assignments on second run, got %+v", assignments)
+	}
+}
+
+func TestAssign_DryRunAndFilter(t *testing.T) {
+	projectPath := t.TempDir()
+	authPath := writeSpec(t, projectPath, "auth", "### Requirement: Login\nThe system SHALL log users in.\n")
+	writeSpec(t, projectPath, "audit", "### Requirement: Trail\nThe system SHALL keep a trail.\n")
+
+	assignments, err := Assign(projectPath, Options{
+		Capabilities: []string{"auth"},
+		DryRun:       true,
+	})
+	if err != nil {
+		t.Fatalf("Assign failed: %v", err)
+	}
+
+	want := []Assignment{{Capability: "auth", Requirement: "Login", ID: "AUT-001"}}
+	if !reflect.DeepEqual(assignments, want) {
+		t.Errorf("Assign() = %+v, want %+v", assignments, want)
+	}
+
+	content, _ := os.ReadFile(authPath)
+	if strings.Contains(string(content), "{#") {
+		t.Error("dry run should not write files")
+	}
+
+	if _, err := Assign(projectPath, Options{Capabilities: []string{"missing"}}); err == nil {
+		t.Error("Expected error for unknown capability")
+	}
+}
+
+func TestAssign_MatchesParser(t *testing.T) {
+	projectPath := t.TempDir()
+	authPath := writeSpec(t, projectPath, "auth", "  ### Requirement: Indented\nNot a requirement.\n\n"+
+		"### Requirement: Login\nThe system SHALL log users in.\n")
+
+	assignments, err := Assign(projectPath, Options{})
+	if err != nil {
+		t.Fatalf("Assign failed: %v", err)
+	}
+	if len(assignments) != 1 || assignments[0].Requirement != "Login" {
+		t.Fatalf("Expected only Login to get an ID, got %+v", assignments)
+	}
+
+	content, _ := os.ReadFile(authPath)
+	if !strings.Contains(string(content), "  ### Requirement: Indented\n") ||
+		!strings.Contains(string(content), "### Requirement: Login {#AUT-001}\n") {
+		t.Errorf("Unexpected spec:\n%s", content)
+	}
+}
+
+func TestAssign_ReservesChangeIDs(t *testing.T) {
+	projectPath := t.TempDir()
+	writeSpec(t, projectPath, "auth", "### Requirement: Login {#AUTH-001}\nThe system SHALL log users in.\n\n"+
+		"### Requirement: Logout\nThe system SHALL log users out.\n")
+
+	deltaDir := filepath.Join(projectPath, "spectr", "changes", "add-sso", "specs", "auth")
+	if err := os.MkdirAll(deltaDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	delta := "## ADDED Requirements\n\n### Requirement: SSO {#AUTH-002}\nThe system SHALL support SSO.\n"
+	if err := os.WriteFile(filepath.Join(deltaDir, "spec.md"), []byte(delta), 0644); err != nil {
+		t.Fatal(err)
+	}
+	proposal := filepath.Join(projectPath, "spectr", "changes", "add-sso", "proposal.md")
+	if err := os.WriteFile(proposal, []byte("# Change: Add SSO\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	assignments, err := Assign(projectPath, Options{DryRun: true})
+	if err != nil {
+		t.Fatalf("Assign failed: %v", err)
+	}
+	want := []Assignment{{Capability: "auth", Requirement: "Logout", ID: "AUTH-003"}}
+	if !reflect.DeepEqual(assignments, want) {
+		t.Errorf("Assign() = %+v, want %+v", assignments, want)
+	}
+}
@@ -0,0 +1,68 @@
+// Package ids manages stable requirement identifiers such as
+// "### Requirement: Spec File Validation {#VAL-012}".
+//
+// Each capability gets a short upper-case prefix derived from its ID;
+// Assign back-fills IDs for requirements that do not have one yet.
+package ids
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const prefixLength = 3
+
+var nonPrefixPattern = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// Prefixes assigns each capability a short, unique, upper-case prefix.
+// The first three letters of the capability ID are preferred
+// ("validation" -> "VAL"); on collision the initials of the remaining
+// words are appended ("cli-interface" -> "CLII"), then the full ID,
+// then a counter. Prefixes in reserved are never handed out.
+// Capabilities are processed in the given order, so a stable input
+// order yields stable prefixes.
+func Prefixes(capabilities []string, reserved ...string) map[string]string {
+	prefixes := make(map[string]string, len(capabilities))
+	taken := make(map[string]bool, len(capabilities)+len(reserved))
+	for _, prefix := range reserved {
+		taken[prefix] = true
+	}
+
+	for _, capability := range capabilities {
+		words := strings.Fields(
+			strings.ToUpper(nonPrefixPattern.ReplaceAllString(capability, " ")),
+		)
+		if len(words) == 0 {
+			words = []string{"REQ"}
+		}
+
+		joined := strings.Join(words, "")
+		candidates := []string{joined[:min(prefixLength, len(joined))]}
+
+		initials := words[0][:min(prefixLength, len(words[0]))]
+		for _, word := range words[1:] {
+			initials += word[:1]
+		}
+		candidates = append(candidates, initials, joined)
+
+		prefix := ""
+		for _, candidate := range candidates {
+			if !taken[candidate] {
+				prefix = candidate
+
+				break
+			}
+		}
+		for n := 2; prefix == ""; n++ {
+			if candidate := candidates[0] + strconv.Itoa(n); !taken[candidate] {
+				prefix = candidate
+			}
+		}
+
+		taken[prefix] = true
+		prefixes[capability] = prefix
+	}
+
+	return prefixes
+}
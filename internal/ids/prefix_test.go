@@ -0,0 +1,35 @@
+package ids
+
+import "testing"
+
+func TestPrefixes(t *testing.T) {
+	got := Prefixes([]string{
+		"validation",
+		"cli-framework",
+		"cli-interface",
+		"cli-integration",
+		"ci",
+		"--",
+	})
+
+	want := map[string]string{
+		"validation":      "VAL",
+		"cli-framework":   "CLI",
+		"cli-interface":   "CLII",
+		"cli-integration": "CLIINTEGRATION",
+		"ci":              "CI",
+		"--":              "REQ",
+	}
+	for id, prefix := range want {
+		if got[id] != prefix {
+			t.Errorf("prefix of %q = %q, want %q", id, got[id], prefix)
+		}
+	}
+}
+
+func TestPrefixes_Reserved(t *testing.T) {
+	got := Prefixes([]string{"validation"}, "VAL")
+	if got["validation"] != "VALIDATION" {
+		t.Errorf("Expected reserved prefix VAL to be skipped, got %q", got["validation"])
+	}
+}
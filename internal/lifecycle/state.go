@@ -0,0 +1,83 @@
+// Package lifecycle tracks the state of a change between proposal and
+// archive:
+//
+//	draft → proposed → approved → in-progress → ready-to-archive
+//
+// The state is stored in the status field of the proposal frontmatter.
+// Changes without a status are proposed: they must be approved before
+// they can be archived, however far their tasks have come.
+package lifecycle
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// State is a lifecycle state of a change
+type State string
+
+// Lifecycle states, in order
+const (
+	Draft          State = "draft"
+	Proposed       State = "proposed"
+	Approved       State = "approved"
+	InProgress     State = "in-progress"
+	ReadyToArchive State = "ready-to-archive"
+)
+
+// States lists the lifecycle states in order
+var States = []State{Draft, Proposed, Approved, InProgress, ReadyToArchive}
+
+// stateLabels are the dashboard headings of the states
+var stateLabels = map[State]string{
+	Draft:          "Draft",
+	Proposed:       "Proposed",
+	Approved:       "Approved",
+	InProgress:     "In Progress",
+	ReadyToArchive: "Ready to Archive",
+}
+
+// Parse returns the state named s
+func Parse(s string) (State, error) {
+	state := State(s)
+	if !slices.Contains(States, state) {
+		names := make([]string, len(States))
+		for i, known := range States {
+			names[i] = string(known)
+		}
+
+		return "", fmt.Errorf(
+			"unknown state %q (expected one of: %s)",
+			s,
+			strings.Join(names, ", "),
+		)
+	}
+
+	return state, nil
+}
+
+// Label returns the human-readable name of the state
+func (s State) Label() string {
+	return stateLabels[s]
+}
+
+// index returns the position of the state in the lifecycle
+func (s State) index() int {
+	return slices.Index(States, s)
+}
+
+// Before reports whether s comes earlier in the lifecycle than other
+func (s State) Before(other State) bool {
+	return s.index() < other.index()
+}
+
+// Effective returns the state of a change given its status field: the
+// state it names, or proposed when it is missing or unknown
+func Effective(status string) State {
+	if state, err := Parse(status); err == nil {
+		return state
+	}
+
+	return Proposed
+}
@@ -0,0 +1,52 @@
+package lifecycle
+
+import (
+	"testing"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+func TestStatesMatchProposalStatuses(t *testing.T) {
+	if len(States) != len(parsers.ProposalStatuses) {
+		t.Fatalf("States = %v, ProposalStatuses = %v", States, parsers.ProposalStatuses)
+	}
+	for i, state := range States {
+		if string(state) != parsers.ProposalStatuses[i] {
+			t.Errorf("States[%d] = %q, want %q", i, state, parsers.ProposalStatuses[i])
+		}
+		if state.Label() == "" {
+			t.Errorf("State %q has no label", state)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	state, err := Parse("in-progress")
+	if err != nil || state != InProgress {
+		t.Errorf("Parse(in-progress) = %q, %v", state, err)
+	}
+
+	if _, err := Parse("done"); err == nil {
+		t.Error("Expected an error for an unknown state")
+	}
+}
+
+func TestEffective(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+		want   State
+	}{
+		{"status wins", "approved", Approved},
+		{"no status", "", Proposed},
+		{"invalid status", "bogus", Proposed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Effective(tt.status); got != tt.want {
+				t.Errorf("Effective(%q) = %q, want %q", tt.status, got, tt.want)
+			}
+		})
+	}
+}
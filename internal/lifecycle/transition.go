@@ -0,0 +1,137 @@
+package lifecycle
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// filePerm is the permission of rewritten proposal files
+const filePerm = 0o644
+
+// Guards are the checks a change must pass to enter a state
+type Guards struct {
+	// Validate checks the change before it is proposed
+	Validate func(changeDir string) error
+	// Force skips all guards except the transition order
+	Force bool
+}
+
+// CheckTransition reports whether a change may move between states.
+// Changes move forward one state at a time and may move back to any
+// earlier state.
+func CheckTransition(from, to State) error {
+	switch {
+	case from == to:
+		return fmt.Errorf("change is already %s", to)
+	case to.Before(from), to.index() == from.index()+1:
+		return nil
+	default:
+		return fmt.Errorf(
+			"cannot move from %s to %s; the next state is %s",
+			from,
+			to,
+			States[from.index()+1],
+		)
+	}
+}
+
+// Current returns the state of the change in changeDir
+func Current(changeDir string) (State, error) {
+	metadata, err := parsers.ParseProposalMetadata(
+		filepath.Join(changeDir, "proposal.md"),
+	)
+	if err != nil {
+		return "", fmt.Errorf("read proposal: %w", err)
+	}
+
+	return Effective(metadata.Status), nil
+}
+
+// Transition moves the change in changeDir to state to, checking the
+// transition order and the guards of the target state, and records the
+// new state in the proposal frontmatter. It returns the previous state.
+func Transition(changeDir string, to State, guards Guards) (State, error) {
+	from, err := Current(changeDir)
+	if err != nil {
+		return "", err
+	}
+
+	if err := CheckTransition(from, to); err != nil {
+		return from, err
+	}
+
+	if !guards.Force && from.Before(to) {
+		if err := checkGuards(changeDir, to, guards); err != nil {
+			return from, err
+		}
+	}
+
+	proposalPath := filepath.Join(changeDir, "proposal.md")
+	content, err := os.ReadFile(proposalPath)
+	if err != nil {
+		return from, fmt.Errorf("read proposal: %w", err)
+	}
+	updated := parsers.SetFrontmatterField(
+		string(content),
+		parsers.StatusField,
+		string(to),
+	)
+	if err := os.WriteFile(proposalPath, []byte(updated), filePerm); err != nil {
+		return from, fmt.Errorf("write proposal: %w", err)
+	}
+
+	return from, nil
+}
+
+// checkGuards checks the conditions for entering state to
+func checkGuards(changeDir string, to State, guards Guards) error {
+	switch to {
+	case Proposed:
+		if guards.Validate == nil {
+			return nil
+		}
+		if err := guards.Validate(changeDir); err != nil {
+			return fmt.Errorf("change must validate before it is proposed: %w", err)
+		}
+	case ReadyToArchive:
+		tasks, err := parsers.CountTasks(filepath.Join(changeDir, "tasks.md"))
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("read tasks: %w", err)
+		}
+		if tasks.Total == 0 {
+			return errors.New("change has no tasks; add tasks.md before marking it ready to archive")
+		}
+		if tasks.Completed < tasks.Total {
+			return fmt.Errorf(
+				"%d of %d tasks are incomplete",
+				tasks.Total-tasks.Completed,
+				tasks.Total,
+			)
+		}
+	}
+
+	return nil
+}
+
+// CheckArchivable fails if the change is in a state before approved.
+// Changes without a status are proposed, so they must be approved too.
+func CheckArchivable(status string) error {
+	if status != "" {
+		if _, err := Parse(status); err != nil {
+			return err
+		}
+	}
+
+	if state := Effective(status); state.Before(Approved) {
+		return fmt.Errorf(
+			"change is %s; it must be approved before it can be archived",
+			state,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package lifecycle
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// writeChange creates a change with the given proposal and tasks
+func writeChange(t *testing.T, proposal, tasks string) string {
+	t.Helper()
+	changeDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(changeDir, "proposal.md"), []byte(proposal), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if tasks != "" {
+		if err := os.WriteFile(filepath.Join(changeDir, "tasks.md"), []byte(tasks), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return changeDir
+}
+
+func TestCheckTransition(t *testing.T) {
+	tests := []struct {
+		from, to State
+		ok       bool
+	}{
+		{Draft, Proposed, true},
+		{Proposed, Approved, true},
+		{Approved, InProgress, true},
+		{InProgress, ReadyToArchive, true},
+		{ReadyToArchive, Draft, true},
+		{InProgress, Proposed, true},
+		{Draft, Approved, false},
+		{Proposed, ReadyToArchive, false},
+		{Approved, Approved, false},
+	}
+
+	for _, tt := range tests {
+		err := CheckTransition(tt.from, tt.to)
+		if (err == nil) != tt.ok {
+			t.Errorf("CheckTransition(%s, %s) = %v, want ok=%v", tt.from, tt.to, err, tt.ok)
+		}
+	}
+}
+
+func TestTransition(t *testing.T) {
+	changeDir := writeChange(t, "---\nstatus: proposed\nowner: alice\n---\n# Change: Test\n", "")
+
+	from, err := Transition(changeDir, Approved, Guards{})
+	if err != nil {
+		t.Fatalf("Transition failed: %v", err)
+	}
+	if from != Proposed {
+		t.Errorf("from = %q, want proposed", from)
+	}
+
+	metadata, err := parsers.ParseProposalMetadata(filepath.Join(changeDir, "proposal.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metadata.Status != "approved" || metadata.Owner != "alice" {
+		t.Errorf("Unexpected metadata after transition: %+v", metadata)
+	}
+
+	if _, err := Transition(changeDir, ReadyToArchive, Guards{}); err == nil {
+		t.Error("Expected skipping in-progress to fail")
+	}
+}
+
+func TestTransition_AddsFrontmatter(t *testing.T) {
+	changeDir := writeChange(t, "# Change: Test\n", "")
+
+	if _, err := Transition(changeDir, Draft, Guards{}); err != nil {
+		t.Fatalf("Transition failed: %v", err)
+	}
+
+	state, err := Current(changeDir)
+	if err != nil || state != Draft {
+		t.Errorf("Current() = %q, %v", state, err)
+	}
+}
+
+func TestTransition_ValidateGuard(t *testing.T) {
+	changeDir := writeChange(t, "---\nstatus: draft\n---\n# Change: Test\n", "")
+	guards := Guards{Validate: func(string) error { return errors.New("2 errors") }}
+
+	_, err := Transition(changeDir, Proposed, guards)
+	if err == nil || !strings.Contains(err.Error(), "must validate") {
+		t.Errorf("Expected validation guard error, got %v", err)
+	}
+
+	guards.Force = true
+	if _, err := Transition(changeDir, Proposed, guards); err != nil {
+		t.Errorf("Expected --force to skip the guard, got %v", err)
+	}
+}
+
+func TestTransition_ReadyToArchiveGuard(t *testing.T) {
+	changeDir := writeChange(
+		t,
+		"---\nstatus: in-progress\n---\n# Change: Test\n",
+		"## 1. Tasks\n- [x] 1.1 Done\n- [ ] 1.2 Open\n",
+	)
+
+	_, err := Transition(changeDir, ReadyToArchive, Guards{})
+	if err == nil || err.Error() != "1 of 2 tasks are incomplete" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	noTasks := writeChange(t, "---\nstatus: in-progress\n---\n# Change: Test\n", "")
+	if _, err := Transition(noTasks, ReadyToArchive, Guards{}); err == nil {
+		t.Error("Expected a change without tasks to be rejected")
+	}
+
+	// Moving back is never guarded
+	done := writeChange(t, "---\nstatus: ready-to-archive\n---\n# Change: Test\n", "")
+	if _, err := Transition(done, InProgress, Guards{}); err != nil {
+		t.Errorf("Expected moving back to succeed, got %v", err)
+	}
+}
+
+func TestCheckArchivable(t *testing.T) {
+	for status, ok := range map[string]bool{
+		"":                 false,
+		"approved":         true,
+		"in-progress":      true,
+		"ready-to-archive": true,
+		"draft":            false,
+		"proposed":         false,
+		"bogus":            false,
+	} {
+		if err := CheckArchivable(status); (err == nil) != ok {
+			t.Errorf("CheckArchivable(%q) = %v, want ok=%v", status, err, ok)
+		}
+	}
+}
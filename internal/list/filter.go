@@ -0,0 +1,69 @@
+package list
+
+import "slices"
+
+// ChangeFilter selects changes by their proposal metadata. Empty
+// fields match every change.
+type ChangeFilter struct {
+	// Labels must all be present on the change
+	Labels []string
+	Owner  string
+	// Status matches the lifecycle state of the change
+	Status string
+}
+
+// IsZero reports whether the filter matches every change
+func (f ChangeFilter) IsZero() bool {
+	return len(f.Labels) == 0 && f.Owner == "" && f.Status == ""
+}
+
+// Matches reports whether the change passes the filter
+func (f ChangeFilter) Matches(change ChangeInfo) bool {
+	if f.Owner != "" && change.Owner != f.Owner {
+		return false
+	}
+	if f.Status != "" && change.State != f.Status {
+		return false
+	}
+	for _, label := range f.Labels {
+		if !slices.Contains(change.Labels, label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FilterChanges returns the changes that pass the filter
+func FilterChanges(changes []ChangeInfo, filter ChangeFilter) []ChangeInfo {
+	if filter.IsZero() {
+		return changes
+	}
+
+	var filtered []ChangeInfo
+	for _, change := range changes {
+		if filter.Matches(change) {
+			filtered = append(filtered, change)
+		}
+	}
+
+	return filtered
+}
+
+// FilterItems returns the changes of items that pass the filter. Specs
+// have no proposal metadata and are dropped unless the filter is empty.
+func FilterItems(items ItemList, filter ChangeFilter) ItemList {
+	if filter.IsZero() {
+		return items
+	}
+
+	var filtered ItemList
+	for _, item := range items {
+		if item.Type == ItemTypeChange && item.Change != nil &&
+			filter.Matches(*item.Change) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,65 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+func TestFilterChanges(t *testing.T) {
+	changes := []ChangeInfo{
+		{ID: "a", State: "approved", ProposalMetadata: parsers.ProposalMetadata{
+			Owner: "alice", Status: "approved", Labels: []string{"auth", "api"},
+		}},
+		{ID: "b", State: "draft", ProposalMetadata: parsers.ProposalMetadata{
+			Owner: "bob", Status: "draft", Labels: []string{"auth"},
+		}},
+		{ID: "c", State: "proposed"},
+	}
+
+	tests := []struct {
+		name   string
+		filter ChangeFilter
+		want   []string
+	}{
+		{"empty", ChangeFilter{}, []string{"a", "b", "c"}},
+		{"label", ChangeFilter{Labels: []string{"auth"}}, []string{"a", "b"}},
+		{"all labels", ChangeFilter{Labels: []string{"auth", "api"}}, []string{"a"}},
+		{"owner", ChangeFilter{Owner: "bob"}, []string{"b"}},
+		{"status and label", ChangeFilter{Status: "approved", Labels: []string{"auth"}}, []string{"a"}},
+		{"state without status", ChangeFilter{Status: "proposed"}, []string{"c"}},
+		{"no match", ChangeFilter{Status: "in-progress"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, change := range FilterChanges(changes, tt.filter) {
+				got = append(got, change.ID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("FilterChanges() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("FilterChanges() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterItems(t *testing.T) {
+	items := ItemList{
+		NewChangeItem(ChangeInfo{ID: "a", ProposalMetadata: parsers.ProposalMetadata{Owner: "alice"}}),
+		NewChangeItem(ChangeInfo{ID: "b"}),
+		NewSpecItem(SpecInfo{ID: "auth"}),
+	}
+
+	if got := FilterItems(items, ChangeFilter{}); len(got) != 3 {
+		t.Errorf("Expected all items without a filter, got %d", len(got))
+	}
+	got := FilterItems(items, ChangeFilter{Owner: "alice"})
+	if len(got) != 1 || got.Changes()[0].ID != "a" {
+		t.Errorf("Expected only change a, got %+v", got)
+	}
+}
@@ -23,14 +23,14 @@ func FormatChangesText(changes []ChangeInfo) string {
 
 	// Sort by ID
 	sort.Slice(changes, func(i, j int) bool {
-		return changes[i].ID < changes[j].ID
+		return changes[i].QualifiedID() < changes[j].QualifiedID()
 	})
 
 	// Find the longest ID for alignment
 	maxIDLen := 0
 	for _, change := range changes {
-		if len(change.ID) > maxIDLen {
-			maxIDLen = len(change.ID)
+		if len(change.QualifiedID()) > maxIDLen {
+			maxIDLen = len(change.QualifiedID())
 		}
 	}
 
@@ -38,7 +38,7 @@ func FormatChangesText(changes []ChangeInfo) string {
 	for _, change := range changes {
 		line := fmt.Sprintf("%-*s  %d/%d tasks",
 			maxIDLen,
-			change.ID,
+			change.QualifiedID(),
 			change.TaskStatus.Completed,
 			change.TaskStatus.Total,
 		)
@@ -56,13 +56,13 @@ func FormatChangesLong(changes []ChangeInfo) string {
 
 	// Sort by ID
 	sort.Slice(changes, func(i, j int) bool {
-		return changes[i].ID < changes[j].ID
+		return changes[i].QualifiedID() < changes[j].QualifiedID()
 	})
 
 	var lines []string
 	for _, change := range changes {
 		line := fmt.Sprintf("%s: %s [deltas %d] [tasks %d/%d]",
-			change.ID,
+			change.QualifiedID(),
 			change.Title,
 			change.DeltaCount,
 			change.TaskStatus.Completed,
@@ -82,7 +82,7 @@ func FormatChangesJSON(changes []ChangeInfo) (string, error) {
 
 	// Sort by ID
 	sort.Slice(changes, func(i, j int) bool {
-		return changes[i].ID < changes[j].ID
+		return changes[i].QualifiedID() < changes[j].QualifiedID()
 	})
 
 	data, err := json.MarshalIndent(changes, "", "  ")
@@ -101,12 +101,12 @@ func FormatSpecsText(specs []SpecInfo) string {
 
 	// Sort by ID
 	sort.Slice(specs, func(i, j int) bool {
-		return specs[i].ID < specs[j].ID
+		return specs[i].QualifiedID() < specs[j].QualifiedID()
 	})
 
 	var lines []string
 	for _, spec := range specs {
-		lines = append(lines, spec.ID)
+		lines = append(lines, spec.QualifiedID())
 	}
 
 	return strings.Join(lines, lineSeparator)
@@ -120,13 +120,13 @@ func FormatSpecsLong(specs []SpecInfo) string {
 
 	// Sort by ID
 	sort.Slice(specs, func(i, j int) bool {
-		return specs[i].ID < specs[j].ID
+		return specs[i].QualifiedID() < specs[j].QualifiedID()
 	})
 
 	var lines []string
 	for _, spec := range specs {
 		line := fmt.Sprintf("%s: %s [requirements %d]",
-			spec.ID,
+			spec.QualifiedID(),
 			spec.Title,
 			spec.RequirementCount,
 		)
@@ -144,7 +144,7 @@ func FormatSpecsJSON(specs []SpecInfo) (string, error) {
 
 	// Sort by ID
 	sort.Slice(specs, func(i, j int) bool {
-		return specs[i].ID < specs[j].ID
+		return specs[i].QualifiedID() < specs[j].QualifiedID()
 	})
 
 	data, err := json.MarshalIndent(specs, "", "  ")
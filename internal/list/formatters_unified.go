@@ -20,14 +20,14 @@ func FormatAllText(items ItemList) string {
 
 	// Sort by ID
 	sort.Slice(items, func(i, j int) bool {
-		return items[i].ID() < items[j].ID()
+		return items[i].QualifiedID() < items[j].QualifiedID()
 	})
 
 	// Find the longest ID for alignment
 	maxIDLen := 0
 	for _, item := range items {
-		if len(item.ID()) > maxIDLen {
-			maxIDLen = len(item.ID())
+		if len(item.QualifiedID()) > maxIDLen {
+			maxIDLen = len(item.QualifiedID())
 		}
 	}
 
@@ -53,7 +53,7 @@ func FormatAllText(items ItemList) string {
 
 		line := fmt.Sprintf("%-*s  %s  %s",
 			maxIDLen,
-			item.ID(),
+			item.QualifiedID(),
 			typeIndicator,
 			details,
 		)
@@ -73,7 +73,7 @@ func FormatAllLong(items ItemList) string {
 
 	// Sort by ID
 	sort.Slice(items, func(i, j int) bool {
-		return items[i].ID() < items[j].ID()
+		return items[i].QualifiedID() < items[j].QualifiedID()
 	})
 
 	var lines []string
@@ -84,7 +84,7 @@ func FormatAllLong(items ItemList) string {
 			if item.Change != nil {
 				line = fmt.Sprintf(
 					"%s [CHANGE]: %s [deltas %d] [tasks %d/%d]",
-					item.Change.ID,
+					item.Change.QualifiedID(),
 					item.Change.Title,
 					item.Change.DeltaCount,
 					item.Change.TaskStatus.Completed,
@@ -95,7 +95,7 @@ func FormatAllLong(items ItemList) string {
 			if item.Spec != nil {
 				line = fmt.Sprintf(
 					"%s [SPEC]: %s [requirements %d]",
-					item.Spec.ID,
+					item.Spec.QualifiedID(),
 					item.Spec.Title,
 					item.Spec.RequirementCount,
 				)
@@ -119,7 +119,7 @@ func FormatAllJSON(items ItemList) (string, error) {
 
 	// Sort by ID for consistent output
 	sort.Slice(items, func(i, j int) bool {
-		return items[i].ID() < items[j].ID()
+		return items[i].QualifiedID() < items[j].QualifiedID()
 	})
 
 	// Marshal items to JSON with indentation for readability
@@ -6,6 +6,7 @@ import (
 	"sort"
 
 	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/lifecycle"
 	"github.com/connerohnesorge/spectr/internal/parsers"
 )
 
@@ -52,11 +53,20 @@ func (l *Lister) ListChanges() ([]ChangeInfo, error) {
 			deltaCount = 0
 		}
 
+		// Read frontmatter metadata; invalid frontmatter is reported
+		// by validation
+		metadata, err := parsers.ParseProposalMetadata(proposalPath)
+		if err != nil {
+			metadata = parsers.ProposalMetadata{}
+		}
+
 		changes = append(changes, ChangeInfo{
-			ID:         id,
-			Title:      title,
-			DeltaCount: deltaCount,
-			TaskStatus: taskStatus,
+			ID:               id,
+			Title:            title,
+			DeltaCount:       deltaCount,
+			TaskStatus:       taskStatus,
+			State:            string(lifecycle.Effective(metadata.Status)),
+			ProposalMetadata: metadata,
 		})
 	}
 
@@ -3,6 +3,7 @@ package list
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -117,6 +118,49 @@ func TestListChanges_FallbackTitle(t *testing.T) {
 	}
 }
 
+func TestListChanges_Metadata(t *testing.T) {
+	tmpDir := t.TempDir()
+	changeDir := filepath.Join(tmpDir, "spectr", "changes", "add-auth")
+	if err := os.MkdirAll(changeDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	proposalContent := `---
+owner: alice
+status: approved
+labels: [auth]
+---
+# Change: Add Auth
+`
+	if err := os.WriteFile(filepath.Join(changeDir, "proposal.md"), []byte(proposalContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	changes, err := NewLister(tmpDir).ListChanges()
+	if err != nil {
+		t.Fatalf("ListChanges failed: %v", err)
+	}
+	if len(changes) != 1 {
+		t.Fatalf("Expected 1 change, got %d", len(changes))
+	}
+
+	change := changes[0]
+	if change.Title != "Add Auth" || change.Owner != "alice" ||
+		change.Status != "approved" || len(change.Labels) != 1 {
+		t.Errorf("Unexpected change: %+v", change)
+	}
+
+	output, err := FormatChangesJSON(changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"owner": "alice"`, `"status": "approved"`, `"labels": [`} {
+		if !strings.Contains(output, want) {
+			t.Errorf("JSON output missing %s:\n%s", want, output)
+		}
+	}
+}
+
 func TestListSpecs(t *testing.T) {
 	tmpDir := t.TempDir()
 	specsDir := filepath.Join(tmpDir, "spectr", "specs")
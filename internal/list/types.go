@@ -8,6 +8,21 @@ type ChangeInfo struct {
 	Title      string             `json:"title"`
 	DeltaCount int                `json:"deltaCount"`
 	TaskStatus parsers.TaskStatus `json:"taskStatus"`
+	// Root is the spectr root the change belongs to in workspace mode,
+	// relative to the workspace
+	Root string `json:"root,omitempty"`
+	// State is the lifecycle state: the status from the frontmatter,
+	// or proposed without one
+	State string `json:"state"`
+	// ProposalMetadata holds the proposal frontmatter (owner, status,
+	// labels, ...)
+	parsers.ProposalMetadata
+}
+
+// QualifiedID returns the change ID prefixed with its workspace root,
+// e.g. "services/api:add-auth", or the plain ID outside workspace mode
+func (c ChangeInfo) QualifiedID() string {
+	return qualifyID(c.Root, c.ID)
 }
 
 // SpecInfo represents information about a spec
@@ -15,6 +30,24 @@ type SpecInfo struct {
 	ID               string `json:"id"`
 	Title            string `json:"title"`
 	RequirementCount int    `json:"requirementCount"`
+	// Root is the spectr root the spec belongs to in workspace mode,
+	// relative to the workspace
+	Root string `json:"root,omitempty"`
+}
+
+// QualifiedID returns the spec ID prefixed with its workspace root,
+// e.g. "services/api:auth", or the plain ID outside workspace mode
+func (s SpecInfo) QualifiedID() string {
+	return qualifyID(s.Root, s.ID)
+}
+
+// qualifyID prefixes id with root unless root is empty
+func qualifyID(root, id string) string {
+	if root == "" {
+		return id
+	}
+
+	return root + ":" + id
 }
 
 // ItemType represents the type of an item (change or spec)
@@ -66,6 +99,23 @@ func (i *Item) ID() string {
 	return ""
 }
 
+// QualifiedID returns the identifier prefixed with the item's workspace
+// root, if any
+func (i *Item) QualifiedID() string {
+	switch i.Type {
+	case ItemTypeChange:
+		if i.Change != nil {
+			return i.Change.QualifiedID()
+		}
+	case ItemTypeSpec:
+		if i.Spec != nil {
+			return i.Spec.QualifiedID()
+		}
+	}
+
+	return ""
+}
+
 // Title returns the title for this item
 func (i *Item) Title() string {
 	switch i.Type {
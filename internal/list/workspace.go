@@ -0,0 +1,100 @@
+package list
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+)
+
+// WorkspaceLister lists changes and specs of every spectr root in a
+// workspace. Each item records its root relative to the workspace, so
+// equal IDs in different roots stay apart.
+type WorkspaceLister struct {
+	workspacePath string
+	roots         []string
+}
+
+// NewWorkspaceLister creates a WorkspaceLister for the given roots,
+// typically found with discovery.FindRoots
+func NewWorkspaceLister(workspacePath string, roots []string) *WorkspaceLister {
+	return &WorkspaceLister{workspacePath: workspacePath, roots: roots}
+}
+
+// ListChanges retrieves the active changes of all roots
+func (w *WorkspaceLister) ListChanges() ([]ChangeInfo, error) {
+	var changes []ChangeInfo
+	for _, root := range w.roots {
+		rootChanges, err := NewLister(root).ListChanges()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", root, err)
+		}
+
+		label := discovery.RootLabel(w.workspacePath, root)
+		for i := range rootChanges {
+			rootChanges[i].Root = label
+		}
+		changes = append(changes, rootChanges...)
+	}
+
+	return changes, nil
+}
+
+// ListSpecs retrieves the specs of all roots
+func (w *WorkspaceLister) ListSpecs() ([]SpecInfo, error) {
+	var specs []SpecInfo
+	for _, root := range w.roots {
+		rootSpecs, err := NewLister(root).ListSpecs()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", root, err)
+		}
+
+		label := discovery.RootLabel(w.workspacePath, root)
+		for i := range rootSpecs {
+			rootSpecs[i].Root = label
+		}
+		specs = append(specs, rootSpecs...)
+	}
+
+	return specs, nil
+}
+
+// ListAll retrieves the changes and specs of all roots as a unified
+// ItemList
+func (w *WorkspaceLister) ListAll(opts *ListAllOptions) (ItemList, error) {
+	options := opts
+	if options == nil {
+		options = &ListAllOptions{
+			SortByID: true,
+		}
+	}
+
+	var items ItemList
+	for _, root := range w.roots {
+		rootItems, err := NewLister(root).ListAll(&ListAllOptions{
+			FilterType: options.FilterType,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", root, err)
+		}
+
+		label := discovery.RootLabel(w.workspacePath, root)
+		for _, item := range rootItems {
+			if item.Change != nil {
+				item.Change.Root = label
+			}
+			if item.Spec != nil {
+				item.Spec.Root = label
+			}
+			items = append(items, item)
+		}
+	}
+
+	if options.SortByID {
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].QualifiedID() < items[j].QualifiedID()
+		})
+	}
+
+	return items, nil
+}
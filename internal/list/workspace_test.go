@@ -0,0 +1,67 @@
+package list
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWorkspaceLister(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	var roots []string
+	for _, service := range []string{"api", "billing"} {
+		root := filepath.Join(tmpDir, "services", service)
+		roots = append(roots, root)
+
+		changeDir := filepath.Join(root, "spectr", "changes", "add-logging")
+		if err := os.MkdirAll(changeDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(changeDir, "proposal.md"), []byte("# Add logging"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		specDir := filepath.Join(root, "spectr", "specs", service)
+		if err := os.MkdirAll(specDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(specDir, "spec.md"), []byte("# "+service), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	lister := NewWorkspaceLister(tmpDir, roots)
+
+	changes, err := lister.ListChanges()
+	if err != nil {
+		t.Fatalf("ListChanges failed: %v", err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("Expected 2 changes, got %d", len(changes))
+	}
+	if changes[0].ID != "add-logging" || changes[0].Root != "services/api" {
+		t.Errorf("Unexpected change %+v", changes[0])
+	}
+
+	text := FormatChangesText(changes)
+	for _, id := range []string{"services/api:add-logging", "services/billing:add-logging"} {
+		if !strings.Contains(text, id) {
+			t.Errorf("Expected %q in output:\n%s", id, text)
+		}
+	}
+
+	items, err := lister.ListAll(nil)
+	if err != nil {
+		t.Fatalf("ListAll failed: %v", err)
+	}
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.QualifiedID())
+	}
+	want := "services/api:add-logging services/api:api services/billing:add-logging services/billing:billing"
+	if got := strings.Join(ids, " "); got != want {
+		t.Errorf("Expected items %q, got %q", want, got)
+	}
+}
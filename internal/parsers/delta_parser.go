@@ -17,13 +17,26 @@ type DeltaPlan struct {
 	Added    []RequirementBlock
 	Modified []RequirementBlock
 	Removed  []string // Just requirement names
-	Renamed  []RenameOp
+	// RemovedIDs maps removed requirement names to their stable IDs,
+	// for entries whose header carries one
+	RemovedIDs map[string]string
+	Renamed    []RenameOp
+	// AddedPlacements maps ADDED requirement names to their placement
+	// hints, for entries that declare one
+	AddedPlacements map[string]Placement
+
+	// Scenario-level operations on existing requirements
+	AddedScenarios    []ScenarioDelta
+	ModifiedScenarios []ScenarioDelta
+	RemovedScenarios  []ScenarioDelta
 }
 
 // RenameOp represents a requirement rename operation
 type RenameOp struct {
 	From string
 	To   string
+	// ID is the stable ID given on the FROM or TO line, if any
+	ID string
 }
 
 // ParseDeltaSpec parses a delta spec file and extracts operations
@@ -36,10 +49,11 @@ func ParseDeltaSpec(filePath string) (*DeltaPlan, error) {
 	defer func() { _ = file.Close() }()
 
 	plan := &DeltaPlan{
-		Added:    make([]RequirementBlock, 0),
-		Modified: make([]RequirementBlock, 0),
-		Removed:  make([]string, 0),
-		Renamed:  make([]RenameOp, 0),
+		Added:      make([]RequirementBlock, 0),
+		Modified:   make([]RequirementBlock, 0),
+		Removed:    make([]string, 0),
+		RemovedIDs: make(map[string]string),
+		Renamed:    make([]RenameOp, 0),
 	}
 
 	content, err := os.ReadFile(filePath)
@@ -48,10 +62,13 @@ func ParseDeltaSpec(filePath string) (*DeltaPlan, error) {
 	}
 
 	// Parse each section
-	plan.Added = parseDeltaSection(string(content), "ADDED")
+	plan.Added, plan.AddedPlacements = parseAddedSection(string(content))
 	plan.Modified = parseDeltaSection(string(content), "MODIFIED")
-	plan.Removed = parseRemovedSection(string(content))
+	plan.Removed, plan.RemovedIDs = parseRemovedSection(string(content))
 	plan.Renamed = parseRenamedSection(string(content))
+	plan.AddedScenarios = parseScenarioDeltaSection(string(content), "ADDED")
+	plan.ModifiedScenarios = parseScenarioDeltaSection(string(content), "MODIFIED")
+	plan.RemovedScenarios = parseScenarioDeltaSection(string(content), "REMOVED")
 
 	return plan, nil
 }
@@ -66,9 +83,32 @@ func parseDeltaSection(content, sectionType string) []RequirementBlock {
 	return parseRequirementsFromSection(sectionContent)
 }
 
+// parseAddedSection extracts ADDED requirements, moving their placement
+// hints out of the requirement content
+func parseAddedSection(content string) ([]RequirementBlock, map[string]Placement) {
+	added := parseDeltaSection(content, "ADDED")
+	placements := make(map[string]Placement)
+	for i := range added {
+		placement, raw := extractPlacement(added[i].Raw)
+		if placement.IsZero() {
+			continue
+		}
+		added[i].Raw = raw
+		placements[added[i].Name] = placement
+	}
+
+	return added, placements
+}
+
 // extractSectionContent extracts content from a section header
 func extractSectionContent(content, sectionType string) string {
-	pattern := fmt.Sprintf(`(?m)^##\s+%s\s+Requirements\s*$`, sectionType)
+	return extractNamedSection(content, sectionType, "Requirements")
+}
+
+// extractNamedSection extracts the content of a "## <sectionType> <kind>"
+// section, e.g. "## ADDED Scenarios"
+func extractNamedSection(content, sectionType, kind string) string {
+	pattern := fmt.Sprintf(`(?m)^##\s+%s\s+%s\s*$`, sectionType, kind)
 	sectionPattern := regexp.MustCompile(pattern)
 	matches := sectionPattern.FindStringIndex(content)
 	if matches == nil {
@@ -141,9 +181,12 @@ func saveAndStartNewRequirement(
 		*requirements = append(*requirements, *currentReq)
 	}
 
+	name, id := SplitRequirementID(name)
+
 	return &RequirementBlock{
 		HeaderLine: line,
-		Name:       strings.TrimSpace(name),
+		Name:       name,
+		ID:         id,
 		Raw:        line + "\n",
 	}
 }
@@ -175,15 +218,17 @@ func appendLineToRequirement(currentReq *RequirementBlock, line string) {
 	}
 }
 
-// parseRemovedSection extracts requirement names from REMOVED section
-func parseRemovedSection(content string) []string {
+// parseRemovedSection extracts requirement names from REMOVED section,
+// along with the stable IDs of those that declare one
+func parseRemovedSection(content string) ([]string, map[string]string) {
 	var removed []string
+	ids := make(map[string]string)
 
 	// Find the REMOVED section header
 	sectionPattern := regexp.MustCompile(`(?m)^##\s+REMOVED\s+Requirements\s*$`)
 	matches := sectionPattern.FindStringIndex(content)
 	if matches == nil {
-		return removed
+		return removed, ids
 	}
 
 	// Extract content from this section until next ## header or end of file
@@ -205,11 +250,15 @@ func parseRemovedSection(content string) []string {
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
 		if matches := reqPattern.FindStringSubmatch(line); len(matches) > 1 {
-			removed = append(removed, strings.TrimSpace(matches[1]))
+			name, id := SplitRequirementID(matches[1])
+			removed = append(removed, name)
+			if id != "" {
+				ids[name] = id
+			}
 		}
 	}
 
-	return removed
+	return removed, ids
 }
 
 // parseRenamedSection extracts FROM/TO pairs from RENAMED section
@@ -246,14 +295,14 @@ func parseRenamedSection(content string) []RenameOp {
 		`^-\s*TO:\s*` + "`" + `###\s+Requirement:\s*(.+?)` + "`" + `\s*$`,
 	)
 
-	var currentFrom string
+	var currentFrom, currentID string
 	scanner := bufio.NewScanner(strings.NewReader(sectionContent))
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
 
 		// Check for FROM line
 		if matches := fromPattern.FindStringSubmatch(line); len(matches) > 1 {
-			currentFrom = strings.TrimSpace(matches[1])
+			currentFrom, currentID = SplitRequirementID(matches[1])
 
 			continue
 		}
@@ -264,11 +313,16 @@ func parseRenamedSection(content string) []RenameOp {
 			continue
 		}
 
+		to, toID := SplitRequirementID(matches[1])
+		if currentID == "" {
+			currentID = toID
+		}
 		renamed = append(renamed, RenameOp{
 			From: currentFrom,
-			To:   strings.TrimSpace(matches[1]),
+			To:   to,
+			ID:   currentID,
 		})
-		currentFrom = ""
+		currentFrom, currentID = "", ""
 	}
 
 	return renamed
@@ -281,10 +335,22 @@ func (dp *DeltaPlan) HasDeltas() bool {
 	hasRemoved := len(dp.Removed) > 0
 	hasRenamed := len(dp.Renamed) > 0
 
-	return hasAdded || hasModified || hasRemoved || hasRenamed
+	return hasAdded || hasModified || hasRemoved || hasRenamed ||
+		dp.HasScenarioDeltas()
+}
+
+// HasScenarioDeltas returns true if the DeltaPlan changes individual
+// scenarios of existing requirements
+func (dp *DeltaPlan) HasScenarioDeltas() bool {
+	return len(dp.AddedScenarios) > 0 || len(dp.ModifiedScenarios) > 0 ||
+		len(dp.RemovedScenarios) > 0
 }
 
-// CountOperations returns the total number of delta operations
+// CountOperations returns the total number of delta operations, counting
+// each added, modified or removed scenario as one operation
 func (dp *DeltaPlan) CountOperations() int {
-	return len(dp.Added) + len(dp.Modified) + len(dp.Removed) + len(dp.Renamed)
+	return len(dp.Added) + len(dp.Modified) + len(dp.Removed) + len(dp.Renamed) +
+		CountScenarios(dp.AddedScenarios) +
+		CountScenarios(dp.ModifiedScenarios) +
+		CountScenarios(dp.RemovedScenarios)
 }
@@ -331,3 +331,51 @@ func TestParseDeltaSpec_MissingFile(t *testing.T) {
 		t.Error("Expected error for missing file, got nil")
 	}
 }
+
+func TestParseDeltaSpec_RequirementIDs(t *testing.T) {
+	content := `# Delta Spec
+
+## MODIFIED Requirements
+
+### Requirement: Sign In {#AUTH-001}
+The system SHALL sign users in.
+
+#### Scenario: Valid credentials
+- **WHEN** credentials are valid
+- **THEN** the user is signed in
+
+## REMOVED Requirements
+
+### Requirement: Legacy Login <!-- id: AUTH-004 -->
+
+## RENAMED Requirements
+
+- FROM: ` + "`" + `### Requirement: Logout {#AUTH-002}` + "`" + `
+- TO: ` + "`" + `### Requirement: Sign Out` + "`" + `
+`
+
+	tmpDir := t.TempDir()
+	filePath := filepath.Join(tmpDir, "spec.md")
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := ParseDeltaSpec(filePath)
+	if err != nil {
+		t.Fatalf("ParseDeltaSpec failed: %v", err)
+	}
+
+	if len(plan.Modified) != 1 || plan.Modified[0].Name != "Sign In" ||
+		plan.Modified[0].ID != "AUTH-001" {
+		t.Errorf("Expected MODIFIED 'Sign In' with ID AUTH-001, got %+v", plan.Modified)
+	}
+	if len(plan.Removed) != 1 || plan.Removed[0] != "Legacy Login" ||
+		plan.RemovedIDs["Legacy Login"] != "AUTH-004" {
+		t.Errorf("Expected REMOVED 'Legacy Login' with ID AUTH-004, got %v %v",
+			plan.Removed, plan.RemovedIDs)
+	}
+	if len(plan.Renamed) != 1 || plan.Renamed[0].From != "Logout" ||
+		plan.Renamed[0].To != "Sign Out" || plan.Renamed[0].ID != "AUTH-002" {
+		t.Errorf("Expected RENAMED Logout -> Sign Out with ID AUTH-002, got %+v", plan.Renamed)
+	}
+}
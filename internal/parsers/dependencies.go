@@ -0,0 +1,77 @@
+package parsers
+
+import (
+	"os"
+	"strings"
+)
+
+// A proposal declares the changes it depends on in its frontmatter:
+//
+//	---
+//	depends_on: [add-auth, add-sessions]
+//	---
+//
+// or in a "## Depends On" section listing one change ID per bullet:
+//
+//	## Depends On
+//	- `add-auth`: MODIFIES the login requirement it adds
+
+// DependsOnField is the frontmatter field listing change dependencies
+const DependsOnField = "depends_on"
+
+// ParseDependencies returns the IDs of the changes the proposal at
+// proposalPath depends on, in declaration order and without duplicates
+func ParseDependencies(proposalPath string) ([]string, error) {
+	content, err := os.ReadFile(proposalPath)
+	if err != nil {
+		return nil, err
+	}
+
+	frontmatter, body, err := ParseFrontmatter(string(content))
+	if err != nil {
+		return nil, err
+	}
+
+	var deps []string
+	seen := make(map[string]bool)
+	add := func(id string) {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			deps = append(deps, id)
+		}
+	}
+
+	for _, id := range frontmatter.List(DependsOnField) {
+		add(id)
+	}
+
+	inSection := false
+	for _, line := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "## ") {
+			inSection = strings.EqualFold(
+				strings.TrimSpace(strings.TrimPrefix(trimmed, "## ")),
+				"Depends On",
+			)
+
+			continue
+		}
+		if !inSection {
+			continue
+		}
+
+		item, ok := strings.CutPrefix(trimmed, "- ")
+		if !ok {
+			item, ok = strings.CutPrefix(trimmed, "* ")
+		}
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(item)
+		if len(fields) > 0 {
+			add(strings.Trim(fields[0], "`*:,"))
+		}
+	}
+
+	return deps, nil
+}
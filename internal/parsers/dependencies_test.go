@@ -0,0 +1,28 @@
+package parsers
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseDependencies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proposal.md")
+	content := "---\ndepends_on: [add-auth]\n---\n# Change: Sessions\n\n" +
+		"## Why\nSessions need auth.\n\n" +
+		"## Depends On\n- `add-sessions`: MODIFIES its requirement\n- add-auth\n* add-keys — later\n\n" +
+		"## Impact\n- not-a-dependency\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	deps, err := ParseDependencies(path)
+	if err != nil {
+		t.Fatalf("ParseDependencies failed: %v", err)
+	}
+	want := []string{"add-auth", "add-sessions", "add-keys"}
+	if !reflect.DeepEqual(deps, want) {
+		t.Errorf("ParseDependencies() = %v, want %v", deps, want)
+	}
+}
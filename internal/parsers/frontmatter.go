@@ -0,0 +1,157 @@
+package parsers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Frontmatter holds the fields of a YAML frontmatter block. Values are
+// strings, or []string for lists.
+type Frontmatter map[string]any
+
+// SplitFrontmatter separates a leading frontmatter block delimited by
+// "---" lines from the rest of content. ok is false when content does
+// not start with a complete block.
+func SplitFrontmatter(content string) (frontmatter, body string, ok bool) {
+	rest, found := strings.CutPrefix(content, "---\n")
+	if !found {
+		rest, found = strings.CutPrefix(content, "---\r\n")
+	}
+	if !found {
+		return "", content, false
+	}
+
+	lines := strings.SplitAfter(rest, "\n")
+	for i, line := range lines {
+		if strings.TrimRight(line, "\r\n") == "---" {
+			return strings.Join(lines[:i], ""), strings.Join(lines[i+1:], ""), true
+		}
+	}
+
+	return "", content, false
+}
+
+// ParseFrontmatter parses the frontmatter at the start of content and
+// returns it with the remaining body. Only the subset of YAML used by
+// spectr is supported: "key: value" scalars, inline lists ("key: [a,
+// b]") and block lists of "- item" lines. Content without frontmatter
+// yields an empty Frontmatter.
+func ParseFrontmatter(content string) (Frontmatter, string, error) {
+	block, body, ok := SplitFrontmatter(content)
+	fields := make(Frontmatter)
+	if !ok {
+		return fields, content, nil
+	}
+
+	var listKey string
+	for i, line := range strings.Split(block, "\n") {
+		line = strings.TrimRight(line, "\r")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		if item, isItem := strings.CutPrefix(trimmed, "- "); isItem || trimmed == "-" {
+			if listKey == "" {
+				return nil, "", fmt.Errorf("frontmatter line %d: list item without a key", i+2)
+			}
+			list, _ := fields[listKey].([]string)
+			fields[listKey] = append(list, unquoteFrontmatter(item))
+
+			continue
+		}
+
+		key, value, found := strings.Cut(trimmed, ":")
+		if !found || line != trimmed {
+			return nil, "", fmt.Errorf("frontmatter line %d: expected 'key: value'", i+2)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		switch {
+		case value == "":
+			listKey = key
+			fields[key] = []string{}
+		case strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]"):
+			listKey = ""
+			items := []string{}
+			for _, item := range strings.Split(value[1:len(value)-1], ",") {
+				if item = unquoteFrontmatter(item); item != "" {
+					items = append(items, item)
+				}
+			}
+			fields[key] = items
+		default:
+			listKey = ""
+			fields[key] = unquoteFrontmatter(value)
+		}
+	}
+
+	return fields, body, nil
+}
+
+// unquoteFrontmatter trims a value and removes surrounding quotes
+func unquoteFrontmatter(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') &&
+		value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+
+	return value
+}
+
+// String returns the scalar value of key, or "" if it is missing or a list
+func (f Frontmatter) String(key string) string {
+	value, _ := f[key].(string)
+
+	return value
+}
+
+// List returns the list value of key. A scalar is returned as a
+// single-element list.
+func (f Frontmatter) List(key string) []string {
+	switch value := f[key].(type) {
+	case []string:
+		return value
+	case string:
+		if value == "" {
+			return nil
+		}
+
+		return []string{value}
+	default:
+		return nil
+	}
+}
+
+// SetFrontmatterField sets a scalar field in the frontmatter at the
+// start of content, replacing an existing value, appending the field
+// to the block, or adding a block if content has none
+func SetFrontmatterField(content, key, value string) string {
+	line := key + ": " + value
+	block, body, ok := SplitFrontmatter(content)
+	if !ok {
+		return "---\n" + line + "\n---\n" + content
+	}
+
+	lines := strings.Split(strings.TrimSuffix(block, "\n"), "\n")
+	replaced := false
+	for i, existing := range lines {
+		name, _, found := strings.Cut(existing, ":")
+		if found && strings.TrimSpace(name) == key && existing == strings.TrimLeft(existing, " \t") {
+			lines[i] = line
+			replaced = true
+
+			break
+		}
+	}
+	if !replaced {
+		if block == "" {
+			lines = nil
+		}
+		lines = append(lines, line)
+	}
+
+	return "---\n" + strings.Join(lines, "\n") + "\n---\n" + body
+}
@@ -0,0 +1,95 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFrontmatter(t *testing.T) {
+	content := `---
+owner: "alice"
+depends_on: [add-auth, 'add-sessions']
+labels:
+  - api
+  - security
+# comment
+empty:
+---
+# Change: Title
+`
+
+	fields, body, err := ParseFrontmatter(content)
+	if err != nil {
+		t.Fatalf("ParseFrontmatter failed: %v", err)
+	}
+	if body != "# Change: Title\n" {
+		t.Errorf("Unexpected body %q", body)
+	}
+	if fields.String("owner") != "alice" {
+		t.Errorf("owner = %q", fields.String("owner"))
+	}
+	if got := fields.List("depends_on"); !reflect.DeepEqual(got, []string{"add-auth", "add-sessions"}) {
+		t.Errorf("depends_on = %v", got)
+	}
+	if got := fields.List("labels"); !reflect.DeepEqual(got, []string{"api", "security"}) {
+		t.Errorf("labels = %v", got)
+	}
+	if got := fields.List("owner"); !reflect.DeepEqual(got, []string{"alice"}) {
+		t.Errorf("scalar as list = %v", got)
+	}
+	if got := fields.List("empty"); len(got) != 0 {
+		t.Errorf("empty = %v", got)
+	}
+}
+
+func TestParseFrontmatter_NoBlock(t *testing.T) {
+	for _, content := range []string{"# Title\n", "---\nowner: a\n"} {
+		fields, body, err := ParseFrontmatter(content)
+		if err != nil || len(fields) != 0 || body != content {
+			t.Errorf("ParseFrontmatter(%q) = %v, %q, %v", content, fields, body, err)
+		}
+	}
+}
+
+func TestParseFrontmatter_Invalid(t *testing.T) {
+	for _, content := range []string{
+		"---\n- orphan\n---\n",
+		"---\nnot a field\n---\n",
+	} {
+		if _, _, err := ParseFrontmatter(content); err == nil {
+			t.Errorf("Expected error for %q", content)
+		}
+	}
+}
+
+func TestSetFrontmatterField(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			"replace",
+			"---\nowner: alice\nstatus: draft\n---\n# Title\n",
+			"---\nowner: alice\nstatus: approved\n---\n# Title\n",
+		},
+		{
+			"append",
+			"---\nowner: alice\n---\n# Title\n",
+			"---\nowner: alice\nstatus: approved\n---\n# Title\n",
+		},
+		{
+			"add block",
+			"# Title\n",
+			"---\nstatus: approved\n---\n# Title\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SetFrontmatterField(tt.content, "status", "approved"); got != tt.want {
+				t.Errorf("SetFrontmatterField() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package parsers
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Requirements reference each other across capabilities with links:
+//
+//	Sessions expire as defined in [[auth#Session Timeout]].
+//
+// The target is a capability ID followed by a requirement name or
+// stable ID ([[auth#AUTH-003]]). An empty capability ([[#Session
+// Timeout]]) refers to the spec containing the link. Links inside
+// fenced code blocks are ignored.
+
+// RequirementLink is a link to a requirement of a capability
+type RequirementLink struct {
+	// Capability is the target capability, empty for the containing spec
+	Capability string `json:"capability,omitempty"`
+	// Requirement is the target requirement name or stable ID
+	Requirement string `json:"requirement"`
+	// Line is the 1-based line of the link
+	Line int `json:"line"`
+	// Source is the name of the requirement containing the link, if any
+	Source string `json:"source,omitempty"`
+}
+
+// TargetCapability returns the capability the link points to, given
+// the capability of the spec containing it
+func (l RequirementLink) TargetCapability(self string) string {
+	if l.Capability == "" {
+		return self
+	}
+
+	return l.Capability
+}
+
+// String returns the link in its Markdown form
+func (l RequirementLink) String() string {
+	return "[[" + l.Capability + "#" + l.Requirement + "]]"
+}
+
+var (
+	requirementLinkPattern = regexp.MustCompile(`\[\[([^\[\]#]*)#([^\[\]]+)\]\]`)
+	linkRequirementHeader  = regexp.MustCompile(`^###\s+Requirement:\s*(.+)$`)
+)
+
+// ExtractRequirementLinks returns the requirement links in content in
+// the order they appear
+func ExtractRequirementLinks(content string) []RequirementLink {
+	var links []RequirementLink
+	var source string
+
+	forEachLinkLine(content, func(i int, line string) string {
+		trimmed := strings.TrimSpace(line)
+		if matches := linkRequirementHeader.FindStringSubmatch(trimmed); matches != nil {
+			source, _ = SplitRequirementID(matches[1])
+
+			return line
+		}
+		if strings.HasPrefix(trimmed, "## ") || IsRequirementGroupHeading(trimmed) {
+			source = ""
+		}
+
+		for _, matches := range requirementLinkPattern.FindAllStringSubmatch(line, -1) {
+			links = append(links, RequirementLink{
+				Capability:  strings.TrimSpace(matches[1]),
+				Requirement: strings.TrimSpace(matches[2]),
+				Line:        i + 1,
+				Source:      source,
+			})
+		}
+
+		return line
+	})
+
+	return links
+}
+
+// RewriteRequirementLinks points links to requirement oldName of
+// capability at newName instead. self is the capability of the spec in
+// content, which links without a capability refer to. It returns the
+// rewritten content and the number of links changed.
+func RewriteRequirementLinks(
+	content, self, capability, oldName, newName string,
+) (string, int) {
+	target := NormalizeRequirementName(oldName)
+	count := 0
+
+	rewritten := forEachLinkLine(content, func(_ int, line string) string {
+		return requirementLinkPattern.ReplaceAllStringFunc(line, func(match string) string {
+			matches := requirementLinkPattern.FindStringSubmatch(match)
+			link := RequirementLink{Capability: strings.TrimSpace(matches[1])}
+			if link.TargetCapability(self) != capability ||
+				NormalizeRequirementName(matches[2]) != target {
+				return match
+			}
+			count++
+
+			return "[[" + matches[1] + "#" + newName + "]]"
+		})
+	})
+
+	return rewritten, count
+}
+
+// forEachLinkLine calls fn for every line outside fenced code blocks,
+// replacing the line with its result, and returns the joined content
+func forEachLinkLine(content string, fn func(i int, line string) string) string {
+	lines := strings.Split(content, "\n")
+	inFence := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+
+			continue
+		}
+		if inFence {
+			continue
+		}
+		lines[i] = fn(i, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,52 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractRequirementLinks(t *testing.T) {
+	content := `## Requirements
+
+See [[validation#Spec File Validation]] first.
+
+### Requirement: Session Timeout {#AUTH-002}
+Sessions SHALL expire as defined in [[#Session Limits]] and [[api/auth#AUTH-001]].
+
+` + "```" + `
+[[ignored#In Code]]
+` + "```" + `
+
+## Notes
+Plain [[ auth # Login ]] text.`
+
+	want := []RequirementLink{
+		{Capability: "validation", Requirement: "Spec File Validation", Line: 3},
+		{Requirement: "Session Limits", Line: 6, Source: "Session Timeout"},
+		{Capability: "api/auth", Requirement: "AUTH-001", Line: 6, Source: "Session Timeout"},
+		{Capability: "auth", Requirement: "Login", Line: 13},
+	}
+
+	got := ExtractRequirementLinks(content)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExtractRequirementLinks() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestRewriteRequirementLinks(t *testing.T) {
+	content := "Use [[auth#user login]], [[#User Login]], [[billing#User Login]] and [[auth#Logout]].\n" +
+		"```\n[[auth#User Login]]\n```"
+
+	got, n := RewriteRequirementLinks(content, "auth", "auth", "User Login", "Sign In")
+	want := "Use [[auth#Sign In]], [[#Sign In]], [[billing#User Login]] and [[auth#Logout]].\n" +
+		"```\n[[auth#User Login]]\n```"
+	if n != 2 || got != want {
+		t.Errorf("RewriteRequirementLinks() = %q, %d; want %q, 2", got, n, want)
+	}
+
+	// From another capability, the unqualified link is not affected
+	got, n = RewriteRequirementLinks(content, "billing", "auth", "User Login", "Sign In")
+	if n != 1 {
+		t.Errorf("Expected 1 rewrite from billing, got %d: %q", n, got)
+	}
+}
@@ -0,0 +1,173 @@
+package parsers
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"time"
+)
+
+// Proposal frontmatter fields
+const (
+	OwnerField         = "owner"
+	StatusField        = "status"
+	PriorityField      = "priority"
+	LabelsField        = "labels"
+	TargetReleaseField = "target_release"
+	CreatedField       = "created"
+	IssueField         = "issue"
+)
+
+// CreatedLayout is the date format of the created field
+const CreatedLayout = "2006-01-02"
+
+// ProposalStatuses are the values allowed for the status field
+var ProposalStatuses = []string{
+	"draft",
+	"proposed",
+	"approved",
+	"in-progress",
+	"ready-to-archive",
+}
+
+// ProposalPriorities are the values allowed for the priority field
+var ProposalPriorities = []string{"low", "medium", "high", "critical"}
+
+// ProposalMetadata is the frontmatter of a proposal.md:
+//
+//	---
+//	owner: "@alice"
+//	status: approved
+//	priority: high
+//	labels: [auth, security]
+//	target_release: v1.4
+//	created: 2025-01-15
+//	issue: https://github.com/org/repo/issues/42
+//	---
+type ProposalMetadata struct {
+	Owner         string   `json:"owner,omitempty"`
+	Status        string   `json:"status,omitempty"`
+	Priority      string   `json:"priority,omitempty"`
+	Labels        []string `json:"labels,omitempty"`
+	TargetRelease string   `json:"targetRelease,omitempty"`
+	Created       string   `json:"created,omitempty"`
+	Issue         string   `json:"issue,omitempty"`
+}
+
+// ParseProposalMetadata reads the frontmatter metadata of the proposal
+// at proposalPath. A proposal without frontmatter has empty metadata.
+func ParseProposalMetadata(proposalPath string) (ProposalMetadata, error) {
+	content, err := os.ReadFile(proposalPath)
+	if err != nil {
+		return ProposalMetadata{}, err
+	}
+
+	frontmatter, _, err := ParseFrontmatter(string(content))
+	if err != nil {
+		return ProposalMetadata{}, err
+	}
+
+	return NewProposalMetadata(frontmatter), nil
+}
+
+// NewProposalMetadata extracts the metadata fields from frontmatter
+func NewProposalMetadata(frontmatter Frontmatter) ProposalMetadata {
+	return ProposalMetadata{
+		Owner:         frontmatter.String(OwnerField),
+		Status:        frontmatter.String(StatusField),
+		Priority:      frontmatter.String(PriorityField),
+		Labels:        frontmatter.List(LabelsField),
+		TargetRelease: frontmatter.String(TargetReleaseField),
+		Created:       frontmatter.String(CreatedField),
+		Issue:         frontmatter.String(IssueField),
+	}
+}
+
+// FrontmatterFieldError is a schema violation of a frontmatter field
+type FrontmatterFieldError struct {
+	Field   string
+	Message string
+	// Unknown is set for fields that are not part of the schema
+	Unknown bool
+}
+
+func (e FrontmatterFieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// scalarFields are the proposal fields that take a single value
+var scalarFields = []string{
+	OwnerField,
+	StatusField,
+	PriorityField,
+	TargetReleaseField,
+	CreatedField,
+	IssueField,
+}
+
+// listFields are the proposal fields that take a list of values
+var listFields = []string{LabelsField, DependsOnField}
+
+// CheckProposalFrontmatter validates proposal frontmatter against the
+// schema and returns the violations sorted by field
+func CheckProposalFrontmatter(frontmatter Frontmatter) []FrontmatterFieldError {
+	var errs []FrontmatterFieldError
+	fields := make([]string, 0, len(frontmatter))
+	for field := range frontmatter {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	for _, field := range fields {
+		switch {
+		case slices.Contains(scalarFields, field):
+			if _, ok := frontmatter[field].(string); !ok {
+				errs = append(errs, FrontmatterFieldError{
+					Field:   field,
+					Message: "must be a single value, not a list",
+				})
+			}
+		case slices.Contains(listFields, field):
+			for _, item := range frontmatter.List(field) {
+				if item == "" {
+					errs = append(errs, FrontmatterFieldError{
+						Field:   field,
+						Message: "must not contain empty items",
+					})
+
+					break
+				}
+			}
+		default:
+			errs = append(errs, FrontmatterFieldError{
+				Field:   field,
+				Message: "is not a known proposal field",
+				Unknown: true,
+			})
+		}
+	}
+
+	metadata := NewProposalMetadata(frontmatter)
+	if metadata.Status != "" && !slices.Contains(ProposalStatuses, metadata.Status) {
+		errs = append(errs, FrontmatterFieldError{
+			Field:   StatusField,
+			Message: fmt.Sprintf("%q is not one of %v", metadata.Status, ProposalStatuses),
+		})
+	}
+	if metadata.Priority != "" && !slices.Contains(ProposalPriorities, metadata.Priority) {
+		errs = append(errs, FrontmatterFieldError{
+			Field:   PriorityField,
+			Message: fmt.Sprintf("%q is not one of %v", metadata.Priority, ProposalPriorities),
+		})
+	}
+	if metadata.Created != "" {
+		if _, err := time.Parse(CreatedLayout, metadata.Created); err != nil {
+			errs = append(errs, FrontmatterFieldError{
+				Field:   CreatedField,
+				Message: fmt.Sprintf("%q is not a date in YYYY-MM-DD format", metadata.Created),
+			})
+		}
+	}
+
+	return errs
+}
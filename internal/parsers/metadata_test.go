@@ -0,0 +1,73 @@
+package parsers
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseProposalMetadata(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proposal.md")
+	content := `---
+owner: "@alice"
+status: approved
+priority: high
+labels: [auth, security]
+target_release: v1.4
+created: 2025-01-15
+issue: https://example.com/issues/42
+---
+# Change: Add auth
+`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	metadata, err := ParseProposalMetadata(path)
+	if err != nil {
+		t.Fatalf("ParseProposalMetadata failed: %v", err)
+	}
+	want := ProposalMetadata{
+		Owner:         "@alice",
+		Status:        "approved",
+		Priority:      "high",
+		Labels:        []string{"auth", "security"},
+		TargetRelease: "v1.4",
+		Created:       "2025-01-15",
+		Issue:         "https://example.com/issues/42",
+	}
+	if !reflect.DeepEqual(metadata, want) {
+		t.Errorf("ParseProposalMetadata() = %+v, want %+v", metadata, want)
+	}
+}
+
+func TestCheckProposalFrontmatter(t *testing.T) {
+	frontmatter := Frontmatter{
+		"owner":      []string{"alice", "bob"},
+		"status":     "done",
+		"priority":   "urgent",
+		"created":    "15/01/2025",
+		"labels":     "auth",
+		"depends_on": []string{"add-auth"},
+		"reviewer":   "carol",
+	}
+
+	var got []string
+	unknown := 0
+	for _, err := range CheckProposalFrontmatter(frontmatter) {
+		got = append(got, err.Field)
+		if err.Unknown {
+			unknown++
+		}
+	}
+
+	want := []string{"owner", "reviewer", "status", "priority", "created"}
+	if !reflect.DeepEqual(got, want) || unknown != 1 {
+		t.Errorf("CheckProposalFrontmatter() fields = %v (%d unknown), want %v (1 unknown)", got, unknown, want)
+	}
+
+	if errs := CheckProposalFrontmatter(Frontmatter{"status": "draft"}); len(errs) != 0 {
+		t.Errorf("Expected valid frontmatter, got %v", errs)
+	}
+}
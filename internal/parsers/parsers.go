@@ -11,15 +11,16 @@ import (
 )
 
 // ExtractTitle extracts the title from a markdown file by finding
-// the first H1 heading and removing "Change:" or "Spec:" prefix if present
+// the first H1 heading and removing "Change:" or "Spec:" prefix if present.
+// A leading frontmatter block is skipped.
 func ExtractTitle(filePath string) (string, error) {
-	file, err := os.Open(filePath)
+	content, err := os.ReadFile(filePath)
 	if err != nil {
 		return "", err
 	}
-	defer func() { _ = file.Close() }()
+	_, body, _ := SplitFrontmatter(string(content))
 
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(strings.NewReader(body))
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
 		// Look for H1 heading (# Title)
@@ -46,35 +47,16 @@ type TaskStatus struct {
 	Completed int `json:"completed"`
 }
 
-// CountTasks counts tasks in tasks.md, identifying completed vs total
+// CountTasks counts tasks in tasks.md, identifying completed vs total.
+// Nested tasks are counted as well.
 func CountTasks(filePath string) (TaskStatus, error) {
-	status := TaskStatus{Total: 0, Completed: 0}
-
-	file, err := os.Open(filePath)
+	list, err := ParseTasksFile(filePath)
 	if err != nil {
 		// Return zero status if file doesn't exist or can't be read
-		return status, nil
-	}
-	defer func() { _ = file.Close() }()
-
-	// Regex to match task lines: - [ ] or - [x] (case-insensitive)
-	taskPattern := regexp.MustCompile(`^\s*-\s*\[([xX ])\]`)
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
-		matches := taskPattern.FindStringSubmatch(line)
-		if len(matches) <= 1 {
-			continue
-		}
-		status.Total++
-		marker := strings.ToLower(strings.TrimSpace(matches[1]))
-		if marker == "x" {
-			status.Completed++
-		}
+		return TaskStatus{Total: 0, Completed: 0}, nil
 	}
 
-	return status, scanner.Err()
+	return list.Status(), nil
 }
 
 // CountDeltas counts the number of delta sections
@@ -37,6 +37,11 @@ func TestExtractTitle(t *testing.T) {
 			content:  "#   Change:   Trim Whitespace   \n\nMore content",
 			expected: "Trim Whitespace",
 		},
+		{
+			name:     "Frontmatter",
+			content:  "---\nowner: alice\n# not a title\n---\n# Change: After Frontmatter\n",
+			expected: "After Frontmatter",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,109 @@
+package parsers
+
+import (
+	"bufio"
+	"regexp"
+	"strings"
+)
+
+// ADDED requirements are appended to the end of the Requirements section
+// unless they carry a placement hint directly in their block:
+//
+//	### Requirement: Session Timeout
+//	<!-- after: User Logout -->
+//
+// "after:" and "before:" name a requirement (or its stable ID); "group:"
+// names a "###" grouping heading, and the requirement is added at the
+// end of that group.
+
+// Placement positions an ADDED requirement within the Requirements section
+type Placement struct {
+	After  string
+	Before string
+	Group  string
+}
+
+// IsZero reports whether the placement has no hint
+func (p Placement) IsZero() bool {
+	return p == Placement{}
+}
+
+// Target returns the requirement or group the placement refers to
+func (p Placement) Target() string {
+	switch {
+	case p.After != "":
+		return p.After
+	case p.Before != "":
+		return p.Before
+	default:
+		return p.Group
+	}
+}
+
+var (
+	placementHintPattern = regexp.MustCompile(
+		`(?i)^\s*<!--\s*(after|before|group):\s*(.+?)\s*-->\s*$`,
+	)
+	h3HeadingPattern = regexp.MustCompile(`^###\s+(.+?)\s*$`)
+)
+
+// extractPlacement removes the first placement hint from a requirement
+// block and returns it with the remaining block
+func extractPlacement(raw string) (Placement, string) {
+	var placement Placement
+	var kept strings.Builder
+
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		matches := placementHintPattern.FindStringSubmatch(line)
+		if matches == nil || !placement.IsZero() {
+			kept.WriteString(line + "\n")
+
+			continue
+		}
+
+		switch strings.ToLower(matches[1]) {
+		case "after":
+			placement.After = matches[2]
+		case "before":
+			placement.Before = matches[2]
+		default:
+			placement.Group = matches[2]
+		}
+	}
+
+	return placement, kept.String()
+}
+
+// IsRequirementGroupHeading reports whether line is a "###" heading that
+// groups requirements rather than a requirement header
+func IsRequirementGroupHeading(line string) bool {
+	return h3HeadingPattern.MatchString(line) &&
+		!strings.HasPrefix(strings.TrimSpace(line), "### Requirement:")
+}
+
+// RequirementGroups returns the names of the "###" grouping headings in
+// the Requirements section of spec content, in document order
+func RequirementGroups(content string) []string {
+	var groups []string
+	inRequirements := false
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.HasPrefix(line, "## ") {
+			inRequirements = strings.TrimSpace(line) == "## Requirements"
+
+			continue
+		}
+
+		if inRequirements && IsRequirementGroupHeading(line) {
+			groups = append(groups, h3HeadingPattern.FindStringSubmatch(line)[1])
+		}
+	}
+
+	return groups
+}
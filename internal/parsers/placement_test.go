@@ -0,0 +1,123 @@
+package parsers
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseDeltaSpec_AddedPlacements(t *testing.T) {
+	content := `## ADDED Requirements
+
+### Requirement: Session Timeout
+<!-- after: User Login -->
+The system SHALL expire idle sessions.
+
+#### Scenario: Idle
+- **WHEN** a session is idle
+- **THEN** it expires
+
+### Requirement: Token Issue
+<!-- Before: AUTH-003 -->
+The system SHALL issue tokens.
+
+### Requirement: Audit
+<!-- group: Compliance -->
+<!-- after: Token Issue -->
+The system SHALL audit logins.
+
+### Requirement: Unplaced
+The system SHALL do something.
+`
+
+	filePath := filepath.Join(t.TempDir(), "spec.md")
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := ParseDeltaSpec(filePath)
+	if err != nil {
+		t.Fatalf("ParseDeltaSpec failed: %v", err)
+	}
+
+	want := map[string]Placement{
+		"Session Timeout": {After: "User Login"},
+		"Token Issue":     {Before: "AUTH-003"},
+		"Audit":           {Group: "Compliance"},
+	}
+	if !reflect.DeepEqual(plan.AddedPlacements, want) {
+		t.Errorf("Expected placements %+v, got %+v", want, plan.AddedPlacements)
+	}
+
+	if strings.Contains(plan.Added[0].Raw, "<!--") {
+		t.Errorf("Placement hint should be stripped from block:\n%s", plan.Added[0].Raw)
+	}
+	// Only the first hint is a placement; later ones are kept as content
+	if !strings.Contains(plan.Added[2].Raw, "<!-- after: Token Issue -->") {
+		t.Errorf("Second hint should be kept:\n%s", plan.Added[2].Raw)
+	}
+}
+
+func TestRequirementGroups(t *testing.T) {
+	content := `# Auth Specification
+
+## Purpose
+
+### Background
+
+## Requirements
+
+### Sessions
+
+### Requirement: Login
+Content.
+
+#### Scenario: Login
+- **WHEN** credentials are valid
+- **THEN** the user is logged in
+
+### Tokens
+`
+
+	want := []string{"Sessions", "Tokens"}
+	if got := RequirementGroups(content); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected groups %v, got %v", want, got)
+	}
+}
+
+func TestParseRequirements_StopsAtGroupHeading(t *testing.T) {
+	content := `## Requirements
+
+### Sessions
+
+### Requirement: Login
+The system SHALL log users in.
+
+#### Scenario: Login
+- **WHEN** credentials are valid
+- **THEN** the user is logged in
+
+### Tokens
+
+### Requirement: Token Refresh
+The system SHALL refresh tokens.
+`
+
+	filePath := filepath.Join(t.TempDir(), "spec.md")
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	reqs, err := ParseRequirements(filePath)
+	if err != nil {
+		t.Fatalf("ParseRequirements failed: %v", err)
+	}
+	if len(reqs) != 2 {
+		t.Fatalf("Expected 2 requirements, got %d", len(reqs))
+	}
+	if strings.Contains(reqs[0].Raw, "### Tokens") {
+		t.Errorf("Group heading should not belong to requirement:\n%s", reqs[0].Raw)
+	}
+}
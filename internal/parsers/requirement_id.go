@@ -0,0 +1,75 @@
+package parsers
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Stable requirement IDs are written at the end of a requirement header,
+// either as a pandoc-style attribute or as an HTML comment:
+//
+//	### Requirement: Spec File Validation {#VAL-012}
+//	### Requirement: Spec File Validation <!-- id: VAL-012 -->
+//
+// IDs start with a letter and may contain letters, digits, '-', '_'
+// and '.'. They are compared case-sensitively.
+var (
+	requirementIDPattern = regexp.MustCompile(
+		`\s*(?:\{#([A-Za-z][\w.-]*)\}|<!--\s*id:\s*([A-Za-z][\w.-]*)\s*-->)\s*$`,
+	)
+	validIDPattern = regexp.MustCompile(`^[A-Za-z][\w.-]*$`)
+)
+
+// SplitRequirementID separates the stable ID suffix from the text that
+// follows "Requirement:" in a header. The ID is empty when the header
+// has none.
+func SplitRequirementID(headerText string) (name, id string) {
+	text := strings.TrimSpace(headerText)
+
+	matches := requirementIDPattern.FindStringSubmatchIndex(text)
+	if matches == nil {
+		return text, ""
+	}
+
+	// Exactly one of the two alternatives matched
+	for _, group := range []int{2, 4} {
+		if matches[group] >= 0 {
+			id = text[matches[group]:matches[group+1]]
+		}
+	}
+
+	return strings.TrimSpace(text[:matches[0]]), id
+}
+
+// IsValidRequirementID reports whether id can be used as a stable ID
+func IsValidRequirementID(id string) bool {
+	return validIDPattern.MatchString(id)
+}
+
+// FormatRequirementHeader renders a requirement header line, appending
+// the stable ID in "{#ID}" form when one is given
+func FormatRequirementHeader(name, id string) string {
+	if id == "" {
+		return "### Requirement: " + name
+	}
+
+	return "### Requirement: " + name + " {#" + id + "}"
+}
+
+// RenameRequirementHeader replaces the name in an existing header line
+// while keeping its ID suffix in whichever form it was written
+func RenameRequirementHeader(headerLine, oldName, newName string) string {
+	prefix, rest, found := strings.Cut(headerLine, "Requirement:")
+	if !found {
+		return FormatRequirementHeader(newName, "")
+	}
+
+	idx := strings.Index(rest, oldName)
+	if idx < 0 {
+		_, id := SplitRequirementID(rest)
+
+		return FormatRequirementHeader(newName, id)
+	}
+
+	return prefix + "Requirement:" + rest[:idx] + newName + rest[idx+len(oldName):]
+}
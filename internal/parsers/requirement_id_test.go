@@ -0,0 +1,63 @@
+package parsers
+
+import "testing"
+
+func TestSplitRequirementID(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantName string
+		wantID   string
+	}{
+		{"no ID", "Spec File Validation", "Spec File Validation", ""},
+		{"attribute", "Spec File Validation {#VAL-012}", "Spec File Validation", "VAL-012"},
+		{"comment", "Spec File Validation <!-- id: VAL-012 -->", "Spec File Validation", "VAL-012"},
+		{"trailing space", "  Login   {#AUTH.1}  ", "Login", "AUTH.1"},
+		{"invalid ID", "Login {#1-bad}", "Login {#1-bad}", ""},
+		{"not at end", "Login {#AUTH-1} extra", "Login {#AUTH-1} extra", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, id := SplitRequirementID(tt.input)
+			if name != tt.wantName || id != tt.wantID {
+				t.Errorf(
+					"SplitRequirementID(%q) = (%q, %q), want (%q, %q)",
+					tt.input, name, id, tt.wantName, tt.wantID,
+				)
+			}
+		})
+	}
+}
+
+func TestIsValidRequirementID(t *testing.T) {
+	for id, want := range map[string]bool{
+		"VAL-012": true,
+		"auth_1":  true,
+		"A.b-c":   true,
+		"":        false,
+		"12":      false,
+		"VAL 12":  false,
+	} {
+		if got := IsValidRequirementID(id); got != want {
+			t.Errorf("IsValidRequirementID(%q) = %v, want %v", id, got, want)
+		}
+	}
+}
+
+func TestRenameRequirementHeader(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"### Requirement: Old", "### Requirement: New"},
+		{"### Requirement: Old {#VAL-1}", "### Requirement: New {#VAL-1}"},
+		{"### Requirement: Old <!-- id: VAL-1 -->", "### Requirement: New <!-- id: VAL-1 -->"},
+	}
+
+	for _, tt := range tests {
+		if got := RenameRequirementHeader(tt.header, "Old", "New"); got != tt.want {
+			t.Errorf("RenameRequirementHeader(%q) = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
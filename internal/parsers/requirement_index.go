@@ -0,0 +1,130 @@
+package parsers
+
+// RequirementIndex is an ordered set of requirements that resolves
+// references by stable ID first and by normalized name second. It is
+// shared by pre-merge validation and the archive merger so both agree
+// on which base requirement a delta refers to.
+type RequirementIndex struct {
+	blocks  []RequirementBlock
+	removed []bool
+	byName  map[string]int
+	byID    map[string]int
+}
+
+// NewRequirementIndex indexes blocks in their original order
+func NewRequirementIndex(blocks []RequirementBlock) *RequirementIndex {
+	ix := &RequirementIndex{
+		blocks:  make([]RequirementBlock, 0, len(blocks)),
+		removed: make([]bool, 0, len(blocks)),
+		byName:  make(map[string]int, len(blocks)),
+		byID:    make(map[string]int, len(blocks)),
+	}
+	for _, block := range blocks {
+		ix.blocks = append(ix.blocks, block)
+		ix.removed = append(ix.removed, false)
+		ix.link(len(ix.blocks)-1, block)
+	}
+
+	return ix
+}
+
+// link records the name and ID of the block at position i
+func (ix *RequirementIndex) link(i int, block RequirementBlock) {
+	ix.byName[NormalizeRequirementName(block.Name)] = i
+	if block.ID != "" {
+		ix.byID[block.ID] = i
+	}
+}
+
+// unlink forgets the name and ID of the block at position i
+func (ix *RequirementIndex) unlink(i int) {
+	block := ix.blocks[i]
+	if ix.byName[NormalizeRequirementName(block.Name)] == i {
+		delete(ix.byName, NormalizeRequirementName(block.Name))
+	}
+	if block.ID != "" && ix.byID[block.ID] == i {
+		delete(ix.byID, block.ID)
+	}
+}
+
+// Find returns the position of the requirement referenced by id and
+// name. A known ID always wins. Otherwise the name is used, unless the
+// requirement found by name carries a different ID.
+func (ix *RequirementIndex) Find(id, name string) (int, bool) {
+	if id != "" {
+		if i, ok := ix.byID[id]; ok {
+			return i, true
+		}
+	}
+
+	i, ok := ix.byName[NormalizeRequirementName(name)]
+	if !ok {
+		return 0, false
+	}
+	if id != "" && ix.blocks[i].ID != "" && ix.blocks[i].ID != id {
+		return 0, false
+	}
+
+	return i, true
+}
+
+// HasName reports whether a requirement with the given name exists
+func (ix *RequirementIndex) HasName(name string) bool {
+	_, ok := ix.byName[NormalizeRequirementName(name)]
+
+	return ok
+}
+
+// HasID reports whether a requirement with the given stable ID exists
+func (ix *RequirementIndex) HasID(id string) bool {
+	_, ok := ix.byID[id]
+
+	return ok
+}
+
+// Get returns the requirement at position i
+func (ix *RequirementIndex) Get(i int) RequirementBlock {
+	return ix.blocks[i]
+}
+
+// Replace swaps the requirement at position i, keeping its position
+func (ix *RequirementIndex) Replace(i int, block RequirementBlock) {
+	ix.unlink(i)
+	ix.blocks[i] = block
+	ix.link(i, block)
+}
+
+// Remove drops the requirement at position i
+func (ix *RequirementIndex) Remove(i int) {
+	ix.unlink(i)
+	ix.removed[i] = true
+}
+
+// Len returns the number of indexed positions, including removed ones
+func (ix *RequirementIndex) Len() int {
+	return len(ix.blocks)
+}
+
+// IsRemoved reports whether the requirement at position i was removed
+func (ix *RequirementIndex) IsRemoved(i int) bool {
+	return ix.removed[i]
+}
+
+// Blocks returns the remaining requirements in their original order
+func (ix *RequirementIndex) Blocks() []RequirementBlock {
+	blocks := make([]RequirementBlock, 0, len(ix.blocks))
+	for i, block := range ix.blocks {
+		if !ix.removed[i] {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return blocks
+}
+
+// Add appends a requirement to the end of the index
+func (ix *RequirementIndex) Add(block RequirementBlock) {
+	ix.blocks = append(ix.blocks, block)
+	ix.removed = append(ix.removed, false)
+	ix.link(len(ix.blocks)-1, block)
+}
@@ -0,0 +1,60 @@
+package parsers
+
+import "testing"
+
+func TestRequirementIndex_Find(t *testing.T) {
+	index := NewRequirementIndex([]RequirementBlock{
+		{Name: "Login", ID: "AUTH-001"},
+		{Name: "Logout"},
+	})
+
+	tests := []struct {
+		name      string
+		id        string
+		reqName   string
+		wantIndex int
+		wantFound bool
+	}{
+		{"by ID", "AUTH-001", "Anything", 0, true},
+		{"by name", "", "logout", 1, true},
+		{"by name without conflicting ID", "AUTH-002", "Logout", 1, true},
+		{"conflicting ID", "AUTH-009", "Login", 0, false},
+		{"missing", "", "Signup", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i, found := index.Find(tt.id, tt.reqName)
+			if found != tt.wantFound || (found && i != tt.wantIndex) {
+				t.Errorf("Find(%q, %q) = (%d, %v), want (%d, %v)",
+					tt.id, tt.reqName, i, found, tt.wantIndex, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestRequirementIndex_ReplaceAndRemove(t *testing.T) {
+	index := NewRequirementIndex([]RequirementBlock{
+		{Name: "Login", ID: "AUTH-001"},
+		{Name: "Logout", ID: "AUTH-002"},
+		{Name: "Signup"},
+	})
+
+	index.Replace(0, RequirementBlock{Name: "Sign In", ID: "AUTH-001"})
+	index.Remove(1)
+
+	if index.HasName("Login") {
+		t.Error("old name should no longer resolve after Replace")
+	}
+	if !index.HasName("Sign In") || !index.HasID("AUTH-001") {
+		t.Error("replaced requirement should resolve by new name and ID")
+	}
+	if index.HasID("AUTH-002") {
+		t.Error("removed requirement should not resolve by ID")
+	}
+
+	blocks := index.Blocks()
+	if len(blocks) != 2 || blocks[0].Name != "Sign In" || blocks[1].Name != "Signup" {
+		t.Errorf("Blocks() = %+v, want [Sign In, Signup]", blocks)
+	}
+}
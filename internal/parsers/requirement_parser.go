@@ -8,10 +8,15 @@ import (
 	"strings"
 )
 
+// RequirementHeaderPattern matches the header line of a requirement and
+// captures its name, including any ID suffix
+var RequirementHeaderPattern = regexp.MustCompile(`^###\s+Requirement:\s*(.+)$`)
+
 // RequirementBlock represents a requirement with its header and content
 type RequirementBlock struct {
 	HeaderLine string // "### Requirement: <name>"
-	Name       string // Extracted requirement name
+	Name       string // Extracted requirement name, without the ID suffix
+	ID         string // Stable ID from a "{#ID}" suffix, empty if none
 	Raw        string // Full block content (header + scenarios + body text)
 }
 
@@ -29,7 +34,6 @@ func ParseRequirements(filePath string) ([]RequirementBlock, error) {
 
 	var requirements []RequirementBlock
 	var currentReq *RequirementBlock
-	reqPattern := regexp.MustCompile(`^###\s+Requirement:\s*(.+)$`)
 	h2Pattern := regexp.MustCompile(`^##\s+`)
 
 	scanner := bufio.NewScanner(file)
@@ -37,7 +41,7 @@ func ParseRequirements(filePath string) ([]RequirementBlock, error) {
 		line := scanner.Text()
 
 		// Check if this is a new requirement header
-		matches := reqPattern.FindStringSubmatch(line)
+		matches := RequirementHeaderPattern.FindStringSubmatch(line)
 		if len(matches) > 1 {
 			// Save previous requirement if exists
 			if currentReq != nil {
@@ -45,18 +49,20 @@ func ParseRequirements(filePath string) ([]RequirementBlock, error) {
 			}
 
 			// Start new requirement
-			name := strings.TrimSpace(matches[1])
+			name, id := SplitRequirementID(matches[1])
 			currentReq = &RequirementBlock{
 				HeaderLine: line,
 				Name:       name,
+				ID:         id,
 				Raw:        line + "\n",
 			}
 
 			continue
 		}
 
-		// Check if we hit a new section (## header) - ends current requirement
-		if h2Pattern.MatchString(line) {
+		// A new section (## header) or a grouping heading (### header
+		// that is not a requirement) ends the current requirement
+		if h2Pattern.MatchString(line) || IsRequirementGroupHeading(line) {
 			if currentReq != nil {
 				requirements = append(requirements, *currentReq)
 				currentReq = nil
@@ -107,3 +113,26 @@ func ParseScenarios(requirementContent string) []string {
 func NormalizeRequirementName(name string) string {
 	return strings.ToLower(strings.TrimSpace(name))
 }
+
+// RequirementDescription returns the requirement body text that
+// precedes its first scenario or sub-heading, without the header line
+func RequirementDescription(requirementContent string) string {
+	var lines []string
+
+	scanner := bufio.NewScanner(strings.NewReader(requirementContent))
+	first := true
+	for scanner.Scan() {
+		line := scanner.Text()
+		if first {
+			first = false
+
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			break
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
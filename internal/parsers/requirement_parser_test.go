@@ -249,3 +249,22 @@ func TestNormalizeRequirementName(t *testing.T) {
 		})
 	}
 }
+
+func TestRequirementDescription(t *testing.T) {
+	raw := `### Requirement: Login
+The system SHALL authenticate users.
+
+Sessions expire after one hour.
+
+#### Scenario: Valid credentials
+- **WHEN** valid credentials are submitted
+`
+	want := "The system SHALL authenticate users.\n\nSessions expire after one hour."
+	if got := RequirementDescription(raw); got != want {
+		t.Errorf("RequirementDescription() = %q, want %q", got, want)
+	}
+
+	if got := RequirementDescription("### Requirement: Empty\n"); got != "" {
+		t.Errorf("Expected empty description, got %q", got)
+	}
+}
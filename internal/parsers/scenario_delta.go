@@ -0,0 +1,121 @@
+package parsers
+
+import (
+	"bufio"
+	"regexp"
+	"strings"
+)
+
+// Scenario-level deltas change individual scenarios of an existing
+// requirement instead of replacing the whole requirement block:
+//
+//	## ADDED Scenarios
+//
+//	### Requirement: User Login
+//
+//	#### Scenario: Remember me
+//	- **WHEN** the user ticks "remember me"
+//	- **THEN** the session outlives the browser
+//
+// MODIFIED Scenarios replace scenarios with the same name, and REMOVED
+// Scenarios only need the scenario headers.
+
+// ScenarioBlock is the raw markdown of one "#### Scenario:" block
+type ScenarioBlock struct {
+	Name string
+	Raw  string
+}
+
+// ScenarioDelta groups the scenarios of one delta section that target
+// the same requirement
+type ScenarioDelta struct {
+	// Requirement is the name of the targeted requirement
+	Requirement string
+	// RequirementID is the stable ID given on the requirement header, if any
+	RequirementID string
+	Scenarios     []ScenarioBlock
+	// HeaderLine is the requirement header as written in the delta
+	HeaderLine string
+}
+
+var scenarioBlockHeaderPattern = regexp.MustCompile(`^####\s+Scenario:\s*(.+?)\s*$`)
+
+// parseScenarioDeltaSection extracts scenario deltas from the
+// "## <sectionType> Scenarios" section
+func parseScenarioDeltaSection(content, sectionType string) []ScenarioDelta {
+	sectionContent := extractNamedSection(content, sectionType, "Scenarios")
+	if sectionContent == "" {
+		return nil
+	}
+
+	var deltas []ScenarioDelta
+	for _, req := range parseRequirementsFromSection(sectionContent) {
+		_, scenarios := SplitRequirementScenarios(req.Raw)
+		deltas = append(deltas, ScenarioDelta{
+			Requirement:   req.Name,
+			RequirementID: req.ID,
+			Scenarios:     scenarios,
+			HeaderLine:    req.HeaderLine,
+		})
+	}
+
+	return deltas
+}
+
+// SplitRequirementScenarios splits a requirement block into the text
+// before its first scenario (header and description) and its scenario
+// blocks. Each scenario runs until the next scenario header, so nothing
+// is lost when the parts are joined again.
+func SplitRequirementScenarios(raw string) (body string, scenarios []ScenarioBlock) {
+	var bodyBuilder strings.Builder
+	var current *ScenarioBlock
+
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if matches := scenarioBlockHeaderPattern.FindStringSubmatch(
+			strings.TrimSpace(line),
+		); matches != nil {
+			if current != nil {
+				scenarios = append(scenarios, *current)
+			}
+			current = &ScenarioBlock{Name: matches[1], Raw: line + "\n"}
+
+			continue
+		}
+
+		if current != nil {
+			current.Raw += line + "\n"
+		} else {
+			bodyBuilder.WriteString(line + "\n")
+		}
+	}
+
+	if current != nil {
+		scenarios = append(scenarios, *current)
+	}
+
+	return bodyBuilder.String(), scenarios
+}
+
+// JoinRequirementScenarios is the inverse of SplitRequirementScenarios,
+// separating the body and each scenario by a blank line
+func JoinRequirementScenarios(body string, scenarios []ScenarioBlock) string {
+	parts := []string{strings.TrimRight(body, "\n")}
+	for _, scenario := range scenarios {
+		parts = append(parts, strings.TrimRight(scenario.Raw, "\n"))
+	}
+
+	return strings.Join(parts, "\n\n") + "\n"
+}
+
+// CountScenarios returns the number of scenarios across scenario deltas
+func CountScenarios(deltas []ScenarioDelta) int {
+	count := 0
+	for _, delta := range deltas {
+		count += len(delta.Scenarios)
+	}
+
+	return count
+}
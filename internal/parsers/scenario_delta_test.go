@@ -0,0 +1,105 @@
+package parsers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseDeltaSpec_ScenarioDeltas(t *testing.T) {
+	content := `# Delta Spec
+
+## ADDED Scenarios
+
+### Requirement: User Login {#AUTH-001}
+
+#### Scenario: Remember me
+- **WHEN** the user ticks remember me
+- **THEN** the session persists
+
+#### Scenario: Locked account
+- **WHEN** the account is locked
+- **THEN** login is refused
+
+## MODIFIED Scenarios
+
+### Requirement: User Logout
+
+#### Scenario: Logout works
+- **WHEN** the user logs out
+- **THEN** the session ends
+
+## REMOVED Scenarios
+
+### Requirement: User Login
+
+#### Scenario: Legacy token
+`
+
+	filePath := filepath.Join(t.TempDir(), "spec.md")
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := ParseDeltaSpec(filePath)
+	if err != nil {
+		t.Fatalf("ParseDeltaSpec failed: %v", err)
+	}
+
+	if !plan.HasDeltas() || !plan.HasScenarioDeltas() {
+		t.Error("Expected plan to have scenario deltas")
+	}
+	if got := plan.CountOperations(); got != 4 {
+		t.Errorf("Expected 4 operations, got %d", got)
+	}
+	if len(plan.Added) != 0 || len(plan.Modified) != 0 || len(plan.Removed) != 0 {
+		t.Error("Scenario sections should not produce requirement operations")
+	}
+
+	if len(plan.AddedScenarios) != 1 {
+		t.Fatalf("Expected 1 ADDED scenario delta, got %d", len(plan.AddedScenarios))
+	}
+	added := plan.AddedScenarios[0]
+	if added.Requirement != "User Login" || added.RequirementID != "AUTH-001" {
+		t.Errorf("Unexpected ADDED target %q (%q)", added.Requirement, added.RequirementID)
+	}
+	if len(added.Scenarios) != 2 || added.Scenarios[0].Name != "Remember me" ||
+		added.Scenarios[1].Name != "Locked account" {
+		t.Errorf("Unexpected ADDED scenarios %+v", added.Scenarios)
+	}
+
+	if len(plan.ModifiedScenarios) != 1 ||
+		plan.ModifiedScenarios[0].Scenarios[0].Name != "Logout works" {
+		t.Errorf("Unexpected MODIFIED scenarios %+v", plan.ModifiedScenarios)
+	}
+	if len(plan.RemovedScenarios) != 1 ||
+		plan.RemovedScenarios[0].Scenarios[0].Name != "Legacy token" {
+		t.Errorf("Unexpected REMOVED scenarios %+v", plan.RemovedScenarios)
+	}
+}
+
+func TestSplitRequirementScenarios(t *testing.T) {
+	raw := `### Requirement: Login
+The system SHALL log users in.
+
+#### Scenario: Valid
+- **WHEN** credentials are valid
+- **THEN** the user is logged in
+
+#### Scenario: Invalid
+- **WHEN** credentials are invalid
+- **THEN** an error is shown
+`
+
+	body, scenarios := SplitRequirementScenarios(raw)
+	if body != "### Requirement: Login\nThe system SHALL log users in.\n\n" {
+		t.Errorf("Unexpected body %q", body)
+	}
+	if len(scenarios) != 2 || scenarios[0].Name != "Valid" || scenarios[1].Name != "Invalid" {
+		t.Fatalf("Unexpected scenarios %+v", scenarios)
+	}
+
+	if joined := JoinRequirementScenarios(body, scenarios); joined != raw {
+		t.Errorf("JoinRequirementScenarios did not round-trip:\n%s", joined)
+	}
+}
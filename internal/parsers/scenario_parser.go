@@ -0,0 +1,90 @@
+package parsers
+
+import (
+	"bufio"
+	"regexp"
+	"strings"
+)
+
+// ScenarioStep is a single bullet within a scenario, e.g.
+// "- **WHEN** user logs in" becomes {Keyword: "WHEN", Text: "user logs in"}.
+// Bullets without a bold keyword have an empty Keyword.
+type ScenarioStep struct {
+	Keyword string `json:"keyword,omitempty"`
+	Text    string `json:"text"`
+}
+
+// Scenario is a parsed "#### Scenario:" block with its steps
+type Scenario struct {
+	Name  string         `json:"name"`
+	Steps []ScenarioStep `json:"steps"`
+}
+
+var (
+	scenarioHeaderPattern = regexp.MustCompile(`^####\s+Scenario:\s*(.+)$`)
+	scenarioStepPattern   = regexp.MustCompile(
+		`(?i)^[-*]\s+\*\*(GIVEN|WHEN|THEN|AND|BUT)\*\*:?\s*(.*)$`,
+	)
+	bulletPattern = regexp.MustCompile(`^[-*]\s+(.+)$`)
+)
+
+// ParseScenarioBlocks extracts scenarios and their WHEN/THEN/AND steps
+// from requirement content. Scenario content ends at the next heading.
+func ParseScenarioBlocks(requirementContent string) []Scenario {
+	var scenarios []Scenario
+	var current *Scenario
+
+	scanner := bufio.NewScanner(strings.NewReader(requirementContent))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if matches := scenarioHeaderPattern.FindStringSubmatch(line); len(matches) > 1 {
+			if current != nil {
+				scenarios = append(scenarios, *current)
+			}
+			current = &Scenario{
+				Name:  strings.TrimSpace(matches[1]),
+				Steps: make([]ScenarioStep, 0),
+			}
+
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		if strings.HasPrefix(line, "#") {
+			scenarios = append(scenarios, *current)
+			current = nil
+
+			continue
+		}
+
+		if step, ok := parseScenarioStep(line); ok {
+			current.Steps = append(current.Steps, step)
+		}
+	}
+
+	if current != nil {
+		scenarios = append(scenarios, *current)
+	}
+
+	return scenarios
+}
+
+// parseScenarioStep parses a single bullet line into a step
+func parseScenarioStep(line string) (ScenarioStep, bool) {
+	if matches := scenarioStepPattern.FindStringSubmatch(line); len(matches) > 2 {
+		return ScenarioStep{
+			Keyword: strings.ToUpper(matches[1]),
+			Text:    strings.TrimSpace(matches[2]),
+		}, true
+	}
+
+	if matches := bulletPattern.FindStringSubmatch(line); len(matches) > 1 {
+		return ScenarioStep{Text: strings.TrimSpace(matches[1])}, true
+	}
+
+	return ScenarioStep{}, false
+}
@@ -0,0 +1,50 @@
+package parsers
+
+import "testing"
+
+func TestParseScenarioBlocks(t *testing.T) {
+	content := `### Requirement: Login
+The system SHALL authenticate users.
+
+#### Scenario: Successful login
+- **GIVEN** a registered user
+- **WHEN** valid credentials are submitted
+- **THEN** a session is created
+- **AND** the user is redirected
+- plain bullet
+
+#### Scenario: Empty scenario
+
+### Requirement: Next
+`
+
+	scenarios := ParseScenarioBlocks(content)
+	if len(scenarios) != 2 {
+		t.Fatalf("Expected 2 scenarios, got %d", len(scenarios))
+	}
+
+	login := scenarios[0]
+	if login.Name != "Successful login" {
+		t.Errorf("Expected name 'Successful login', got %q", login.Name)
+	}
+
+	expected := []ScenarioStep{
+		{Keyword: "GIVEN", Text: "a registered user"},
+		{Keyword: "WHEN", Text: "valid credentials are submitted"},
+		{Keyword: "THEN", Text: "a session is created"},
+		{Keyword: "AND", Text: "the user is redirected"},
+		{Text: "plain bullet"},
+	}
+	if len(login.Steps) != len(expected) {
+		t.Fatalf("Expected %d steps, got %d", len(expected), len(login.Steps))
+	}
+	for i, step := range expected {
+		if login.Steps[i] != step {
+			t.Errorf("Step %d: got %+v, want %+v", i, login.Steps[i], step)
+		}
+	}
+
+	if len(scenarios[1].Steps) != 0 {
+		t.Errorf("Expected empty scenario to have no steps, got %d", len(scenarios[1].Steps))
+	}
+}
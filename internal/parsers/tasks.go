@@ -0,0 +1,229 @@
+package parsers
+
+import (
+	"os"
+	"regexp"
+	"strings"
+)
+
+// tasks.md groups checklist items into numbered sections. Tasks carry a
+// hierarchical ID, may be nested by indentation, and may name assignees
+// and link the requirements they implement:
+//
+//	## 1. Documentation Updates
+//	- [x] 1.1 Update the README @alice
+//	- [ ] 1.2 Document [[auth#Session Timeout]]
+//	  - [ ] 1.2.1 Add an example
+
+// Task is a checklist item of tasks.md
+type Task struct {
+	// ID is the hierarchical task ID such as "1.2", if any
+	ID string `json:"id,omitempty"`
+	// Text is the description following the ID
+	Text string `json:"text"`
+	// Done reports whether the task is checked off
+	Done bool `json:"done"`
+	// Assignees are the names mentioned as @name, without the @
+	Assignees []string `json:"assignees,omitempty"`
+	// Requirements are the requirements the task links to
+	Requirements []RequirementLink `json:"requirements,omitempty"`
+	// Line is the 1-based line of the task
+	Line int `json:"line"`
+	// Indent is the indentation width of the task line
+	Indent int `json:"-"`
+	// Children are the tasks nested below this one
+	Children []*Task `json:"children,omitempty"`
+}
+
+// TaskSection is a "## " section of tasks.md
+type TaskSection struct {
+	// Number is the section number such as "1", if any
+	Number string `json:"number,omitempty"`
+	// Title is the heading text following the number
+	Title string `json:"title"`
+	// Line is the 1-based line of the heading, or 0 for tasks that
+	// appear before the first section
+	Line int `json:"line"`
+	// Tasks are the top-level tasks of the section
+	Tasks []*Task `json:"tasks"`
+}
+
+// TaskList is the parsed content of tasks.md
+type TaskList struct {
+	Sections []*TaskSection `json:"sections"`
+}
+
+var (
+	taskLinePattern    = regexp.MustCompile(`^(\s*)-\s*\[([xX ])\]\s*(.*)$`)
+	taskIDPattern      = regexp.MustCompile(`^(\d+(?:\.\d+)*)\.?(?:\s+|$)`)
+	taskSectionPattern = regexp.MustCompile(`^##\s+(?:(\d+)\.\s+)?(.+?)\s*$`)
+	assigneePattern    = regexp.MustCompile(`(?:^|\s)@([\w.-]*\w)`)
+)
+
+// ParseTaskList parses the content of tasks.md. Tasks inside fenced
+// code blocks are ignored.
+func ParseTaskList(content string) *TaskList {
+	list := &TaskList{Sections: make([]*TaskSection, 0)}
+	var section *TaskSection
+	var stack []*Task
+	inFence := false
+
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inFence = !inFence
+
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		if matches := taskSectionPattern.FindStringSubmatch(trimmed); matches != nil {
+			section = &TaskSection{
+				Number: matches[1],
+				Title:  matches[2],
+				Line:   i + 1,
+				Tasks:  make([]*Task, 0),
+			}
+			list.Sections = append(list.Sections, section)
+			stack = nil
+
+			continue
+		}
+
+		task := parseTaskLine(line, i+1)
+		if task == nil {
+			continue
+		}
+		if section == nil {
+			section = &TaskSection{Tasks: make([]*Task, 0)}
+			list.Sections = append(list.Sections, section)
+		}
+
+		for len(stack) > 0 && stack[len(stack)-1].Indent >= task.Indent {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			section.Tasks = append(section.Tasks, task)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.Children = append(parent.Children, task)
+		}
+		stack = append(stack, task)
+	}
+
+	return list
+}
+
+// parseTaskLine parses a checklist line, returning nil for other lines
+func parseTaskLine(line string, lineNum int) *Task {
+	matches := taskLinePattern.FindStringSubmatch(line)
+	if matches == nil {
+		return nil
+	}
+
+	task := &Task{
+		Done:   strings.EqualFold(matches[2], "x"),
+		Line:   lineNum,
+		Indent: len(strings.ReplaceAll(matches[1], "\t", "    ")),
+	}
+
+	text := strings.TrimSpace(matches[3])
+	if id := taskIDPattern.FindStringSubmatch(text); id != nil {
+		task.ID = id[1]
+		text = strings.TrimSpace(text[len(id[0]):])
+	}
+	task.Text = text
+
+	for _, mention := range assigneePattern.FindAllStringSubmatch(text, -1) {
+		task.Assignees = append(task.Assignees, mention[1])
+	}
+	for _, link := range requirementLinkPattern.FindAllStringSubmatch(text, -1) {
+		task.Requirements = append(task.Requirements, RequirementLink{
+			Capability:  strings.TrimSpace(link[1]),
+			Requirement: strings.TrimSpace(link[2]),
+			Line:        lineNum,
+		})
+	}
+
+	return task
+}
+
+// ParseTasksFile parses the tasks.md file at filePath
+func ParseTasksFile(filePath string) (*TaskList, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseTaskList(string(content)), nil
+}
+
+// Walk calls fn for every task of the section, parents before their
+// children
+func (s *TaskSection) Walk(fn func(*Task)) {
+	walkTasks(s.Tasks, fn)
+}
+
+// walkTasks calls fn for tasks and their descendants in document order
+func walkTasks(tasks []*Task, fn func(*Task)) {
+	for _, task := range tasks {
+		fn(task)
+		walkTasks(task.Children, fn)
+	}
+}
+
+// Status counts the tasks of the section, including nested tasks
+func (s *TaskSection) Status() TaskStatus {
+	var status TaskStatus
+	s.Walk(func(task *Task) {
+		status.Total++
+		if task.Done {
+			status.Completed++
+		}
+	})
+
+	return status
+}
+
+// Heading returns the section heading as written, such as
+// "1. Documentation Updates"
+func (s *TaskSection) Heading() string {
+	if s.Number == "" {
+		return s.Title
+	}
+
+	return s.Number + ". " + s.Title
+}
+
+// Walk calls fn for every task in document order
+func (l *TaskList) Walk(fn func(*Task)) {
+	for _, section := range l.Sections {
+		section.Walk(fn)
+	}
+}
+
+// Status counts all tasks of the list, including nested tasks
+func (l *TaskList) Status() TaskStatus {
+	var status TaskStatus
+	for _, section := range l.Sections {
+		sectionStatus := section.Status()
+		status.Total += sectionStatus.Total
+		status.Completed += sectionStatus.Completed
+	}
+
+	return status
+}
+
+// Find returns the task with the given ID, or nil
+func (l *TaskList) Find(id string) *Task {
+	var found *Task
+	l.Walk(func(task *Task) {
+		if found == nil && task.ID == id {
+			found = task
+		}
+	})
+
+	return found
+}
@@ -0,0 +1,109 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+const sampleTasks = `# Implementation Tasks
+
+- [x] 0.1 Preamble task
+
+## 1. Documentation Updates
+- [x] 1.1 Update the README @alice
+- [ ] 1.2 Document [[auth#Session Timeout]] @bob @carol
+  - [x] 1.2.1 Add an example
+  - [ ] 1.2.2. Add a diagram
+    - [ ] Review with @dave.smith
+- [ ] 1.3 Email alice@example.com
+
+## Cleanup
+- [X] Remove dead code
+
+` + "```" + `
+- [ ] 9.9 Not a task
+` + "```" + `
+
+## 3. Empty
+`
+
+func TestParseTaskList(t *testing.T) {
+	list := ParseTaskList(sampleTasks)
+
+	if len(list.Sections) != 4 {
+		t.Fatalf("Expected 4 sections, got %d", len(list.Sections))
+	}
+
+	preamble := list.Sections[0]
+	if preamble.Title != "" || preamble.Line != 0 || len(preamble.Tasks) != 1 {
+		t.Errorf("Unexpected preamble section: %+v", preamble)
+	}
+
+	docs := list.Sections[1]
+	if docs.Number != "1" || docs.Title != "Documentation Updates" || docs.Line != 5 {
+		t.Errorf("Unexpected section: %+v", docs)
+	}
+	if docs.Heading() != "1. Documentation Updates" {
+		t.Errorf("Heading() = %q", docs.Heading())
+	}
+	if len(docs.Tasks) != 3 {
+		t.Fatalf("Expected 3 top-level tasks, got %d", len(docs.Tasks))
+	}
+
+	first := docs.Tasks[0]
+	if first.ID != "1.1" || first.Text != "Update the README @alice" || !first.Done || first.Line != 6 {
+		t.Errorf("Unexpected task: %+v", first)
+	}
+	if !reflect.DeepEqual(first.Assignees, []string{"alice"}) {
+		t.Errorf("Assignees = %v", first.Assignees)
+	}
+
+	second := docs.Tasks[1]
+	if !reflect.DeepEqual(second.Assignees, []string{"bob", "carol"}) {
+		t.Errorf("Assignees = %v", second.Assignees)
+	}
+	if len(second.Requirements) != 1 || second.Requirements[0].String() != "[[auth#Session Timeout]]" {
+		t.Errorf("Requirements = %+v", second.Requirements)
+	}
+	if len(second.Children) != 2 || second.Children[1].ID != "1.2.2" {
+		t.Fatalf("Unexpected children: %+v", second.Children)
+	}
+	grandchild := second.Children[1].Children
+	if len(grandchild) != 1 || grandchild[0].ID != "" ||
+		!reflect.DeepEqual(grandchild[0].Assignees, []string{"dave.smith"}) {
+		t.Errorf("Unexpected nested task: %+v", grandchild)
+	}
+
+	if got := docs.Tasks[2].Assignees; len(got) != 0 {
+		t.Errorf("Email address parsed as assignee: %v", got)
+	}
+
+	if cleanup := list.Sections[2]; cleanup.Number != "" || cleanup.Title != "Cleanup" || !cleanup.Tasks[0].Done {
+		t.Errorf("Unexpected section: %+v", cleanup)
+	}
+	if empty := list.Sections[3]; empty.Heading() != "3. Empty" || len(empty.Tasks) != 0 {
+		t.Errorf("Unexpected section: %+v", empty)
+	}
+}
+
+func TestTaskList_Status(t *testing.T) {
+	list := ParseTaskList(sampleTasks)
+
+	if got := list.Status(); got != (TaskStatus{Total: 8, Completed: 4}) {
+		t.Errorf("Status() = %+v", got)
+	}
+	if got := list.Sections[1].Status(); got != (TaskStatus{Total: 6, Completed: 2}) {
+		t.Errorf("section Status() = %+v", got)
+	}
+}
+
+func TestTaskList_Find(t *testing.T) {
+	list := ParseTaskList(sampleTasks)
+
+	if task := list.Find("1.2.1"); task == nil || task.Text != "Add an example" {
+		t.Errorf("Find(1.2.1) = %+v", task)
+	}
+	if task := list.Find("9.9"); task != nil {
+		t.Errorf("Found task inside code fence: %+v", task)
+	}
+}
@@ -0,0 +1,182 @@
+// Package propose opens pull requests that propose a change: the change
+// directory is committed on a new branch and the PR body summarizes the
+// proposal, its tasks, and its spec deltas.
+package propose
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+	"github.com/connerohnesorge/spectr/internal/validation"
+)
+
+// proposalSections are the proposal.md sections quoted in the PR body
+var proposalSections = []string{"Why", "What Changes"}
+
+// BuildPRBody renders the PR body of the change in changeDir: the Why
+// and What Changes sections of the proposal, the task checklist, and a
+// preview of each delta spec
+func BuildPRBody(changeDir string) (string, error) {
+	var sb strings.Builder
+
+	proposal, err := os.ReadFile(filepath.Join(changeDir, "proposal.md"))
+	if err != nil {
+		return "", fmt.Errorf("read proposal: %w", err)
+	}
+	_, content, _ := parsers.SplitFrontmatter(string(proposal))
+	sections := validation.ExtractSections(content)
+	for _, name := range proposalSections {
+		if text := sections[name]; text != "" {
+			fmt.Fprintf(&sb, "## %s\n\n%s\n\n", name, text)
+		}
+	}
+
+	tasks, err := parsers.ParseTasksFile(filepath.Join(changeDir, "tasks.md"))
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("read tasks: %w", err)
+	}
+	if tasks != nil {
+		writeTasks(&sb, tasks)
+	}
+
+	if err := writeDeltas(&sb, filepath.Join(changeDir, "specs")); err != nil {
+		return "", err
+	}
+
+	sb.WriteString("---\n")
+	sb.WriteString("Generated by `spectr propose --pr`\n")
+
+	return sb.String(), nil
+}
+
+// writeTasks appends the task checklist, keeping sections and nesting
+func writeTasks(sb *strings.Builder, list *parsers.TaskList) {
+	if list.Status().Total == 0 {
+		return
+	}
+
+	sb.WriteString("## Tasks\n\n")
+	for _, section := range list.Sections {
+		if len(section.Tasks) == 0 {
+			continue
+		}
+		if heading := section.Heading(); heading != "" {
+			fmt.Fprintf(sb, "### %s\n\n", heading)
+		}
+		writeTaskItems(sb, section.Tasks, 0)
+		sb.WriteString("\n")
+	}
+}
+
+// writeTaskItems appends tasks as checklist items at the given depth
+func writeTaskItems(sb *strings.Builder, tasks []*parsers.Task, depth int) {
+	for _, task := range tasks {
+		mark := " "
+		if task.Done {
+			mark = "x"
+		}
+		text := task.Text
+		if task.ID != "" {
+			text = task.ID + " " + text
+		}
+		fmt.Fprintf(sb, "%s- [%s] %s\n", strings.Repeat("  ", depth), mark, text)
+		writeTaskItems(sb, task.Children, depth+1)
+	}
+}
+
+// writeDeltas appends a summary and the content of every delta spec
+// below specsDir, one capability at a time
+func writeDeltas(sb *strings.Builder, specsDir string) error {
+	var files []string
+	err := filepath.WalkDir(specsDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && d.Name() == "spec.md" {
+			files = append(files, path)
+		}
+
+		return nil
+	})
+	if os.IsNotExist(err) || len(files) == 0 {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("find delta specs: %w", err)
+	}
+	sort.Strings(files)
+
+	sb.WriteString("## Spec Deltas\n\n")
+	for _, file := range files {
+		capability, err := filepath.Rel(specsDir, filepath.Dir(file))
+		if err != nil {
+			return fmt.Errorf("locate delta spec: %w", err)
+		}
+		plan, err := parsers.ParseDeltaSpec(file)
+		if err != nil {
+			return fmt.Errorf("parse delta spec %s: %w", file, err)
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("read delta spec: %w", err)
+		}
+
+		fmt.Fprintf(sb, "### `%s`\n\n", filepath.ToSlash(capability))
+		writeDeltaSummary(sb, plan)
+		sb.WriteString("<details><summary>Delta spec</summary>\n\n")
+		sb.WriteString(strings.TrimSpace(string(content)))
+		sb.WriteString("\n\n</details>\n\n")
+	}
+
+	return nil
+}
+
+// writeDeltaSummary appends one line per kind of delta operation
+func writeDeltaSummary(sb *strings.Builder, plan *parsers.DeltaPlan) {
+	names := func(blocks []parsers.RequirementBlock) []string {
+		out := make([]string, len(blocks))
+		for i, block := range blocks {
+			out[i] = block.Name
+		}
+
+		return out
+	}
+	scenarios := func(deltas []parsers.ScenarioDelta) []string {
+		var out []string
+		for _, delta := range deltas {
+			for _, scenario := range delta.Scenarios {
+				out = append(out, delta.Requirement+" / "+scenario.Name)
+			}
+		}
+
+		return out
+	}
+	renamed := make([]string, len(plan.Renamed))
+	for i, op := range plan.Renamed {
+		renamed[i] = op.From + " → " + op.To
+	}
+
+	lines := []struct {
+		label string
+		items []string
+	}{
+		{"Added", names(plan.Added)},
+		{"Modified", names(plan.Modified)},
+		{"Removed", plan.Removed},
+		{"Renamed", renamed},
+		{"Added scenarios", scenarios(plan.AddedScenarios)},
+		{"Modified scenarios", scenarios(plan.ModifiedScenarios)},
+		{"Removed scenarios", scenarios(plan.RemovedScenarios)},
+	}
+
+	for _, line := range lines {
+		if len(line.items) > 0 {
+			fmt.Fprintf(sb, "- **%s:** %s\n", line.label, strings.Join(line.items, ", "))
+		}
+	}
+	sb.WriteString("\n")
+}
@@ -0,0 +1,96 @@
+package propose
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeFile writes content to path, creating its directory
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildPRBody(t *testing.T) {
+	changeDir := t.TempDir()
+	writeFile(t, filepath.Join(changeDir, "proposal.md"), `---
+status: proposed
+---
+# Change: Add MFA
+
+## Why
+Users want stronger authentication.
+
+## What Changes
+- Add MFA login
+
+## Impact
+- auth
+`)
+	writeFile(t, filepath.Join(changeDir, "tasks.md"), `## 1. Implementation
+- [x] 1.1 Add MFA endpoint
+  - [ ] 1.1.1 Add tests
+- [ ] 1.2 Update docs
+`)
+	writeFile(t, filepath.Join(changeDir, "specs", "auth", "spec.md"), `## ADDED Requirements
+
+### Requirement: MFA Login
+The system SHALL let users log in with a second factor.
+
+#### Scenario: MFA success
+- **WHEN** a user completes the second factor
+- **THEN** they are logged in
+
+## RENAMED Requirements
+- FROM: `+"`### Requirement: Password Login`"+`
+- TO: `+"`### Requirement: Credential Login`"+`
+`)
+
+	body, err := BuildPRBody(changeDir)
+	if err != nil {
+		t.Fatalf("BuildPRBody failed: %v", err)
+	}
+
+	want := []string{
+		"## Why\n\nUsers want stronger authentication.\n",
+		"## What Changes\n\n- Add MFA login\n",
+		"## Tasks\n\n### 1. Implementation\n\n" +
+			"- [x] 1.1 Add MFA endpoint\n  - [ ] 1.1.1 Add tests\n- [ ] 1.2 Update docs\n",
+		"### `auth`\n\n- **Added:** MFA Login\n" +
+			"- **Renamed:** Password Login → Credential Login\n",
+		"<details><summary>Delta spec</summary>\n\n## ADDED Requirements",
+		"Generated by `spectr propose --pr`",
+	}
+	for _, w := range want {
+		if !strings.Contains(body, w) {
+			t.Errorf("Body missing %q:\n%s", w, body)
+		}
+	}
+	if strings.Contains(body, "## Impact") || strings.Contains(body, "status:") {
+		t.Errorf("Body should only quote Why and What Changes:\n%s", body)
+	}
+}
+
+func TestBuildPRBody_ProposalOnly(t *testing.T) {
+	changeDir := t.TempDir()
+	writeFile(t, filepath.Join(changeDir, "proposal.md"), "## Why\nBecause.\n")
+
+	body, err := BuildPRBody(changeDir)
+	if err != nil {
+		t.Fatalf("BuildPRBody failed: %v", err)
+	}
+	if strings.Contains(body, "## Tasks") || strings.Contains(body, "## Spec Deltas") {
+		t.Errorf("Expected no tasks or deltas:\n%s", body)
+	}
+
+	if _, err := BuildPRBody(t.TempDir()); err == nil {
+		t.Error("Expected an error without proposal.md")
+	}
+}
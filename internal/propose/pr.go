@@ -0,0 +1,148 @@
+package propose
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/connerohnesorge/spectr/internal/config"
+	"github.com/connerohnesorge/spectr/internal/fsutil"
+	"github.com/connerohnesorge/spectr/internal/git"
+)
+
+// Options are the pull request options given on the command line.
+// Unset options fall back to the "pr" defaults of the config.
+type Options struct {
+	Remote string
+	Base   string
+	// Draft is --draft or --no-draft, nil when neither was given
+	Draft     *bool
+	Labels    []string
+	Reviewers []string
+}
+
+// OpenPR commits the change directory on a new branch, in a temporary
+// worktree so the current checkout is left alone, pushes it, and opens a
+// pull request proposing the change
+func OpenPR(projectRoot, changeID string, opts Options) (*git.PullRequest, error) {
+	changeDir := filepath.Join(projectRoot, "spectr", "changes", changeID)
+
+	cfg, err := config.Load(projectRoot)
+	if err != nil {
+		return nil, err
+	}
+	cfg.PR.Apply(&opts.Base, &opts.Labels, &opts.Reviewers)
+	if opts.Remote == "" {
+		opts.Remote = cfg.Remote
+	}
+
+	target, err := git.ResolveTarget(opts.Remote, cfg.Platforms, cfg.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := BuildPRBody(changeDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// The worktree is named after the unique branch, so a run left
+	// behind by a crash does not block the next one
+	branch := git.GenerateUniqueBranchName("propose-" + changeID)
+	worktree := filepath.Join(os.TempDir(), "spectr-"+branch)
+	if err := git.CreateWorktree(worktree, branch); err != nil {
+		return nil, err
+	}
+	// deleteBranch is set when the branch has nothing to propose
+	deleteBranch := false
+	defer func() {
+		if err := git.RemoveWorktree(worktree); err != nil {
+			fmt.Fprintf(os.Stderr, "\nWarning: Failed to remove worktree: %v\n", err)
+		}
+		if !deleteBranch {
+			return
+		}
+		if err := git.DeleteBranch(branch); err != nil {
+			fmt.Fprintf(os.Stderr, "\nWarning: Failed to delete branch %s: %v\n", branch, err)
+		}
+	}()
+	fmt.Printf("Created worktree at: %s\n", worktree)
+
+	if err := commitChange(changeDir, changeID, worktree); errors.Is(err, errNothingToPropose) {
+		deleteBranch = true
+
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("%w. Branch %s created. Commit manually and push", err, branch)
+	}
+
+	if err := git.PushFromWorktree(worktree, target.Remote, branch); err != nil {
+		return nil, fmt.Errorf("%w. Branch %s committed. Push manually", err, branch)
+	}
+
+	pr, err := target.CreatePR(git.PROptions{
+		Title:     buildPRTitle(changeID),
+		Body:      body,
+		Branch:    branch,
+		Base:      opts.Base,
+		Draft:     cfg.PR.IsDraft(opts.Draft),
+		Labels:    opts.Labels,
+		Reviewers: opts.Reviewers,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w. Branch %s pushed. Create PR manually", err, branch)
+	}
+
+	return pr, nil
+}
+
+// errNothingToPropose is returned when the change is already committed
+// unchanged, so a proposal PR would be empty
+var errNothingToPropose = errors.New(
+	"change is already committed unchanged on the current branch; nothing to propose",
+)
+
+// commitChange copies the change directory into the worktree, replacing
+// the committed version, and commits it. It fails with
+// errNothingToPropose when there is nothing to commit.
+func commitChange(changeDir, changeID, worktree string) error {
+	target, err := git.WorktreePath(changeDir, worktree)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(target); err != nil {
+		return fmt.Errorf("clear change in worktree: %w", err)
+	}
+	if err := fsutil.CopyDir(changeDir, target); err != nil {
+		return fmt.Errorf("copy change to worktree: %w", err)
+	}
+
+	if err := git.StageInWorktree(worktree, []string{target}); err != nil {
+		return err
+	}
+	staged, err := git.HasStagedChanges(worktree)
+	if err != nil {
+		return err
+	}
+	if !staged {
+		return errNothingToPropose
+	}
+
+	return git.CommitInWorktree(worktree, buildCommitMessage(changeID))
+}
+
+// buildPRTitle returns the title of the proposal PR
+func buildPRTitle(changeID string) string {
+	return fmt.Sprintf("Propose: %s", changeID)
+}
+
+// buildCommitMessage returns the message of the proposal commit
+func buildCommitMessage(changeID string) string {
+	return fmt.Sprintf(
+		"Propose: %s\n\nAdd change proposal spectr/changes/%s/\n\nSpectr-Change: %s\n",
+		changeID,
+		changeID,
+		changeID,
+	)
+}
@@ -0,0 +1,53 @@
+package propose
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func gitIn(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v failed: %v\n%s", args, err, output)
+	}
+}
+
+func TestCommitChange(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	gitIn(t, dir, "init", "-q")
+	gitIn(t, dir, "config", "user.name", "test")
+	gitIn(t, dir, "config", "user.email", "test@example.com")
+
+	changeDir := filepath.Join(dir, "spectr", "changes", "add-sso")
+	if err := os.MkdirAll(changeDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	proposal := filepath.Join(changeDir, "proposal.md")
+	if err := os.WriteFile(proposal, []byte("# Change: Add SSO\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gitIn(t, dir, "add", "-A")
+	gitIn(t, dir, "commit", "-q", "-m", "Add SSO")
+
+	worktree := filepath.Join(t.TempDir(), "worktree")
+	gitIn(t, dir, "worktree", "add", "-q", "-b", "propose-add-sso", worktree)
+
+	// The change is committed unchanged, so the PR would be empty
+	if err := commitChange(changeDir, "add-sso", worktree); !errors.Is(err, errNothingToPropose) {
+		t.Errorf("Expected errNothingToPropose, got %v", err)
+	}
+
+	if err := os.WriteFile(proposal, []byte("# Change: Add SSO login\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := commitChange(changeDir, "add-sso", worktree); err != nil {
+		t.Errorf("commitChange failed: %v", err)
+	}
+}
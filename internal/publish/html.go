@@ -0,0 +1,236 @@
+package publish
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+	"github.com/connerohnesorge/spectr/internal/view"
+)
+
+const (
+	dirPerm  = 0o755
+	filePerm = 0o644
+
+	indexPage   = "index.html"
+	archivePage = "archive.html"
+)
+
+// templateFuncs are available to every site template
+var templateFuncs = template.FuncMap{
+	"md":         renderMarkdown,
+	"specPage":   specPage,
+	"changePage": changePage,
+	"progress":   progressMetrics,
+}
+
+var (
+	pageSet   = template.Must(template.New("pages").Funcs(templateFuncs).Parse(pageTemplates))
+	layoutSet = template.Must(template.New("layout").Funcs(templateFuncs).Parse(layoutTemplate))
+)
+
+// layoutData is passed to layoutTemplate
+type layoutData struct {
+	Title   string
+	Current string
+	Project *Project
+	Content template.HTML
+}
+
+// searchEntry is one item of the client-side search index
+type searchEntry struct {
+	Kind    string `json:"kind"`
+	Title   string `json:"title"`
+	Context string `json:"context,omitempty"`
+	URL     string `json:"url"`
+	Text    string `json:"text"`
+}
+
+// ExportHTML renders the project as a static site in outDir: a
+// dashboard (index.html), one page per capability and active change,
+// the archive history and a client-side search index. The site needs
+// no network access. It returns the paths of the files written.
+func ExportHTML(projectPath, outDir string) ([]string, error) {
+	project, err := Collect(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(outDir, dirPerm); err != nil {
+		return nil, fmt.Errorf("create output directory: %w", err)
+	}
+
+	w := &siteWriter{outDir: outDir, project: project}
+
+	w.page(indexPage, "Dashboard", "dashboard", project.Dashboard)
+	w.page(archivePage, "Archive", "archive", project)
+	for i := range project.Capabilities {
+		capability := &project.Capabilities[i]
+		w.page(specPage(capability.ID), capability.Title, "capability", capability)
+	}
+	for i := range project.Changes {
+		change := &project.Changes[i]
+		w.page(changePage(change.ID), change.Title, "change", change)
+	}
+
+	index, err := json.Marshal(buildSearchIndex(project))
+	if err != nil {
+		return nil, fmt.Errorf("encode search index: %w", err)
+	}
+	w.file("search-index.js", "window.SPECTR_SEARCH = "+string(index)+";\n")
+	w.file("search.js", searchScript)
+	w.file("style.css", styleSheet)
+
+	if w.err != nil {
+		return nil, w.err
+	}
+
+	return w.written, nil
+}
+
+// siteWriter writes pages into the output directory, remembering the
+// first error so callers can check once at the end
+type siteWriter struct {
+	outDir  string
+	project *Project
+	written []string
+	err     error
+}
+
+// page renders a page body template inside the layout and writes it
+func (w *siteWriter) page(name, title, body string, data any) {
+	if w.err != nil {
+		return
+	}
+
+	var content bytes.Buffer
+	if err := pageSet.ExecuteTemplate(&content, body, data); err != nil {
+		w.err = fmt.Errorf("render %s: %w", name, err)
+
+		return
+	}
+
+	var out bytes.Buffer
+	err := layoutSet.Execute(&out, layoutData{
+		Title:   title,
+		Current: name,
+		Project: w.project,
+		//nolint:gosec // content was produced by html/template
+		Content: template.HTML(content.String()),
+	})
+	if err != nil {
+		w.err = fmt.Errorf("render %s: %w", name, err)
+
+		return
+	}
+
+	w.file(name, out.String())
+}
+
+// file writes content to name inside the output directory
+func (w *siteWriter) file(name, content string) {
+	if w.err != nil {
+		return
+	}
+
+	path := filepath.Join(w.outDir, name)
+	if err := os.WriteFile(path, []byte(content), filePerm); err != nil {
+		w.err = fmt.Errorf("write %s: %w", path, err)
+
+		return
+	}
+	w.written = append(w.written, path)
+}
+
+// buildSearchIndex lists every capability, requirement, scenario and
+// change with the text the search box matches against
+func buildSearchIndex(project *Project) []searchEntry {
+	var entries []searchEntry
+
+	for _, capability := range project.Capabilities {
+		page := specPage(capability.ID)
+		entries = append(entries, searchEntry{
+			Kind:  "Capability",
+			Title: capability.Title,
+			URL:   page,
+			Text:  capability.ID + " " + capability.Purpose,
+		})
+
+		for _, req := range capability.Requirements {
+			url := page + "#" + req.Anchor
+			entries = append(entries, searchEntry{
+				Kind:    "Requirement",
+				Title:   req.Name,
+				Context: capability.ID,
+				URL:     url,
+				Text:    req.Description,
+			})
+
+			for _, scenario := range req.Scenarios {
+				entries = append(entries, searchEntry{
+					Kind:    "Scenario",
+					Title:   scenario.Name,
+					Context: capability.ID + " · " + req.Name,
+					URL:     url,
+					Text:    scenarioText(scenario),
+				})
+			}
+		}
+	}
+
+	for _, change := range project.Changes {
+		entries = append(entries, searchEntry{
+			Kind:  "Change",
+			Title: change.Title,
+			URL:   changePage(change.ID),
+			Text:  change.ID + " " + change.Proposal,
+		})
+	}
+
+	return entries
+}
+
+// scenarioText joins a scenario's steps into searchable text
+func scenarioText(scenario parsers.Scenario) string {
+	parts := make([]string, 0, len(scenario.Steps))
+	for _, step := range scenario.Steps {
+		parts = append(parts, step.Text)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// specPage returns the page file name of a capability
+func specPage(id string) string {
+	return "spec-" + pageSlug(id) + ".html"
+}
+
+// changePage returns the page file name of an active change
+func changePage(id string) string {
+	return "change-" + pageSlug(id) + ".html"
+}
+
+// pageSlug flattens IDs into file names, so nested capability IDs such
+// as "api/auth" become "api--auth"
+func pageSlug(id string) string {
+	return strings.ReplaceAll(filepath.ToSlash(id), "/", "--")
+}
+
+// progressMetrics converts a task count into dashboard progress metrics
+func progressMetrics(status parsers.TaskStatus) view.ProgressMetrics {
+	percentage := 0
+	if status.Total > 0 {
+		percentage = status.Completed * 100 / status.Total
+	}
+
+	return view.ProgressMetrics{
+		Total:      status.Total,
+		Completed:  status.Completed,
+		Percentage: percentage,
+	}
+}
@@ -0,0 +1,96 @@
+package publish
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExportHTML(t *testing.T) {
+	root := writeProject(t)
+	outDir := filepath.Join(root, "site")
+
+	files, err := ExportHTML(root, outDir)
+	if err != nil {
+		t.Fatalf("ExportHTML failed: %v", err)
+	}
+
+	for _, name := range []string{
+		"index.html",
+		"archive.html",
+		"spec-auth.html",
+		"change-add-logout.html",
+		"search-index.js",
+		"search.js",
+		"style.css",
+	} {
+		if _, err := os.Stat(filepath.Join(outDir, name)); err != nil {
+			t.Errorf("Expected %s to be written: %v", name, err)
+		}
+	}
+	if len(files) != 7 {
+		t.Errorf("Expected 7 files, got %d: %v", len(files), files)
+	}
+
+	read := func(name string) string {
+		content, err := os.ReadFile(filepath.Join(outDir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return string(content)
+	}
+
+	spec := read("spec-auth.html")
+	for _, want := range []string{
+		`<section class="requirement" id="req-user-login">`,
+		`<a href="#req-user-login">User Login</a>`,
+		"<strong>WHEN</strong> valid credentials are submitted",
+		`<a href="spec-auth.html" class="current">auth</a>`,
+	} {
+		if !strings.Contains(spec, want) {
+			t.Errorf("spec page missing %q", want)
+		}
+	}
+
+	change := read("change-add-logout.html")
+	for _, want := range []string{
+		"Requirement: Logout",
+		"<del>Remember Me</del>",
+		"New capability <code>audit</code>",
+		"50% (1/2)",
+		"<h3>Why</h3>",
+	} {
+		if !strings.Contains(change, want) {
+			t.Errorf("change page missing %q", want)
+		}
+	}
+
+	if !strings.Contains(read("archive.html"), "2024-01-15") {
+		t.Error("archive page missing archived change")
+	}
+
+	index := read("search-index.js")
+	if !strings.HasPrefix(index, "window.SPECTR_SEARCH = [") ||
+		!strings.Contains(index, `"url":"spec-auth.html#req-user-login"`) {
+		t.Errorf("Unexpected search index: %s", index)
+	}
+
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(content), "http://") ||
+			strings.Contains(string(content), "https://") {
+			t.Errorf("%s references the network", file)
+		}
+	}
+}
+
+func TestPageSlug(t *testing.T) {
+	if got := specPage("api/auth"); got != "spec-api--auth.html" {
+		t.Errorf("specPage(api/auth) = %q", got)
+	}
+}
@@ -0,0 +1,186 @@
+package publish
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"regexp"
+	"strings"
+)
+
+var (
+	mdHeadingPattern  = regexp.MustCompile(`^(#{1,6})\s+(.+)$`)
+	mdBulletPattern   = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	mdOrderedPattern  = regexp.MustCompile(`^(\s*)\d+[.)]\s+(.*)$`)
+	mdCheckboxPattern = regexp.MustCompile(`^\[([ xX])\]\s*(.*)$`)
+	mdCodePattern     = regexp.MustCompile("`([^`]+)`")
+	mdBoldPattern     = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+	mdItalicPattern   = regexp.MustCompile(`(^|[^*])\*([^*\s][^*]*)\*`)
+	mdLinkPattern     = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+)
+
+// markdownRenderer converts the subset of markdown used in Spectr
+// documents into HTML: headings, paragraphs, bullet, numbered and task
+// lists, fenced code blocks, inline code, bold, italics and links.
+// Raw HTML in the source is escaped.
+type markdownRenderer struct {
+	sb        strings.Builder
+	paragraph []string
+	listTag   string
+	inCode    bool
+	// headingLevel offsets headings so documents nest under page titles
+	headingLevel int
+}
+
+// renderMarkdown renders markdown with headings shifted down by
+// headingOffset levels
+func renderMarkdown(src string, headingOffset int) template.HTML {
+	r := &markdownRenderer{headingLevel: headingOffset}
+
+	for line := range strings.SplitSeq(src, "\n") {
+		r.line(line)
+	}
+	r.flushParagraph()
+	r.closeList()
+	if r.inCode {
+		r.sb.WriteString("</code></pre>\n")
+	}
+
+	//nolint:gosec // all source text is escaped before being emitted
+	return template.HTML(r.sb.String())
+}
+
+// line renders a single source line
+func (r *markdownRenderer) line(line string) {
+	trimmed := strings.TrimSpace(line)
+
+	if strings.HasPrefix(trimmed, "```") {
+		r.flushParagraph()
+		r.closeList()
+		if r.inCode {
+			r.sb.WriteString("</code></pre>\n")
+		} else {
+			r.sb.WriteString("<pre><code>")
+		}
+		r.inCode = !r.inCode
+
+		return
+	}
+	if r.inCode {
+		r.sb.WriteString(html.EscapeString(line) + "\n")
+
+		return
+	}
+
+	if trimmed == "" {
+		r.flushParagraph()
+		r.closeList()
+
+		return
+	}
+
+	if matches := mdHeadingPattern.FindStringSubmatch(trimmed); matches != nil {
+		r.flushParagraph()
+		r.closeList()
+		level := min(len(matches[1])+r.headingLevel, 6)
+		fmt.Fprintf(&r.sb, "<h%d>%s</h%d>\n", level, inline(matches[2]), level)
+
+		return
+	}
+
+	if matches := mdBulletPattern.FindStringSubmatch(line); matches != nil {
+		r.listItem("ul", matches[2])
+
+		return
+	}
+	if matches := mdOrderedPattern.FindStringSubmatch(line); matches != nil {
+		r.listItem("ol", matches[2])
+
+		return
+	}
+
+	r.closeList()
+	r.paragraph = append(r.paragraph, trimmed)
+}
+
+// listItem renders a list item, opening a list of tag when needed
+func (r *markdownRenderer) listItem(tag, text string) {
+	r.flushParagraph()
+	if r.listTag != tag {
+		r.closeList()
+		fmt.Fprintf(&r.sb, "<%s>\n", tag)
+		r.listTag = tag
+	}
+
+	if matches := mdCheckboxPattern.FindStringSubmatch(text); matches != nil {
+		checked := ""
+		if matches[1] != " " {
+			checked = " checked"
+		}
+		fmt.Fprintf(
+			&r.sb,
+			"<li class=\"task\"><input type=\"checkbox\" disabled%s> %s</li>\n",
+			checked,
+			inline(matches[2]),
+		)
+
+		return
+	}
+
+	fmt.Fprintf(&r.sb, "<li>%s</li>\n", inline(text))
+}
+
+// flushParagraph emits buffered paragraph lines
+func (r *markdownRenderer) flushParagraph() {
+	if len(r.paragraph) == 0 {
+		return
+	}
+
+	fmt.Fprintf(&r.sb, "<p>%s</p>\n", inline(strings.Join(r.paragraph, " ")))
+	r.paragraph = nil
+}
+
+// closeList closes the currently open list, if any
+func (r *markdownRenderer) closeList() {
+	if r.listTag == "" {
+		return
+	}
+
+	fmt.Fprintf(&r.sb, "</%s>\n", r.listTag)
+	r.listTag = ""
+}
+
+// inline escapes text and applies inline code, bold, italic and link
+// formatting. Code spans are protected from further formatting.
+func inline(text string) string {
+	var codes []string
+	text = mdCodePattern.ReplaceAllStringFunc(text, func(match string) string {
+		codes = append(codes, match[1:len(match)-1])
+
+		return fmt.Sprintf("\x00%d\x00", len(codes)-1)
+	})
+
+	text = html.EscapeString(text)
+	text = mdBoldPattern.ReplaceAllString(text, "<strong>$1</strong>")
+	text = mdItalicPattern.ReplaceAllString(text, "$1<em>$2</em>")
+	text = mdLinkPattern.ReplaceAllStringFunc(text, func(match string) string {
+		parts := mdLinkPattern.FindStringSubmatch(match)
+		href := parts[2]
+		if strings.HasPrefix(strings.ToLower(href), "javascript:") {
+			href = "#"
+		}
+
+		return fmt.Sprintf("<a href=\"%s\">%s</a>", href, parts[1])
+	})
+
+	for i, code := range codes {
+		text = strings.Replace(
+			text,
+			fmt.Sprintf("\x00%d\x00", i),
+			"<code>"+html.EscapeString(code)+"</code>",
+			1,
+		)
+	}
+
+	return text
+}
@@ -0,0 +1,335 @@
+package publish
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/ids"
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+const (
+	appendixAnchor   = "appendix-active-changes"
+	documentTitle    = "Specifications"
+	capabilityAnchor = "cap-"
+	changeAnchor     = "change-"
+)
+
+var (
+	markdownHeadingRex = regexp.MustCompile(`^(#{1,6})(\s+.*)$`)
+)
+
+// ExportMarkdown renders the project's specs as one Markdown document
+// suitable for pandoc: a title block, a table of contents, every
+// capability in ID order with numbered requirements (e.g. VAL-3) and
+// scenarios (VAL-3.2), and an appendix of active changes whose deltas
+// link to the requirements they touch. When capabilities is non-empty
+// only those capabilities, and the changes touching them, are included.
+func ExportMarkdown(projectPath string, capabilities []string) (string, error) {
+	project, err := Collect(projectPath)
+	if err != nil {
+		return "", err
+	}
+
+	for _, id := range capabilities {
+		if !project.HasCapability(id) {
+			return "", fmt.Errorf("spec '%s' not found", id)
+		}
+	}
+
+	return FormatMarkdown(project, capabilities), nil
+}
+
+// FormatMarkdown renders an already collected project; see ExportMarkdown
+func FormatMarkdown(project *Project, capabilities []string) string {
+	capabilityIDs := make([]string, 0, len(project.Capabilities))
+	for _, capability := range project.Capabilities {
+		capabilityIDs = append(capabilityIDs, capability.ID)
+	}
+	prefixes := ids.Prefixes(capabilityIDs)
+
+	included := func(id string) bool {
+		return len(capabilities) == 0 || slices.Contains(capabilities, id)
+	}
+
+	var selected []Capability
+	for _, capability := range project.Capabilities {
+		if included(capability.ID) {
+			selected = append(selected, capability)
+		}
+	}
+
+	// Without a filter every active change is listed, including those
+	// without delta specs
+	changes := project.Changes
+	if len(capabilities) > 0 {
+		changes = nil
+		for _, change := range project.Changes {
+			if slices.ContainsFunc(change.Deltas, func(delta Delta) bool {
+				return included(delta.Capability)
+			}) {
+				changes = append(changes, change)
+			}
+		}
+	}
+
+	doc := &markdownDoc{project: project, prefixes: prefixes}
+	doc.title()
+	doc.toc(selected, changes)
+	for i, capability := range selected {
+		doc.capability(i+1, capability)
+	}
+	doc.appendix(changes, included)
+
+	return strings.TrimRight(doc.sb.String(), "\n") + "\n"
+}
+
+// markdownDoc accumulates the consolidated document
+type markdownDoc struct {
+	sb       strings.Builder
+	project  *Project
+	prefixes map[string]string
+}
+
+// requirementID returns the number of a requirement, e.g. "VAL-3".
+// It returns "" when the capability has no such requirement.
+func (d *markdownDoc) requirementID(capabilityID, name string) string {
+	for _, capability := range d.project.Capabilities {
+		if capability.ID != capabilityID {
+			continue
+		}
+		for i, req := range capability.Requirements {
+			if parsers.NormalizeRequirementName(req.Name) ==
+				parsers.NormalizeRequirementName(name) {
+				return fmt.Sprintf("%s-%d", d.prefixes[capabilityID], i+1)
+			}
+		}
+	}
+
+	return ""
+}
+
+// requirementRef renders a requirement name, linked to its numbered
+// heading when it exists in the current specs
+func (d *markdownDoc) requirementRef(capabilityID, name string) string {
+	id := d.requirementID(capabilityID, name)
+	if id == "" {
+		return name
+	}
+
+	return fmt.Sprintf("[%s %s](#%s)", id, name, strings.ToLower(id))
+}
+
+// title writes the pandoc title block
+func (d *markdownDoc) title() {
+	fmt.Fprintf(&d.sb, "---\ntitle: %q\n---\n\n", documentTitle)
+}
+
+// toc writes the table of contents
+func (d *markdownDoc) toc(capabilities []Capability, changes []Change) {
+	d.sb.WriteString("# Table of Contents\n\n")
+
+	for i, capability := range capabilities {
+		fmt.Fprintf(
+			&d.sb,
+			"- [%d. %s](#%s%s)\n",
+			i+1,
+			capability.Title,
+			capabilityAnchor,
+			pageSlug(capability.ID),
+		)
+		for j, req := range capability.Requirements {
+			id := fmt.Sprintf("%s-%d", d.prefixes[capability.ID], j+1)
+			fmt.Fprintf(
+				&d.sb,
+				"  - [%s %s](#%s)\n",
+				id,
+				req.Name,
+				strings.ToLower(id),
+			)
+		}
+	}
+
+	if len(changes) > 0 {
+		fmt.Fprintf(
+			&d.sb,
+			"- [Appendix A: Active Changes](#%s)\n",
+			appendixAnchor,
+		)
+	}
+	d.sb.WriteString("\n")
+}
+
+// capability writes one numbered capability section
+func (d *markdownDoc) capability(number int, capability Capability) {
+	prefix := d.prefixes[capability.ID]
+
+	fmt.Fprintf(
+		&d.sb,
+		"# %d. %s {#%s%s}\n\n",
+		number,
+		capability.Title,
+		capabilityAnchor,
+		pageSlug(capability.ID),
+	)
+	fmt.Fprintf(
+		&d.sb,
+		"Capability `%s` · Requirements `%s-*`\n\n",
+		capability.ID,
+		prefix,
+	)
+
+	if capability.Purpose != "" {
+		d.sb.WriteString(shiftHeadings(capability.Purpose, 1) + "\n\n")
+	}
+
+	for i, req := range capability.Requirements {
+		id := fmt.Sprintf("%s-%d", prefix, i+1)
+		d.requirement(id, req)
+	}
+}
+
+// requirement writes a numbered requirement with its scenarios
+func (d *markdownDoc) requirement(id string, req Requirement) {
+	fmt.Fprintf(
+		&d.sb,
+		"## %s %s {#%s}\n\n",
+		id,
+		req.Name,
+		strings.ToLower(id),
+	)
+
+	if req.Description != "" {
+		d.sb.WriteString(shiftHeadings(req.Description, 2) + "\n\n")
+	}
+
+	for i, scenario := range req.Scenarios {
+		fmt.Fprintf(&d.sb, "### %s.%d %s\n\n", id, i+1, scenario.Name)
+		writeMarkdownSteps(&d.sb, scenario.Steps)
+		d.sb.WriteString("\n")
+	}
+}
+
+// appendix writes the active changes appendix
+func (d *markdownDoc) appendix(changes []Change, included func(string) bool) {
+	if len(changes) == 0 {
+		return
+	}
+
+	fmt.Fprintf(
+		&d.sb,
+		"# Appendix A: Active Changes {#%s}\n\n",
+		appendixAnchor,
+	)
+
+	for _, change := range changes {
+		fmt.Fprintf(
+			&d.sb,
+			"## %s {#%s%s}\n\n",
+			change.Title,
+			changeAnchor,
+			pageSlug(change.ID),
+		)
+		fmt.Fprintf(
+			&d.sb,
+			"Change `%s` · %d/%d tasks complete\n\n",
+			change.ID,
+			change.Progress.Completed,
+			change.Progress.Total,
+		)
+
+		if change.Proposal != "" {
+			d.sb.WriteString(shiftHeadings(change.Proposal, 1) + "\n\n")
+		}
+
+		for _, delta := range change.Deltas {
+			if included(delta.Capability) {
+				d.delta(delta)
+			}
+		}
+	}
+}
+
+// delta writes the operations a change applies to one capability
+func (d *markdownDoc) delta(delta Delta) {
+	if delta.NewCapability {
+		fmt.Fprintf(&d.sb, "### New capability `%s`\n\n", delta.Capability)
+	} else {
+		fmt.Fprintf(
+			&d.sb,
+			"### Changes to [`%s`](#%s%s)\n\n",
+			delta.Capability,
+			capabilityAnchor,
+			pageSlug(delta.Capability),
+		)
+	}
+
+	for _, req := range delta.Added {
+		fmt.Fprintf(&d.sb, "- **ADDED** %s\n", req.Name)
+	}
+	for _, req := range delta.Modified {
+		fmt.Fprintf(
+			&d.sb,
+			"- **MODIFIED** %s\n",
+			d.requirementRef(delta.Capability, req.Name),
+		)
+	}
+	for _, name := range delta.Removed {
+		fmt.Fprintf(
+			&d.sb,
+			"- **REMOVED** %s\n",
+			d.requirementRef(delta.Capability, name),
+		)
+	}
+	for _, op := range delta.Renamed {
+		fmt.Fprintf(
+			&d.sb,
+			"- **RENAMED** %s → %s\n",
+			d.requirementRef(delta.Capability, op.From),
+			op.To,
+		)
+	}
+	d.sb.WriteString("\n")
+}
+
+// writeMarkdownSteps writes scenario steps as bold-keyword bullets
+func writeMarkdownSteps(sb *strings.Builder, steps []parsers.ScenarioStep) {
+	for _, step := range steps {
+		if step.Keyword == "" {
+			fmt.Fprintf(sb, "- %s\n", step.Text)
+
+			continue
+		}
+		fmt.Fprintf(sb, "- **%s** %s\n", step.Keyword, step.Text)
+	}
+}
+
+// shiftHeadings demotes markdown headings by levels (capped at six)
+// so embedded documents nest under the consolidated outline. Fenced
+// code blocks are left untouched.
+func shiftHeadings(src string, levels int) string {
+	lines := strings.Split(src, "\n")
+	inCode := false
+
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+
+			continue
+		}
+		if inCode {
+			continue
+		}
+
+		matches := markdownHeadingRex.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+		depth := min(len(matches[1])+levels, 6)
+		lines[i] = strings.Repeat("#", depth) + matches[2]
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,94 @@
+package publish
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExportMarkdown(t *testing.T) {
+	root := writeProject(t)
+
+	doc, err := ExportMarkdown(root, nil)
+	if err != nil {
+		t.Fatalf("ExportMarkdown failed: %v", err)
+	}
+
+	for _, want := range []string{
+		"---\ntitle: \"Specifications\"\n---\n",
+		"- [1. Auth Specification](#cap-auth)\n  - [AUT-1 User Login](#aut-1)\n",
+		"- [Appendix A: Active Changes](#appendix-active-changes)",
+		"# 1. Auth Specification {#cap-auth}",
+		"## AUT-1 User Login {#aut-1}\n\nThe system SHALL authenticate users.",
+		"### AUT-1.1 Valid credentials\n\n- **WHEN** valid credentials are submitted",
+		"## Add Logout {#change-add-logout}",
+		"Change `add-logout` · 1/2 tasks complete",
+		"### Why\n\nUsers need to sign out.",
+		"### Changes to [`auth`](#cap-auth)",
+		"- **ADDED** Logout",
+		"- **REMOVED** Remember Me",
+		"### New capability `audit`",
+	} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("Document missing %q\n%s", want, doc)
+		}
+	}
+
+	again, err := ExportMarkdown(root, nil)
+	if err != nil || again != doc {
+		t.Error("Expected identical output across runs")
+	}
+}
+
+func TestExportMarkdown_CapabilityFilter(t *testing.T) {
+	root := writeProject(t)
+
+	if _, err := ExportMarkdown(root, []string{"missing"}); err == nil {
+		t.Error("Expected error for unknown capability")
+	}
+
+	project, err := Collect(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	project.Changes[0].Deltas = project.Changes[0].Deltas[:1]
+	project.Changes[0].Deltas[0].Capability = "audit"
+
+	doc := FormatMarkdown(project, []string{"auth"})
+	if strings.Contains(doc, "Appendix A") {
+		t.Errorf("Expected no appendix when no change touches auth\n%s", doc)
+	}
+}
+
+func TestExportMarkdown_RequirementLinks(t *testing.T) {
+	project := &Project{
+		Capabilities: []Capability{{
+			ID:    "auth",
+			Title: "Auth",
+			Requirements: []Requirement{
+				{Name: "Login"},
+				{Name: "Logout"},
+			},
+		}},
+		Changes: []Change{{
+			ID:    "rework",
+			Title: "Rework",
+			Deltas: []Delta{{
+				Capability: "auth",
+				Modified:   []Requirement{{Name: "logout"}},
+			}},
+		}},
+	}
+
+	doc := FormatMarkdown(project, nil)
+	if !strings.Contains(doc, "- **MODIFIED** [AUT-2 logout](#aut-2)") {
+		t.Errorf("Expected MODIFIED to link to numbered requirement\n%s", doc)
+	}
+}
+
+func TestShiftHeadings(t *testing.T) {
+	src := "## Why\n```\n# not a heading\n```\n###### Deep"
+	want := "### Why\n```\n# not a heading\n```\n###### Deep"
+	if got := shiftHeadings(src, 1); got != want {
+		t.Errorf("shiftHeadings() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,46 @@
+package publish
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	src := "## Why\n\n" +
+		"Some **bold** and *italic* text with `<code>`\n" +
+		"continued on a second line.\n\n" +
+		"- [x] done\n" +
+		"- [ ] todo\n\n" +
+		"1. first\n" +
+		"2. see [docs](https://example.com)\n\n" +
+		"```\n<raw> & stuff\n```\n" +
+		"<script>alert(1)</script>\n"
+
+	got := string(renderMarkdown(src, 1))
+
+	for _, want := range []string{
+		"<h3>Why</h3>",
+		"<p>Some <strong>bold</strong> and <em>italic</em> text with <code>&lt;code&gt;</code> continued on a second line.</p>",
+		"<li class=\"task\"><input type=\"checkbox\" disabled checked> done</li>",
+		"<li class=\"task\"><input type=\"checkbox\" disabled> todo</li>",
+		"<ol>\n<li>first</li>",
+		"<a href=\"https://example.com\">docs</a>",
+		"<pre><code>&lt;raw&gt; &amp; stuff\n</code></pre>",
+		"&lt;script&gt;alert(1)&lt;/script&gt;",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Rendered markdown missing %q\n%s", want, got)
+		}
+	}
+
+	if strings.Contains(got, "<script>") {
+		t.Error("Raw HTML must be escaped")
+	}
+}
+
+func TestInline_JavascriptLink(t *testing.T) {
+	got := inline("[click](javascript:alert(1))")
+	if strings.Contains(got, "javascript:") {
+		t.Errorf("Expected javascript link to be neutralized, got %q", got)
+	}
+}
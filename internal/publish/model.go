@@ -0,0 +1,334 @@
+// Package publish renders a Spectr project into documents meant for
+// readers outside the repository: a static HTML site and a single
+// consolidated Markdown document.
+//
+// Collect gathers capabilities, active changes, archive history and
+// dashboard metrics into a Project that the renderers consume.
+package publish
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/parsers"
+	"github.com/connerohnesorge/spectr/internal/validation"
+	"github.com/connerohnesorge/spectr/internal/view"
+)
+
+const (
+	specFile     = "spec.md"
+	proposalFile = "proposal.md"
+	tasksFile    = "tasks.md"
+	archiveDir   = "archive"
+)
+
+var (
+	archiveNamePattern = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})-(.+)$`)
+	nonAnchorPattern   = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// Project is everything a renderer needs to publish a Spectr project
+type Project struct {
+	Capabilities []Capability
+	Changes      []Change
+	Archive      []ArchivedChange
+	Dashboard    *view.DashboardData
+}
+
+// HasCapability reports whether a spec with the given ID exists
+func (p *Project) HasCapability(id string) bool {
+	for _, capability := range p.Capabilities {
+		if capability.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Capability is a spec from spectr/specs/<id>/spec.md
+type Capability struct {
+	ID           string
+	Title        string
+	Purpose      string
+	Requirements []Requirement
+}
+
+// Requirement is a single requirement of a capability or delta
+type Requirement struct {
+	Name        string
+	Anchor      string
+	Description string
+	Scenarios   []parsers.Scenario
+}
+
+// Change is an active change proposal with its delta specs
+type Change struct {
+	ID       string
+	Title    string
+	Proposal string
+	Tasks    string
+	Progress parsers.TaskStatus
+	Deltas   []Delta
+}
+
+// Delta is the set of operations a change applies to one capability
+type Delta struct {
+	Capability string
+	// NewCapability is set when the capability has no spec yet
+	NewCapability bool
+	Added         []Requirement
+	Modified      []Requirement
+	Removed       []string
+	Renamed       []parsers.RenameOp
+}
+
+// ArchivedChange is a change moved to spectr/changes/archive
+type ArchivedChange struct {
+	// Name is the archive directory name, e.g. "2024-01-15-add-auth"
+	Name  string
+	ID    string
+	Date  string
+	Title string
+	// Capabilities lists the capabilities the change touched
+	Capabilities []string
+}
+
+// Collect reads specs, active changes, archived changes and dashboard
+// metrics from the project. Capabilities and changes are sorted by ID,
+// archived changes newest first.
+func Collect(projectPath string) (*Project, error) {
+	dashboard, err := view.CollectData(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	project := &Project{Dashboard: dashboard}
+
+	specIDs, err := discovery.GetSpecs(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(specIDs)
+	for _, id := range specIDs {
+		capability, err := collectCapability(projectPath, id)
+		if err != nil {
+			return nil, err
+		}
+		project.Capabilities = append(project.Capabilities, *capability)
+	}
+
+	changeIDs, err := discovery.GetActiveChanges(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range changeIDs {
+		change, err := collectChange(projectPath, id)
+		if err != nil {
+			return nil, err
+		}
+		for j := range change.Deltas {
+			delta := &change.Deltas[j]
+			delta.NewCapability = !project.HasCapability(delta.Capability)
+		}
+		project.Changes = append(project.Changes, *change)
+	}
+
+	project.Archive, err = collectArchive(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// collectCapability parses a single spec into a Capability
+func collectCapability(projectPath, id string) (*Capability, error) {
+	specPath := filepath.Join(projectPath, "spectr", "specs", id, specFile)
+
+	content, err := os.ReadFile(specPath)
+	if err != nil {
+		return nil, err
+	}
+
+	title, err := parsers.ExtractTitle(specPath)
+	if err != nil || title == "" {
+		title = id
+	}
+
+	blocks, err := parsers.ParseRequirements(specPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Capability{
+		ID:           id,
+		Title:        title,
+		Purpose:      validation.ExtractSections(string(content))["Purpose"],
+		Requirements: toRequirements(blocks),
+	}, nil
+}
+
+// collectChange reads a change's proposal, tasks and delta specs
+func collectChange(projectPath, id string) (*Change, error) {
+	changeDir := filepath.Join(projectPath, "spectr", "changes", id)
+
+	title, err := parsers.ExtractTitle(filepath.Join(changeDir, proposalFile))
+	if err != nil || title == "" {
+		title = id
+	}
+
+	// Progress stays zero when tasks.md is missing
+	progress, _ := parsers.CountTasks(filepath.Join(changeDir, tasksFile))
+
+	deltas, err := collectDeltas(changeDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Change{
+		ID:       id,
+		Title:    title,
+		Proposal: readBody(filepath.Join(changeDir, proposalFile)),
+		Tasks:    readBody(filepath.Join(changeDir, tasksFile)),
+		Progress: progress,
+		Deltas:   deltas,
+	}, nil
+}
+
+// collectDeltas parses every delta spec under <changeDir>/specs
+func collectDeltas(changeDir string) ([]Delta, error) {
+	specsDir := filepath.Join(changeDir, "specs")
+	if _, err := os.Stat(specsDir); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	var deltas []Delta
+	err := filepath.WalkDir(specsDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || d.Name() != specFile {
+			return nil
+		}
+
+		plan, err := parsers.ParseDeltaSpec(path)
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(specsDir, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+
+		deltas = append(deltas, Delta{
+			Capability: filepath.ToSlash(rel),
+			Added:      toRequirements(plan.Added),
+			Modified:   toRequirements(plan.Modified),
+			Removed:    plan.Removed,
+			Renamed:    plan.Renamed,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deltas, nil
+}
+
+// collectArchive lists archived changes, newest first
+func collectArchive(projectPath string) ([]ArchivedChange, error) {
+	dir := filepath.Join(projectPath, "spectr", "changes", archiveDir)
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var archived []ArchivedChange
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		item := ArchivedChange{Name: entry.Name(), ID: entry.Name()}
+		if matches := archiveNamePattern.FindStringSubmatch(entry.Name()); matches != nil {
+			item.Date = matches[1]
+			item.ID = matches[2]
+		}
+
+		changeDir := filepath.Join(dir, entry.Name())
+		item.Title, err = parsers.ExtractTitle(filepath.Join(changeDir, proposalFile))
+		if err != nil || item.Title == "" {
+			item.Title = item.ID
+		}
+
+		deltas, err := collectDeltas(changeDir)
+		if err != nil {
+			return nil, err
+		}
+		for _, delta := range deltas {
+			item.Capabilities = append(item.Capabilities, delta.Capability)
+		}
+
+		archived = append(archived, item)
+	}
+
+	sort.SliceStable(archived, func(i, j int) bool {
+		return archived[i].Name > archived[j].Name
+	})
+
+	return archived, nil
+}
+
+// toRequirements converts parsed requirement blocks into Requirements
+func toRequirements(blocks []parsers.RequirementBlock) []Requirement {
+	reqs := make([]Requirement, 0, len(blocks))
+	for _, block := range blocks {
+		reqs = append(reqs, Requirement{
+			Name:        block.Name,
+			Anchor:      Anchor(block.Name),
+			Description: parsers.RequirementDescription(block.Raw),
+			Scenarios:   parsers.ParseScenarioBlocks(block.Raw),
+		})
+	}
+
+	return reqs
+}
+
+// readBody returns a markdown file's content without its H1 title.
+// Missing files yield an empty string.
+func readBody(path string) string {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	lines := strings.Split(string(content), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "# ") {
+			lines = append(lines[:i], lines[i+1:]...)
+
+			break
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// Anchor converts a requirement name into a stable HTML anchor,
+// e.g. "User Login" becomes "req-user-login"
+func Anchor(name string) string {
+	slug := nonAnchorPattern.ReplaceAllString(strings.ToLower(name), "-")
+
+	return "req-" + strings.Trim(slug, "-")
+}
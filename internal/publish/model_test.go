@@ -0,0 +1,136 @@
+package publish
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testSpec = `# Auth Specification
+
+## Purpose
+
+Authenticate users.
+
+## Requirements
+
+### Requirement: User Login
+The system SHALL authenticate users.
+
+#### Scenario: Valid credentials
+- **WHEN** valid credentials are submitted
+- **THEN** a session is created
+`
+
+const testDelta = `## ADDED Requirements
+
+### Requirement: Logout
+The system SHALL end sessions.
+
+#### Scenario: User logs out
+- **WHEN** the user logs out
+- **THEN** the session is destroyed
+
+## REMOVED Requirements
+
+### Requirement: Remember Me
+`
+
+// writeProject creates a project with one spec, one active change with
+// deltas against an existing and a new capability, and one archived change
+func writeProject(t *testing.T) string {
+	t.Helper()
+
+	root := t.TempDir()
+	files := map[string]string{
+		"spectr/specs/auth/spec.md":                                     testSpec,
+		"spectr/changes/add-logout/proposal.md":                         "# Change: Add Logout\n\n## Why\n\nUsers need to sign out.\n",
+		"spectr/changes/add-logout/tasks.md":                            "## 1. Implementation\n- [x] 1.1 Parse\n- [ ] 1.2 Render\n",
+		"spectr/changes/add-logout/specs/auth/spec.md":                  testDelta,
+		"spectr/changes/add-logout/specs/audit/spec.md":                 "## ADDED Requirements\n\n### Requirement: Audit Log\nThe system SHALL log.\n",
+		"spectr/changes/archive/2024-01-15-add-auth/proposal.md":        "# Change: Add Auth\n",
+		"spectr/changes/archive/2024-01-15-add-auth/specs/auth/spec.md": "## ADDED Requirements\n",
+		"spectr/changes/archive/2024-03-01-tweak/proposal.md":           "# Tweak\n",
+	}
+	for rel, content := range files {
+		path := filepath.Join(root, rel)
+		if err := os.MkdirAll(filepath.Dir(path), dirPerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), filePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return root
+}
+
+func TestCollect(t *testing.T) {
+	project, err := Collect(writeProject(t))
+	if err != nil {
+		t.Fatalf("Collect failed: %v", err)
+	}
+
+	if len(project.Capabilities) != 1 {
+		t.Fatalf("Expected 1 capability, got %d", len(project.Capabilities))
+	}
+	auth := project.Capabilities[0]
+	if auth.Title != "Auth Specification" || auth.Purpose != "Authenticate users." {
+		t.Errorf("Unexpected capability: %+v", auth)
+	}
+	if len(auth.Requirements) != 1 || auth.Requirements[0].Anchor != "req-user-login" {
+		t.Fatalf("Unexpected requirements: %+v", auth.Requirements)
+	}
+	if len(auth.Requirements[0].Scenarios) != 1 {
+		t.Errorf("Expected 1 scenario, got %d", len(auth.Requirements[0].Scenarios))
+	}
+
+	if len(project.Changes) != 1 {
+		t.Fatalf("Expected 1 change, got %d", len(project.Changes))
+	}
+	change := project.Changes[0]
+	if change.Title != "Add Logout" || change.Progress.Total != 2 || change.Progress.Completed != 1 {
+		t.Errorf("Unexpected change: %+v", change)
+	}
+	if len(change.Deltas) != 2 {
+		t.Fatalf("Expected 2 deltas, got %d", len(change.Deltas))
+	}
+	for _, delta := range change.Deltas {
+		switch delta.Capability {
+		case "audit":
+			if !delta.NewCapability {
+				t.Error("Expected audit to be a new capability")
+			}
+		case "auth":
+			if delta.NewCapability || len(delta.Added) != 1 || len(delta.Removed) != 1 {
+				t.Errorf("Unexpected auth delta: %+v", delta)
+			}
+		default:
+			t.Errorf("Unexpected delta capability %q", delta.Capability)
+		}
+	}
+
+	if len(project.Archive) != 2 {
+		t.Fatalf("Expected 2 archived changes, got %d", len(project.Archive))
+	}
+	latest := project.Archive[0]
+	if latest.ID != "tweak" || latest.Date != "2024-03-01" || latest.Title != "Tweak" {
+		t.Errorf("Expected newest archive first, got %+v", latest)
+	}
+	if got := project.Archive[1].Capabilities; len(got) != 1 || got[0] != "auth" {
+		t.Errorf("Unexpected archived capabilities: %v", got)
+	}
+}
+
+func TestAnchor(t *testing.T) {
+	tests := map[string]string{
+		"User Login":            "req-user-login",
+		"  CLI: --json output ": "req-cli-json-output",
+		"Élan":                  "req-lan",
+	}
+	for name, want := range tests {
+		if got := Anchor(name); got != want {
+			t.Errorf("Anchor(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
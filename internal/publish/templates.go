@@ -0,0 +1,292 @@
+//nolint:revive // line-length-limit - embedded HTML, CSS and JS read best unwrapped
+package publish
+
+// layoutTemplate wraps every page with the navigation sidebar and the
+// search box. Pages live side by side in the output directory so all
+// links are plain relative file names.
+const layoutTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}} · Spectr</title>
+<link rel="stylesheet" href="style.css">
+</head>
+<body>
+<nav class="sidebar">
+  <a class="brand" href="index.html">Spectr</a>
+  <input id="search" type="search" placeholder="Search specs…" autocomplete="off">
+  <ul id="search-results" hidden></ul>
+  <h2>Overview</h2>
+  <ul>
+    <li><a href="index.html"{{if eq .Current "index.html"}} class="current"{{end}}>Dashboard</a></li>
+    <li><a href="archive.html"{{if eq .Current "archive.html"}} class="current"{{end}}>Archive</a></li>
+  </ul>
+  <h2>Capabilities</h2>
+  <ul>
+  {{- range .Project.Capabilities}}
+    {{- $page := specPage .ID}}
+    <li><a href="{{$page}}"{{if eq $.Current $page}} class="current"{{end}}>{{.ID}}</a></li>
+  {{- else}}
+    <li class="muted">No specs</li>
+  {{- end}}
+  </ul>
+  <h2>Active Changes</h2>
+  <ul>
+  {{- range .Project.Changes}}
+    {{- $page := changePage .ID}}
+    <li><a href="{{$page}}"{{if eq $.Current $page}} class="current"{{end}}>{{.ID}}</a></li>
+  {{- else}}
+    <li class="muted">No active changes</li>
+  {{- end}}
+  </ul>
+</nav>
+<main>
+{{.Content}}
+</main>
+<script src="search-index.js"></script>
+<script src="search.js"></script>
+</body>
+</html>
+`
+
+// pageTemplates holds the body of each page kind
+const pageTemplates = `
+{{define "dashboard"}}
+<h1>Dashboard</h1>
+{{with .Summary}}
+<div class="cards">
+  <div class="card"><span class="value">{{.TotalSpecs}}</span><span class="label">Specifications</span></div>
+  <div class="card"><span class="value">{{.TotalRequirements}}</span><span class="label">Requirements</span></div>
+  <div class="card"><span class="value">{{.ActiveChanges}}</span><span class="label">Active Changes</span></div>
+  <div class="card"><span class="value">{{.CompletedChanges}}</span><span class="label">Completed Changes</span></div>
+  <div class="card"><span class="value">{{.CompletedTasks}}/{{.TotalTasks}}</span><span class="label">Tasks Done</span></div>
+</div>
+{{end}}
+<h2>Active Changes</h2>
+{{if .ActiveChanges}}
+<table>
+  <thead><tr><th>Change</th><th>Title</th><th>Progress</th></tr></thead>
+  <tbody>
+  {{- range .ActiveChanges}}
+    <tr>
+      <td><a href="{{changePage .ID}}">{{.ID}}</a></td>
+      <td>{{.Title}}</td>
+      <td>{{template "progress" .Progress}}</td>
+    </tr>
+  {{- end}}
+  </tbody>
+</table>
+{{else}}<p class="muted">No active changes.</p>{{end}}
+<h2>Completed Changes</h2>
+{{if .CompletedChanges}}
+<ul>
+{{- range .CompletedChanges}}
+  <li><a href="{{changePage .ID}}">{{.ID}}</a> — {{.Title}}</li>
+{{- end}}
+</ul>
+{{else}}<p class="muted">No completed changes.</p>{{end}}
+<h2>Specifications</h2>
+{{if .Specs}}
+<table>
+  <thead><tr><th>Capability</th><th>Title</th><th>Requirements</th></tr></thead>
+  <tbody>
+  {{- range .Specs}}
+    <tr><td><a href="{{specPage .ID}}">{{.ID}}</a></td><td>{{.Title}}</td><td>{{.RequirementCount}}</td></tr>
+  {{- end}}
+  </tbody>
+</table>
+{{else}}<p class="muted">No specifications.</p>{{end}}
+{{end}}
+
+{{define "progress"}}
+<div class="progress" title="{{.Completed}}/{{.Total}} tasks">
+  <div class="bar" style="width: {{.Percentage}}%"></div>
+</div>
+<span class="muted">{{.Percentage}}% ({{.Completed}}/{{.Total}})</span>
+{{end}}
+
+{{define "capability"}}
+<h1>{{.Title}}</h1>
+<p class="muted"><code>{{.ID}}</code> · {{len .Requirements}} requirement(s)</p>
+{{if .Purpose}}<section class="purpose">{{md .Purpose 1}}</section>{{end}}
+{{if .Requirements}}
+<nav class="toc">
+  <h2>Requirements</h2>
+  <ol>
+  {{- range .Requirements}}
+    <li><a href="#{{.Anchor}}">{{.Name}}</a></li>
+  {{- end}}
+  </ol>
+</nav>
+{{range .Requirements}}{{template "requirement" .}}{{end}}
+{{else}}<p class="muted">This capability has no requirements.</p>{{end}}
+{{end}}
+
+{{define "requirement"}}
+<section class="requirement" id="{{.Anchor}}">
+  <h3><a class="anchor" href="#{{.Anchor}}">#</a> Requirement: {{.Name}}</h3>
+  {{if .Description}}{{md .Description 3}}{{end}}
+  {{range .Scenarios}}
+  <div class="scenario">
+    <h4>Scenario: {{.Name}}</h4>
+    <ul class="steps">
+    {{- range .Steps}}
+      <li>{{if .Keyword}}<strong>{{.Keyword}}</strong> {{end}}{{.Text}}</li>
+    {{- end}}
+    </ul>
+  </div>
+  {{end}}
+</section>
+{{end}}
+
+{{define "change"}}
+<h1>{{.Title}}</h1>
+<p class="muted"><code>{{.ID}}</code></p>
+{{template "progress" progress .Progress}}
+{{if .Proposal}}<section class="proposal">{{md .Proposal 1}}</section>{{end}}
+<h2>Delta Preview</h2>
+{{range .Deltas}}
+<section class="delta">
+  {{if .NewCapability}}
+  <h3>New capability <code>{{.Capability}}</code></h3>
+  {{else}}
+  <h3>Capability <a href="{{specPage .Capability}}"><code>{{.Capability}}</code></a></h3>
+  {{end}}
+  {{if .Added}}<h4 class="op added">ADDED</h4>{{range .Added}}{{template "requirement" .}}{{end}}{{end}}
+  {{if .Modified}}<h4 class="op modified">MODIFIED</h4>{{range .Modified}}{{template "requirement" .}}{{end}}{{end}}
+  {{if .Removed}}
+  <h4 class="op removed">REMOVED</h4>
+  <ul>{{range .Removed}}<li><del>{{.}}</del></li>{{end}}</ul>
+  {{end}}
+  {{if .Renamed}}
+  <h4 class="op renamed">RENAMED</h4>
+  <ul>{{range .Renamed}}<li>{{.From}} → {{.To}}</li>{{end}}</ul>
+  {{end}}
+</section>
+{{else}}<p class="muted">This change has no delta specs.</p>{{end}}
+{{if .Tasks}}<h2>Tasks</h2><section class="tasks">{{md .Tasks 1}}</section>{{end}}
+{{end}}
+
+{{define "archive"}}
+<h1>Archive</h1>
+{{if .Archive}}
+<table>
+  <thead><tr><th>Date</th><th>Change</th><th>Title</th><th>Capabilities</th></tr></thead>
+  <tbody>
+  {{- range .Archive}}
+    <tr>
+      <td>{{.Date}}</td>
+      <td><code>{{.ID}}</code></td>
+      <td>{{.Title}}</td>
+      <td>
+      {{- range $i, $c := .Capabilities}}{{if $i}}, {{end}}
+        {{- if $.HasCapability $c}}<a href="{{specPage $c}}">{{$c}}</a>{{else}}{{$c}}{{end}}
+      {{- end -}}
+      </td>
+    </tr>
+  {{- end}}
+  </tbody>
+</table>
+{{else}}<p class="muted">No archived changes.</p>{{end}}
+{{end}}
+`
+
+// styleSheet is written to style.css
+const styleSheet = `:root {
+  --fg: #1f2328; --muted: #656d76; --border: #d0d7de; --bg: #ffffff;
+  --side: #f6f8fa; --accent: #0969da; --added: #1a7f37; --removed: #cf222e;
+  --modified: #9a6700; --renamed: #8250df;
+}
+* { box-sizing: border-box; }
+body {
+  margin: 0; display: flex; min-height: 100vh; color: var(--fg); background: var(--bg);
+  font: 15px/1.55 -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif;
+}
+a { color: var(--accent); text-decoration: none; }
+a:hover { text-decoration: underline; }
+code { font-family: ui-monospace, SFMono-Regular, Menlo, monospace; font-size: 90%; }
+pre { background: var(--side); padding: 12px; overflow-x: auto; border-radius: 6px; }
+.sidebar {
+  width: 270px; flex-shrink: 0; padding: 20px 16px; background: var(--side);
+  border-right: 1px solid var(--border); position: sticky; top: 0; height: 100vh; overflow-y: auto;
+}
+.sidebar .brand { font-size: 20px; font-weight: 600; color: var(--fg); }
+.sidebar h2 { font-size: 12px; text-transform: uppercase; color: var(--muted); margin: 20px 0 6px; }
+.sidebar ul { list-style: none; margin: 0; padding: 0; }
+.sidebar li { padding: 2px 0; word-break: break-all; }
+.sidebar a.current { font-weight: 600; color: var(--fg); }
+#search { width: 100%; margin-top: 14px; padding: 6px 8px; border: 1px solid var(--border); border-radius: 6px; }
+#search-results { background: var(--bg); border: 1px solid var(--border); border-radius: 6px; margin-top: 4px; }
+#search-results li { padding: 6px 8px; border-bottom: 1px solid var(--border); }
+#search-results .kind { display: block; font-size: 11px; color: var(--muted); }
+main { flex: 1; max-width: 960px; padding: 24px 40px 60px; }
+table { border-collapse: collapse; width: 100%; margin: 8px 0 16px; }
+th, td { text-align: left; padding: 6px 10px; border-bottom: 1px solid var(--border); vertical-align: top; }
+.muted { color: var(--muted); }
+.cards { display: flex; flex-wrap: wrap; gap: 12px; }
+.card { border: 1px solid var(--border); border-radius: 8px; padding: 12px 16px; min-width: 150px; }
+.card .value { display: block; font-size: 24px; font-weight: 600; }
+.card .label { color: var(--muted); font-size: 13px; }
+.progress { display: inline-block; width: 140px; height: 8px; background: var(--border); border-radius: 4px; vertical-align: middle; }
+.progress .bar { height: 100%; background: var(--added); border-radius: 4px; }
+.toc ol { padding-left: 22px; }
+.requirement { border-top: 1px solid var(--border); padding-top: 6px; margin-top: 18px; }
+.requirement .anchor { color: var(--muted); visibility: hidden; }
+.requirement h3:hover .anchor { visibility: visible; }
+.requirement:target { background: #fff8c5; }
+.scenario { margin-left: 12px; padding-left: 12px; border-left: 3px solid var(--border); }
+.steps { padding-left: 18px; }
+.delta { border: 1px solid var(--border); border-radius: 8px; padding: 4px 16px 12px; margin: 12px 0; }
+.op { margin-bottom: 0; }
+.op.added { color: var(--added); }
+.op.removed { color: var(--removed); }
+.op.modified { color: var(--modified); }
+.op.renamed { color: var(--renamed); }
+li.task { list-style: none; margin-left: -18px; }
+`
+
+// searchScript is written to search.js. It filters the entries of the
+// SPECTR_SEARCH index (search-index.js) without any network access.
+const searchScript = `(function () {
+  var input = document.getElementById("search");
+  var results = document.getElementById("search-results");
+  var index = window.SPECTR_SEARCH || [];
+  if (!input || !results) { return; }
+
+  function render(query) {
+    results.innerHTML = "";
+    var terms = query.toLowerCase().split(/\s+/).filter(Boolean);
+    if (terms.length === 0) { results.hidden = true; return; }
+
+    var matches = index.filter(function (entry) {
+      var haystack = (entry.title + " " + entry.text).toLowerCase();
+      return terms.every(function (term) { return haystack.indexOf(term) !== -1; });
+    }).slice(0, 25);
+
+    if (matches.length === 0) {
+      var empty = document.createElement("li");
+      empty.textContent = "No matches";
+      results.appendChild(empty);
+    }
+    matches.forEach(function (entry) {
+      var item = document.createElement("li");
+      var kind = document.createElement("span");
+      kind.className = "kind";
+      kind.textContent = entry.kind + (entry.context ? " · " + entry.context : "");
+      var link = document.createElement("a");
+      link.href = entry.url;
+      link.textContent = entry.title;
+      item.appendChild(kind);
+      item.appendChild(link);
+      results.appendChild(item);
+    });
+    results.hidden = false;
+  }
+
+  input.addEventListener("input", function () { render(input.value); });
+  input.addEventListener("keydown", function (event) {
+    if (event.key === "Escape") { input.value = ""; render(""); }
+  });
+})();
+`
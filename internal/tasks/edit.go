@@ -0,0 +1,167 @@
+// Package tasks edits the checklist in a change's tasks.md in place.
+// Edits touch only the lines they change, so the rest of the file keeps
+// its formatting.
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// filePerm is the permission of rewritten tasks.md files
+const filePerm = 0o644
+
+var (
+	// markerPattern splits a task line around its checkbox marker
+	markerPattern = regexp.MustCompile(`^(\s*-\s*\[)([xX ])(\].*)$`)
+	// leadingSpace matches the indentation of a line
+	leadingSpace = regexp.MustCompile(`^\s*`)
+)
+
+// SetDone checks off the task with the given ID, or clears its
+// checkbox when done is false. It reports whether the content changed;
+// a task that is already in the requested state is left as is.
+func SetDone(content, id string, done bool) (string, bool, error) {
+	task := parsers.ParseTaskList(content).Find(id)
+	if task == nil {
+		return content, false, fmt.Errorf("task %s not found", id)
+	}
+	if task.Done == done {
+		return content, false, nil
+	}
+
+	lines := strings.Split(content, "\n")
+	matches := markerPattern.FindStringSubmatch(lines[task.Line-1])
+	if matches == nil {
+		return content, false, fmt.Errorf("task %s has no checkbox", id)
+	}
+
+	marker := " "
+	if done {
+		marker = "x"
+	}
+	lines[task.Line-1] = matches[1] + marker + matches[3]
+
+	return strings.Join(lines, "\n"), true, nil
+}
+
+// Add appends an open task to a section and returns the new content and
+// the ID given to the task. The section is matched by number ("2") or
+// title, ignoring case; an empty section selects the last one. Tasks in
+// numbered sections get the next free ID below the section number.
+func Add(content, section, text string) (string, string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return content, "", errors.New("task text is empty")
+	}
+
+	list := parsers.ParseTaskList(content)
+	target, err := findSection(list, section)
+	if err != nil {
+		return content, "", err
+	}
+
+	lines := strings.Split(content, "\n")
+
+	// Insert after the last task of the section, or after its heading
+	after := target.Line
+	indent := ""
+	if len(target.Tasks) > 0 {
+		target.Walk(func(task *parsers.Task) {
+			after = max(after, task.Line)
+		})
+		indent = leadingSpace.FindString(lines[target.Tasks[0].Line-1])
+	}
+
+	id := nextID(target)
+	line := indent + "- [ ] " + text
+	if id != "" {
+		line = indent + "- [ ] " + id + " " + text
+	}
+	if after > 0 && strings.HasSuffix(lines[after-1], "\r") {
+		line += "\r"
+	}
+
+	lines = append(lines[:after], append([]string{line}, lines[after:]...)...)
+
+	return strings.Join(lines, "\n"), id, nil
+}
+
+// findSection returns the section matching number or title, or the
+// last section when section is empty
+func findSection(list *parsers.TaskList, section string) (*parsers.TaskSection, error) {
+	if len(list.Sections) == 0 {
+		return nil, errors.New("tasks.md has no sections")
+	}
+	if section == "" {
+		return list.Sections[len(list.Sections)-1], nil
+	}
+
+	section = strings.TrimSuffix(strings.TrimSpace(section), ".")
+	for _, s := range list.Sections {
+		if s.Line > 0 && (s.Number == section || strings.EqualFold(s.Title, section)) {
+			return s, nil
+		}
+	}
+
+	headings := make([]string, 0, len(list.Sections))
+	for _, s := range list.Sections {
+		if s.Line > 0 {
+			headings = append(headings, strconv.Quote(s.Heading()))
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"section %q not found (sections: %s)",
+		section,
+		strings.Join(headings, ", "),
+	)
+}
+
+// nextID returns the ID following the highest top-level task ID of a
+// numbered section, or "" for sections without a number
+func nextID(section *parsers.TaskSection) string {
+	if section.Number == "" {
+		return ""
+	}
+
+	prefix := section.Number + "."
+	last := 0
+	for _, task := range section.Tasks {
+		n, err := strconv.Atoi(strings.TrimPrefix(task.ID, prefix))
+		if err == nil && strings.HasPrefix(task.ID, prefix) {
+			last = max(last, n)
+		}
+	}
+
+	return prefix + strconv.Itoa(last+1)
+}
+
+// EditFile applies edit to the tasks.md at path and writes the result
+// back when the content changed
+func EditFile(path string, edit func(content string) (string, error)) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read tasks: %w", err)
+	}
+
+	updated, err := edit(string(content))
+	if err != nil {
+		return err
+	}
+	if updated == string(content) {
+		return nil
+	}
+
+	if err := os.WriteFile(path, []byte(updated), filePerm); err != nil {
+		return fmt.Errorf("write tasks: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package tasks
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const sample = `# Tasks
+
+## 1. Setup
+- [x] 1.1 Add config
+- [ ] 1.2 Add flag  <!-- keep -->
+    - [ ] 1.2.1 Nested
+
+## 2. Docs
+
+## Cleanup
+* not a task
+`
+
+func TestSetDone(t *testing.T) {
+	updated, changed, err := SetDone(sample, "1.2.1", true)
+	if err != nil || !changed {
+		t.Fatalf("SetDone = %v, %v", changed, err)
+	}
+	want := strings.Replace(sample, "    - [ ] 1.2.1 Nested", "    - [x] 1.2.1 Nested", 1)
+	if updated != want {
+		t.Errorf("Unexpected content:\n%s", updated)
+	}
+
+	updated, changed, err = SetDone(sample, "1.1", false)
+	if err != nil || !changed || !strings.Contains(updated, "- [ ] 1.1 Add config\n") {
+		t.Errorf("Undo failed: %v, %v\n%s", changed, err, updated)
+	}
+
+	if _, changed, err := SetDone(sample, "1.1", true); err != nil || changed {
+		t.Errorf("Expected no change for a done task, got %v, %v", changed, err)
+	}
+	if _, _, err := SetDone(sample, "3.1", true); err == nil {
+		t.Error("Expected an error for an unknown task")
+	}
+}
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		section string
+		wantID  string
+		after   string
+	}{
+		{"after nested tasks", "1", "1.3", "    - [ ] 1.2.1 Nested\n- [ ] 1.3 New task\n"},
+		{"by title", "setup", "1.3", "    - [ ] 1.2.1 Nested\n- [ ] 1.3 New task\n"},
+		{"empty section", "2.", "2.1", "## 2. Docs\n- [ ] 2.1 New task\n\n"},
+		{"unnumbered last section", "", "", "## Cleanup\n- [ ] New task\n* not a task"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated, id, err := Add(sample, tt.section, "  New task ")
+			if err != nil {
+				t.Fatalf("Add failed: %v", err)
+			}
+			if id != tt.wantID {
+				t.Errorf("id = %q, want %q", id, tt.wantID)
+			}
+			if !strings.Contains(updated, tt.after) {
+				t.Errorf("Expected %q in:\n%s", tt.after, updated)
+			}
+			if strings.Count(updated, "\n") != strings.Count(sample, "\n")+1 {
+				t.Errorf("Expected exactly one added line:\n%s", updated)
+			}
+		})
+	}
+}
+
+func TestAdd_Errors(t *testing.T) {
+	if _, _, err := Add(sample, "9", "x"); err == nil || !strings.Contains(err.Error(), `"1. Setup"`) {
+		t.Errorf("Expected unknown section error listing sections, got %v", err)
+	}
+	if _, _, err := Add(sample, "1", " "); err == nil {
+		t.Error("Expected an error for empty text")
+	}
+	if _, _, err := Add("# Tasks\n", "", "x"); err == nil {
+		t.Error("Expected an error without sections")
+	}
+}
+
+func TestAdd_KeepsIndentationAndLineEndings(t *testing.T) {
+	content := "## 1. Setup\r\n  - [ ] 1.1 One\r\n  - [ ] 1.4 Four\r\n"
+
+	updated, id, err := Add(content, "1", "Five")
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if id != "1.5" {
+		t.Errorf("id = %q, want 1.5", id)
+	}
+	if want := "  - [ ] 1.4 Four\r\n  - [ ] 1.5 Five\r\n"; !strings.Contains(updated, want) {
+		t.Errorf("Expected %q in %q", want, updated)
+	}
+}
+
+func TestEditFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tasks.md")
+	if err := os.WriteFile(path, []byte(sample), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := EditFile(path, func(content string) (string, error) {
+		updated, _, err := SetDone(content, "1.2", true)
+
+		return updated, err
+	})
+	if err != nil {
+		t.Fatalf("EditFile failed: %v", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "- [x] 1.2 Add flag  <!-- keep -->\n") {
+		t.Errorf("Unexpected content:\n%s", content)
+	}
+
+	if err := EditFile(filepath.Join(t.TempDir(), "missing.md"), nil); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
@@ -0,0 +1,298 @@
+// Package testgen generates Go test skeletons from spec scenarios.
+//
+// Each requirement of a capability becomes a test function and each of
+// its scenarios a t.Run subtest whose body lists the WHEN/THEN/AND steps
+// as comments and skips with "not implemented". Subtest names equal the
+// scenario names so `spectr coverage` links them by convention.
+package testgen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+const (
+	dirPerm  = 0o755
+	filePerm = 0o644
+
+	testFileSuffix = "_spec_test.go"
+	skipMessage    = "not implemented"
+)
+
+// testFileNames turns capability IDs into test file names; nested
+// capabilities such as "api/auth" become "api_auth"
+var testFileNames = strings.NewReplacer("-", "_", "/", "_")
+
+// Options configures test skeleton generation
+type Options struct {
+	// Capability is the spec ID whose scenarios are generated
+	Capability string
+	// PackageDir is the Go package directory that receives the test file
+	PackageDir string
+	// FileName overrides the default "<capability>_spec_test.go" name
+	FileName string
+	// DryRun computes the result without writing to disk
+	DryRun bool
+}
+
+// Result describes the outcome of a generation run
+type Result struct {
+	// Path is the test file that was (or would be) written
+	Path string
+	// Added lists the test functions newly generated
+	Added []string
+	// Skipped lists test functions left alone because they already exist
+	Skipped []string
+	// Content is the full resulting file content
+	Content string
+}
+
+// testFunc is a test function to be generated for one requirement
+type testFunc struct {
+	Name        string
+	Requirement string
+	Scenarios   []parsers.Scenario
+}
+
+// Generate builds test skeletons for a capability and writes them to
+// the package directory. Test functions that already exist anywhere in
+// the package are never overwritten; new ones are appended to the
+// target file, which is created when missing.
+func Generate(projectPath string, opts Options) (*Result, error) {
+	specIDs, err := discovery.GetSpecs(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover specs: %w", err)
+	}
+	if !slices.Contains(specIDs, opts.Capability) {
+		return nil, fmt.Errorf("spec '%s' not found", opts.Capability)
+	}
+
+	specPath := filepath.Join(
+		projectPath, "spectr", "specs", opts.Capability, "spec.md",
+	)
+	reqs, err := parsers.ParseRequirements(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", specPath, err)
+	}
+
+	fileName := opts.FileName
+	if fileName == "" {
+		fileName = testFileNames.Replace(opts.Capability) + testFileSuffix
+	}
+	result := &Result{Path: filepath.Join(opts.PackageDir, fileName)}
+
+	pkgName, existing, err := inspectPackage(opts.PackageDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var funcs []testFunc
+	for _, req := range reqs {
+		scenarios := parsers.ParseScenarioBlocks(req.Raw)
+		if len(scenarios) == 0 {
+			continue
+		}
+
+		name := "Test" + Identifier(req.Name)
+		if existing[name] {
+			result.Skipped = append(result.Skipped, name)
+
+			continue
+		}
+		existing[name] = true
+		funcs = append(funcs, testFunc{
+			Name:        name,
+			Requirement: req.Name,
+			Scenarios:   scenarios,
+		})
+		result.Added = append(result.Added, name)
+	}
+
+	content, err := buildContent(result.Path, pkgName, opts.Capability, funcs)
+	if err != nil {
+		return nil, err
+	}
+	result.Content = content
+
+	if opts.DryRun || len(funcs) == 0 {
+		return result, nil
+	}
+
+	if err := os.MkdirAll(opts.PackageDir, dirPerm); err != nil {
+		return nil, fmt.Errorf("create package directory: %w", err)
+	}
+	if err := os.WriteFile(result.Path, []byte(content), filePerm); err != nil {
+		return nil, fmt.Errorf("write %s: %w", result.Path, err)
+	}
+
+	return result, nil
+}
+
+// inspectPackage returns the package name used by test files in dir
+// (falling back to any Go file, then the directory name) and the set of
+// test function names already declared there.
+func inspectPackage(dir string) (string, map[string]bool, error) {
+	existing := make(map[string]bool)
+	pkgName := ""
+
+	entries, err := os.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return "", nil, fmt.Errorf("read package directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(
+			token.NewFileSet(),
+			filepath.Join(dir, entry.Name()),
+			nil,
+			parser.SkipObjectResolution,
+		)
+		if err != nil {
+			continue
+		}
+
+		isTest := strings.HasSuffix(entry.Name(), "_test.go")
+		if pkgName == "" || isTest {
+			pkgName = file.Name.Name
+		}
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+				existing[fn.Name.Name] = true
+			}
+		}
+	}
+
+	if pkgName == "" {
+		pkgName = strings.ToLower(Identifier(filepath.Base(dir)))
+	}
+
+	return pkgName, existing, nil
+}
+
+// buildContent renders the generated functions, appending them to the
+// existing file at path when present, and gofmt-formats the result
+func buildContent(
+	path, pkgName, capability string,
+	funcs []testFunc,
+) (string, error) {
+	var sb strings.Builder
+
+	original, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		sb.WriteString(ensureTestingImport(string(original)))
+	case os.IsNotExist(err):
+		fmt.Fprintf(&sb, "package %s\n\nimport \"testing\"\n", pkgName)
+	default:
+		return "", fmt.Errorf("read %s: %w", path, err)
+	}
+
+	for _, fn := range funcs {
+		sb.WriteString("\n")
+		writeTestFunc(&sb, capability, fn)
+	}
+
+	formatted, err := format.Source([]byte(sb.String()))
+	if err != nil {
+		return "", fmt.Errorf("format generated tests: %w", err)
+	}
+
+	return string(formatted), nil
+}
+
+// ensureTestingImport adds `import "testing"` after the package clause
+// when the file does not already import it
+func ensureTestingImport(src string) string {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ImportsOnly)
+	if err != nil {
+		return src
+	}
+
+	for _, imp := range file.Imports {
+		if imp.Path.Value == strconv.Quote("testing") {
+			return src
+		}
+	}
+
+	offset := fset.Position(file.Name.End()).Offset
+
+	return src[:offset] + "\n\nimport \"testing\"\n" + src[offset:]
+}
+
+// writeTestFunc renders a single requirement test function
+func writeTestFunc(sb *strings.Builder, capability string, fn testFunc) {
+	fmt.Fprintf(
+		sb,
+		"// %s covers the %q requirement of the %s capability.\n",
+		fn.Name,
+		fn.Requirement,
+		capability,
+	)
+	fmt.Fprintf(sb, "func %s(t *testing.T) {\n", fn.Name)
+
+	for _, scenario := range fn.Scenarios {
+		fmt.Fprintf(
+			sb,
+			"\tt.Run(%s, func(t *testing.T) {\n",
+			strconv.Quote(scenario.Name),
+		)
+		for _, step := range scenario.Steps {
+			if step.Keyword == "" {
+				fmt.Fprintf(sb, "\t\t// %s\n", step.Text)
+
+				continue
+			}
+			fmt.Fprintf(sb, "\t\t// %s %s\n", step.Keyword, step.Text)
+		}
+		fmt.Fprintf(sb, "\t\tt.Skip(%s)\n", strconv.Quote(skipMessage))
+		sb.WriteString("\t})\n")
+	}
+
+	sb.WriteString("}\n")
+}
+
+// Identifier converts a free-form name into an exported Go identifier,
+// e.g. "Spec File Validation" becomes "SpecFileValidation".
+func Identifier(name string) string {
+	var sb strings.Builder
+	upperNext := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upperNext = true
+
+			continue
+		}
+		if upperNext {
+			r = unicode.ToUpper(r)
+			upperNext = false
+		}
+		sb.WriteRune(r)
+	}
+
+	ident := sb.String()
+	if ident == "" {
+		return "Unnamed"
+	}
+	if unicode.IsDigit(rune(ident[0])) {
+		ident = "N" + ident
+	}
+
+	return ident
+}
@@ -0,0 +1,161 @@
+package testgen
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testSpec = `# Auth Specification
+
+## Purpose
+
+Authentication.
+
+## Requirements
+
+### Requirement: User Login
+The system SHALL authenticate users.
+
+#### Scenario: Valid credentials
+- **WHEN** valid credentials are submitted
+- **THEN** a session is created
+
+#### Scenario: Invalid password
+- **WHEN** the password is wrong
+- **THEN** login is rejected
+
+### Requirement: Logout
+The system SHALL end sessions.
+
+#### Scenario: Session ends
+- **WHEN** the user logs out
+- **THEN** the session is destroyed
+`
+
+func setupProject(t *testing.T) string {
+	t.Helper()
+	tmpDir := t.TempDir()
+	specDir := filepath.Join(tmpDir, "spectr", "specs", "auth")
+	if err := os.MkdirAll(specDir, dirPerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(specDir, "spec.md"), []byte(testSpec), filePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	return tmpDir
+}
+
+func TestGenerate_NewFile(t *testing.T) {
+	projectPath := setupProject(t)
+	pkgDir := filepath.Join(projectPath, "internal", "auth")
+
+	result, err := Generate(projectPath, Options{Capability: "auth", PackageDir: pkgDir})
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if len(result.Added) != 2 {
+		t.Errorf("Expected 2 added tests, got %v", result.Added)
+	}
+
+	content, err := os.ReadFile(filepath.Join(pkgDir, "auth_spec_test.go"))
+	if err != nil {
+		t.Fatalf("Expected test file to be written: %v", err)
+	}
+
+	if _, err := parser.ParseFile(token.NewFileSet(), "", content, 0); err != nil {
+		t.Fatalf("Generated file does not parse: %v", err)
+	}
+
+	for _, want := range []string{
+		"package auth",
+		"func TestUserLogin(t *testing.T)",
+		"func TestLogout(t *testing.T)",
+		`t.Run("Valid credentials"`,
+		"// WHEN valid credentials are submitted",
+		"// THEN a session is created",
+		`t.Skip("not implemented")`,
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("Generated file missing %q", want)
+		}
+	}
+}
+
+func TestGenerate_NeverOverwrites(t *testing.T) {
+	projectPath := setupProject(t)
+	pkgDir := filepath.Join(projectPath, "internal", "auth")
+	if err := os.MkdirAll(pkgDir, dirPerm); err != nil {
+		t.Fatal(err)
+	}
+
+	// Existing implementation of one requirement, in a file without
+	// the testing import being reused for the generated functions
+	existing := "package authx\n\nfunc TestUserLogin(t *T) { /* custom */ }\n\ntype T struct{}\n"
+	target := filepath.Join(pkgDir, "auth_spec_test.go")
+	if err := os.WriteFile(target, []byte(existing), filePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Generate(projectPath, Options{Capability: "auth", PackageDir: pkgDir})
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if len(result.Skipped) != 1 || result.Skipped[0] != "TestUserLogin" {
+		t.Errorf("Expected TestUserLogin to be skipped, got %v", result.Skipped)
+	}
+	if len(result.Added) != 1 || result.Added[0] != "TestLogout" {
+		t.Errorf("Expected only TestLogout to be added, got %v", result.Added)
+	}
+
+	content, _ := os.ReadFile(target)
+	if !strings.Contains(string(content), "/* custom */") {
+		t.Error("Existing test function was overwritten")
+	}
+	if !strings.Contains(string(content), `import "testing"`) {
+		t.Error("Expected testing import to be added")
+	}
+
+	// A second run adds nothing
+	result, err = Generate(projectPath, Options{Capability: "auth", PackageDir: pkgDir})
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if len(result.Added) != 0 {
+		t.Errorf("Expected no tests added on rerun, got %v", result.Added)
+	}
+}
+
+func TestGenerate_UnknownCapability(t *testing.T) {
+	projectPath := setupProject(t)
+
+	_, err := Generate(projectPath, Options{Capability: "missing", PackageDir: projectPath})
+	if err == nil {
+		t.Error("Expected error for unknown capability")
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Spec File Validation", "SpecFileValidation"},
+		{"archive --pr flag", "ArchivePrFlag"},
+		{"2FA support", "N2FASupport"},
+		{"kebab-case-name", "KebabCaseName"},
+		{"!!!", "Unnamed"},
+	}
+
+	for _, tt := range tests {
+		if got := Identifier(tt.input); got != tt.want {
+			t.Errorf("Identifier(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
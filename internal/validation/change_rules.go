@@ -4,6 +4,7 @@
 package validation
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -110,6 +111,27 @@ func ValidateChangeDeltaSpecs(
 		allIssues = append(allIssues, baseSpecIssues...)
 	}
 
+	// Check the proposal frontmatter against its schema
+	allIssues = append(allIssues, validateProposalMetadata(changeDir)...)
+
+	// Check the changes this change depends on
+	allIssues = append(
+		allIssues,
+		validateChangeDependencies(changeDir, spectrRoot)...,
+	)
+
+	// Check links against the specs as they will be after archiving
+	allIssues = append(
+		allIssues,
+		validateChangeLinks(specFiles, specsDir, spectrRoot)...,
+	)
+
+	// Check task IDs, sections and requirement links in tasks.md
+	allIssues = append(
+		allIssues,
+		validateTasks(changeDir, specFiles, specsDir, spectrRoot)...,
+	)
+
 	// Check if there are no deltas at all
 	if totalDeltas == 0 {
 		allIssues = append(allIssues, ValidationIssue{
@@ -117,7 +139,8 @@ func ValidateChangeDeltaSpecs(
 			Path:  specsDir,
 			Line:  1, // Default to line 1 for missing deltas
 			Message: "Change must have at least one delta " +
-				"(ADDED, MODIFIED, REMOVED, or RENAMED requirement)",
+				"(ADDED, MODIFIED, REMOVED, or RENAMED requirement, " +
+				"or ADDED, MODIFIED, or REMOVED scenario)",
 		})
 	}
 
@@ -222,6 +245,21 @@ func validateSingleDeltaFile(
 		issues = append(issues, renamedIssues...)
 	}
 
+	// Process ADDED/MODIFIED/REMOVED Scenarios
+	scenarioIssues, scenarioSectionCount, err := validateScenarioDeltaSections(
+		specPath,
+		lines,
+		sections,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	issues = append(issues, scenarioIssues...)
+	deltaCount += scenarioSectionCount
+
+	// Stable requirement IDs must be unique within the delta file
+	issues = append(issues, validateRequirementIDs(specPath, lines)...)
+
 	// Check for cross-section conflicts within this file
 	for normalized := range fileAddedReqs {
 		if fileModifiedReqs[normalized] {
@@ -264,11 +302,13 @@ func parseRenamedRequirements(content string) []RenamedRequirement {
 	var renames []RenamedRequirement
 	lines := strings.Split(content, "\n")
 
+	// Names may be wrapped in backticks:
+	// - FROM: `### Requirement: Old Name`
 	fromRegex := regexp.MustCompile(
-		`^\s*-\s*FROM:\s*###\s*Requirement:\s*(.+)$`,
+		`^\s*-\s*FROM:\s*` + "`?" + `###\s*Requirement:\s*(.+?)` + "`?$",
 	)
 	toRegex := regexp.MustCompile(
-		`^\s*-\s*TO:\s*###\s*Requirement:\s*(.+)$`,
+		`^\s*-\s*TO:\s*` + "`?" + `###\s*Requirement:\s*(.+?)` + "`?$",
 	)
 
 	var currentFrom string
@@ -343,7 +383,7 @@ func validateDeltaAgainstBaseSpec(
 ) ([]ValidationIssue, error) {
 	// Extract capability name from delta spec path
 	// Path structure: .../changes/<change-id>/specs/<capability>/spec.md
-	// We want to extract <capability>
+	// We want to extract <capability>, which may be nested (api/auth)
 	relPath, err := filepath.Rel(specsDir, deltaSpecPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get relative path: %w", err)
@@ -378,14 +418,33 @@ func validateDeltaAgainstBaseSpec(
 			lineNum = findPreMergeErrorLine(lines, err.Error(), deltaPlan)
 		}
 
-		return []ValidationIssue{
-			{
-				Level:   LevelError,
-				Path:    deltaSpecPath,
-				Line:    lineNum,
-				Message: err.Error(),
-			},
-		}, nil
+		issue := ValidationIssue{
+			Level:   LevelError,
+			Path:    deltaSpecPath,
+			Line:    lineNum,
+			Message: err.Error(),
+		}
+
+		var unknown *UnknownRequirementError
+		if errors.As(err, &unknown) {
+			issue.Suggestions = unknown.Suggestions
+			if unknown.Fix != "" {
+				issue.Fix = &IssueFix{Old: unknown.Name, New: unknown.Fix}
+			}
+		}
+
+		var unknownScenario *UnknownScenarioError
+		if errors.As(err, &unknownScenario) {
+			issue.Suggestions = unknownScenario.Suggestions
+			if unknownScenario.Fix != "" {
+				issue.Fix = &IssueFix{
+					Old: unknownScenario.Name,
+					New: unknownScenario.Fix,
+				}
+			}
+		}
+
+		return []ValidationIssue{issue}, nil
 	}
 
 	return nil, nil
@@ -443,7 +502,7 @@ func findRenamedPairLine(lines []string, fromName string, startLine int) int {
 		}
 
 		withoutBullet := strings.TrimSpace(strings.TrimPrefix(trimmed, "-"))
-		withoutCode := strings.Trim(withoutBullet, "`")
+		withoutCode := strings.ReplaceAll(withoutBullet, "`", "")
 		if strings.HasPrefix(
 			withoutCode,
 			"FROM: ### Requirement: "+fromName,
@@ -467,6 +526,8 @@ func findPreMergeErrorLine(lines []string, errMsg string, deltaPlan *parsers.Del
 	// - "RENAMED FROM requirement %q does not exist in base spec"
 	// - "RENAMED TO requirement %q already exists in base spec"
 	// - "ADDED requirement %q already exists in base spec"
+	// - "ADDED Scenarios target requirement %q does not exist in base spec"
+	// - "MODIFIED scenario %q does not exist in requirement %q"
 
 	sectionName, reqName := extractSectionAndReqName(errMsg)
 
@@ -479,6 +540,15 @@ func findPreMergeErrorLine(lines []string, errMsg string, deltaPlan *parsers.Del
 		return findRenamedPairLine(lines, reqName, sectionLine)
 	}
 
+	// Scenario errors name the scenario first and its requirement second
+	if strings.Contains(errMsg, " scenario \"") {
+		if parts := strings.Split(errMsg, "\""); len(parts) > 3 {
+			sectionLine = findRequirementLineInSection(lines, parts[3], sectionLine)
+		}
+
+		return findScenarioLine(lines, reqName, sectionLine)
+	}
+
 	return findRequirementLineInSection(lines, reqName, sectionLine)
 }
 
@@ -487,7 +557,12 @@ func extractSectionAndReqName(errMsg string) (string, string) {
 	var sectionName, reqName string
 
 	// Determine section name based on error message
+	operation, _, _ := strings.Cut(errMsg, " ")
 	switch {
+	case strings.Contains(errMsg, " Scenarios target requirement"),
+		strings.Contains(errMsg, " Scenarios leave requirement"),
+		strings.HasPrefix(errMsg, operation+" scenario "):
+		sectionName = operation + " Scenarios"
 	case strings.Contains(errMsg, "MODIFIED requirement"):
 		sectionName = "MODIFIED Requirements"
 	case strings.Contains(errMsg, "REMOVED requirement"):
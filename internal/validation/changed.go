@@ -0,0 +1,146 @@
+package validation
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/graph"
+)
+
+// ChangedScope is what a set of changed files touches in a project
+type ChangedScope struct {
+	// Changes lists the active changes with changed files
+	Changes []string
+	// Capabilities lists the capabilities whose main spec changed,
+	// including removed ones
+	Capabilities []string
+}
+
+// Empty reports whether the files touch nothing spectr validates
+func (s ChangedScope) Empty() bool {
+	return len(s.Changes) == 0 && len(s.Capabilities) == 0
+}
+
+// FindChangedScope maps changed files, absolute paths as returned by
+// git.ChangedFiles, to the changes and capabilities of the project at
+// projectPath they belong to. Files outside spectr/changes and
+// spectr/specs, and archived changes, are ignored.
+func FindChangedScope(projectPath string, files []string) (ChangedScope, error) {
+	spectrRoot := filepath.Join(projectPath, SpectrDir)
+	// git reports paths with symlinks resolved
+	if resolved, err := filepath.EvalSymlinks(spectrRoot); err == nil {
+		spectrRoot = resolved
+	}
+	specIDs, err := discovery.GetSpecIDs(projectPath)
+	if err != nil {
+		return ChangedScope{}, fmt.Errorf("failed to discover specs: %w", err)
+	}
+
+	var scope ChangedScope
+	for _, file := range files {
+		rel, err := filepath.Rel(spectrRoot, file)
+		if err != nil {
+			continue
+		}
+		parts := strings.Split(filepath.ToSlash(rel), "/")
+		if len(parts) < 3 {
+			continue
+		}
+
+		switch parts[0] {
+		case "changes":
+			if parts[1] != "archive" && !slices.Contains(scope.Changes, parts[1]) {
+				scope.Changes = append(scope.Changes, parts[1])
+			}
+		case "specs":
+			capability := changedCapability(parts[1:], specIDs)
+			if capability != "" && !slices.Contains(scope.Capabilities, capability) {
+				scope.Capabilities = append(scope.Capabilities, capability)
+			}
+		}
+	}
+	slices.Sort(scope.Changes)
+	slices.Sort(scope.Capabilities)
+
+	return scope, nil
+}
+
+// changedCapability returns the capability of a file below spectr/specs
+// given as path segments: the directory of a spec.md, or else the
+// longest capability containing the file
+func changedCapability(parts, specIDs []string) string {
+	if parts[len(parts)-1] == "spec.md" {
+		return strings.Join(parts[:len(parts)-1], "/")
+	}
+
+	var capability string
+	for i := len(parts) - 1; i > 0 && capability == ""; i-- {
+		if candidate := strings.Join(parts[:i], "/"); slices.Contains(specIDs, candidate) {
+			capability = candidate
+		}
+	}
+
+	return capability
+}
+
+// GetChangedItems returns the items to validate for scope: the changed
+// changes and specs, the active changes with deltas for a changed
+// capability, and the active changes depending on a changed change.
+// Removed items are skipped.
+func GetChangedItems(projectPath string, scope ChangedScope) ([]ValidationItem, error) {
+	g, err := graph.Load(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]bool)
+	for _, id := range scope.Changes {
+		if change, ok := g.Changes[id]; ok && !change.Archived {
+			changes[id] = true
+		}
+	}
+	for _, change := range g.Active() {
+		touchesSpec := slices.ContainsFunc(change.Capabilities, func(capability string) bool {
+			return slices.Contains(scope.Capabilities, capability)
+		})
+		dependsOnChange := slices.ContainsFunc(change.DependsOn, func(dep string) bool {
+			return slices.Contains(scope.Changes, dep)
+		})
+		if touchesSpec || dependsOnChange {
+			changes[change.ID] = true
+		}
+	}
+	changeIDs := make([]string, 0, len(changes))
+	for id := range changes {
+		changeIDs = append(changeIDs, id)
+	}
+	slices.Sort(changeIDs)
+
+	specIDs, err := discovery.GetSpecIDs(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover specs: %w", err)
+	}
+	var capabilities []string
+	for _, capability := range scope.Capabilities {
+		if slices.Contains(specIDs, capability) {
+			capabilities = append(capabilities, capability)
+		}
+	}
+
+	items := CreateValidationItems(
+		projectPath,
+		changeIDs,
+		ItemTypeChange,
+		filepath.Join(projectPath, SpectrDir, "changes"),
+	)
+
+	return append(items, CreateValidationItems(
+		projectPath,
+		capabilities,
+		ItemTypeSpec,
+		filepath.Join(projectPath, SpectrDir, "specs"),
+	)...), nil
+}
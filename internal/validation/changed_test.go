@@ -0,0 +1,83 @@
+package validation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+// writeSpectrFiles writes files below the spectr directory of a new
+// project and returns the project path
+func writeSpectrFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	projectPath, err := filepath.EvalSymlinks(t.TempDir())
+	assert.NoError(t, err)
+	for path, content := range files {
+		path = filepath.Join(projectPath, SpectrDir, filepath.FromSlash(path))
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	return projectPath
+}
+
+func TestFindChangedScope(t *testing.T) {
+	projectPath := writeSpectrFiles(t, map[string]string{
+		"specs/auth/spec.md":         "# Auth\n",
+		"specs/api/billing/spec.md":  "# Billing\n",
+		"specs/api/billing/notes.md": "notes\n",
+	})
+	spectrRoot := filepath.Join(projectPath, SpectrDir)
+	files := []string{
+		filepath.Join(projectPath, "README.md"),
+		filepath.Join(spectrRoot, "project.md"),
+		filepath.Join(spectrRoot, "changes", "add-sso", "proposal.md"),
+		filepath.Join(spectrRoot, "changes", "add-sso", "specs", "auth", "spec.md"),
+		filepath.Join(spectrRoot, "changes", "archive", "2025-01-01-old", "proposal.md"),
+		filepath.Join(spectrRoot, "specs", "api", "billing", "notes.md"),
+		filepath.Join(spectrRoot, "specs", "removed", "spec.md"),
+	}
+
+	scope, err := FindChangedScope(projectPath, files)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"add-sso"}, scope.Changes)
+	assert.Equal(t, []string{"api/billing", "removed"}, scope.Capabilities)
+
+	scope, err = FindChangedScope(projectPath, files[:2])
+	assert.NoError(t, err)
+	assert.True(t, scope.Empty())
+}
+
+func TestGetChangedItems(t *testing.T) {
+	delta := "## ADDED Requirements\n"
+	projectPath := writeSpectrFiles(t, map[string]string{
+		"specs/auth/spec.md":                     "# Auth\n",
+		"specs/billing/spec.md":                  "# Billing\n",
+		"changes/add-sso/proposal.md":            "# Change: SSO\n",
+		"changes/add-sso/specs/auth/spec.md":     delta,
+		"changes/tune-sso/proposal.md":           "---\ndepends_on: [add-mfa]\n---\n# Change: Tune\n",
+		"changes/add-mfa/proposal.md":            "# Change: MFA\n",
+		"changes/add-mfa/specs/mfa/spec.md":      delta,
+		"changes/invoices/proposal.md":           "# Change: Invoices\n",
+		"changes/invoices/specs/billing/spec.md": delta,
+	})
+
+	items, err := GetChangedItems(projectPath, ChangedScope{
+		Changes:      []string{"add-mfa", "gone"},
+		Capabilities: []string{"auth", "removed"},
+	})
+	assert.NoError(t, err)
+
+	var names []string
+	for _, item := range items {
+		names = append(names, item.ItemType+":"+item.Name)
+	}
+	assert.Equal(t, []string{
+		"change:add-mfa",
+		"change:add-sso",
+		"change:tune-sso",
+		"spec:auth",
+	}, names)
+}
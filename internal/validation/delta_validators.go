@@ -4,6 +4,8 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/connerohnesorge/spectr/internal/parsers"
 )
@@ -402,6 +404,7 @@ func validateRenamedRequirements(
 // - ADDED requirements don't already exist in base spec
 // - MODIFIED/REMOVED/RENAMED requirements DO exist in base spec
 // - RENAMED TO requirements don't already exist (unless renaming to itself)
+// - scenario deltas target existing requirements and scenarios
 //
 // If specExists is false, only ADDED operations are allowed.
 //
@@ -409,13 +412,14 @@ func validateRenamedRequirements(
 func ValidatePreMerge(baseSpecPath string, deltaPlan *parsers.DeltaPlan, specExists bool) error {
 	// If spec doesn't exist, only ADDED operations are allowed
 	if !specExists {
-		if len(deltaPlan.Modified) > 0 || len(deltaPlan.Removed) > 0 || len(deltaPlan.Renamed) > 0 {
+		if len(deltaPlan.Modified) > 0 || len(deltaPlan.Removed) > 0 || len(deltaPlan.Renamed) > 0 ||
+			deltaPlan.HasScenarioDeltas() {
 			return errors.New(
 				"target spec does not exist; only ADDED requirements are allowed for new specs",
 			)
 		}
 
-		return nil
+		return validatePlacements(nil, nil, deltaPlan)
 	}
 
 	// Parse base spec to get existing requirements
@@ -424,50 +428,132 @@ func ValidatePreMerge(baseSpecPath string, deltaPlan *parsers.DeltaPlan, specExi
 		return fmt.Errorf("parse base spec: %w", err)
 	}
 
-	// Build map of existing requirement names (normalized)
-	existing := make(map[string]bool)
-	for _, req := range baseReqs {
-		normalized := parsers.NormalizeRequirementName(req.Name)
-		existing[normalized] = true
-	}
+	// Index existing requirements by stable ID and normalized name
+	base := parsers.NewRequirementIndex(baseReqs)
 
 	// Validate MODIFIED requirements exist in base
 	for _, req := range deltaPlan.Modified {
-		normalized := parsers.NormalizeRequirementName(req.Name)
-		if !existing[normalized] {
-			return fmt.Errorf("MODIFIED requirement %q does not exist in base spec", req.Name)
+		if _, ok := base.Find(req.ID, req.Name); !ok {
+			return newUnknownRequirementError("MODIFIED", req.Name, req.ID, baseReqs)
 		}
 	}
 
 	// Validate REMOVED requirements exist in base
 	for _, name := range deltaPlan.Removed {
-		normalized := parsers.NormalizeRequirementName(name)
-		if !existing[normalized] {
-			return fmt.Errorf("REMOVED requirement %q does not exist in base spec", name)
+		id := deltaPlan.RemovedIDs[name]
+		if _, ok := base.Find(id, name); !ok {
+			return newUnknownRequirementError("REMOVED", name, id, baseReqs)
 		}
 	}
 
 	// Validate RENAMED FROM requirements exist in base
 	for _, op := range deltaPlan.Renamed {
-		fromNorm := parsers.NormalizeRequirementName(op.From)
-		if !existing[fromNorm] {
-			return fmt.Errorf("RENAMED FROM requirement %q does not exist in base spec", op.From)
+		from, ok := base.Find(op.ID, op.From)
+		if !ok {
+			return newUnknownRequirementError("RENAMED FROM", op.From, op.ID, baseReqs)
 		}
 
 		// Check that TO name doesn't already exist (unless it's being renamed from something else)
-		toNorm := parsers.NormalizeRequirementName(op.To)
-		if existing[toNorm] && toNorm != fromNorm {
+		if to, exists := base.Find("", op.To); exists && to != from {
 			return fmt.Errorf("RENAMED TO requirement %q already exists in base spec", op.To)
 		}
 	}
 
 	// Validate ADDED requirements don't exist in base
 	for _, req := range deltaPlan.Added {
-		normalized := parsers.NormalizeRequirementName(req.Name)
-		if existing[normalized] {
+		if base.HasName(req.Name) {
 			return fmt.Errorf("ADDED requirement %q already exists in base spec", req.Name)
 		}
+		if req.ID != "" && base.HasID(req.ID) {
+			return fmt.Errorf("ADDED requirement %q uses ID %s, which already exists in base spec", req.Name, req.ID)
+		}
+	}
+
+	// Validate scenario deltas against the renamed base requirements
+	if deltaPlan.HasScenarioDeltas() {
+		if err := validateScenarioDeltasAgainstBase(baseReqs, deltaPlan); err != nil {
+			return err
+		}
+	}
+
+	// Validate placement hints of ADDED requirements
+	baseContent, err := os.ReadFile(baseSpecPath)
+	if err != nil {
+		return fmt.Errorf("read base spec: %w", err)
+	}
+
+	return validatePlacements(
+		baseReqs,
+		parsers.RequirementGroups(string(baseContent)),
+		deltaPlan,
+	)
+}
+
+// UnknownRequirementError reports a MODIFIED, REMOVED or RENAMED FROM
+// reference to a requirement that does not exist in the base spec,
+// together with similarly named base requirements
+type UnknownRequirementError struct {
+	// Operation is "MODIFIED", "REMOVED" or "RENAMED FROM"
+	Operation string
+	// Name is the requirement name the delta referenced
+	Name string
+	// ID is the stable ID the delta referenced, if any
+	ID string
+	// Suggestions are base requirement names similar to Name
+	Suggestions []string
+	// Fix is the suggestion that is safe to apply automatically, if any
+	Fix string
+}
+
+// newUnknownRequirementError builds an UnknownRequirementError with
+// suggestions drawn from the base requirements
+func newUnknownRequirementError(
+	operation, name, id string,
+	baseReqs []parsers.RequirementBlock,
+) *UnknownRequirementError {
+	names := make([]string, 0, len(baseReqs))
+	for _, req := range baseReqs {
+		names = append(names, req.Name)
+	}
+	suggestions, fix := suggestRequirementNames(name, names)
+
+	return &UnknownRequirementError{
+		Operation:   operation,
+		Name:        name,
+		ID:          id,
+		Suggestions: suggestions,
+		Fix:         fix,
+	}
+}
+
+func (e *UnknownRequirementError) Error() string {
+	return fmt.Sprintf(
+		"%s requirement %q does not exist in base spec%s",
+		e.Operation,
+		e.Name,
+		idHint(e.ID),
+	) + didYouMean(e.Suggestions)
+}
+
+// didYouMean formats suggestions as a message suffix
+func didYouMean(suggestions []string) string {
+	if len(suggestions) == 0 {
+		return ""
+	}
+
+	quoted := make([]string, 0, len(suggestions))
+	for _, s := range suggestions {
+		quoted = append(quoted, fmt.Sprintf("%q", s))
+	}
+
+	return "; did you mean " + strings.Join(quoted, " or ") + "?"
+}
+
+// idHint describes the stable ID a delta referenced, for error messages
+func idHint(id string) string {
+	if id == "" {
+		return ""
 	}
 
-	return nil
+	return fmt.Sprintf(" (no requirement with ID %s)", id)
 }
@@ -0,0 +1,77 @@
+package validation
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/graph"
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// validateChangeDependencies checks the changes the proposal of the
+// change in changeDir depends on: each must be an active or archived
+// change, and the dependencies must not form a cycle through it
+func validateChangeDependencies(changeDir, spectrRoot string) []ValidationIssue {
+	proposalPath := filepath.Join(changeDir, "proposal.md")
+	// Malformed frontmatter is reported by validateProposalMetadata
+	deps, err := parsers.ParseDependencies(proposalPath)
+	if err != nil || len(deps) == 0 {
+		return nil
+	}
+
+	g, err := graph.Load(filepath.Dir(spectrRoot))
+	if err != nil {
+		return []ValidationIssue{{
+			Level:   LevelError,
+			Path:    proposalPath,
+			Line:    1,
+			Message: fmt.Sprintf("Failed to load change dependencies: %v", err),
+		}}
+	}
+
+	content, _ := os.ReadFile(proposalPath)
+	lines := strings.Split(string(content), "\n")
+	id := filepath.Base(changeDir)
+
+	var issues []ValidationIssue
+	for _, dep := range g.Missing(id) {
+		issues = append(issues, ValidationIssue{
+			Level: LevelError,
+			Path:  proposalPath,
+			Line:  findDependencyLine(lines, dep),
+			Message: fmt.Sprintf(
+				"Dependency %q is neither an active nor an archived change",
+				dep,
+			),
+		})
+	}
+	for _, cycle := range g.CyclesThrough(id) {
+		// Point at the dependency that leads into the cycle
+		next := cycle[(slices.Index(cycle, id)+1)%len(cycle)]
+		issues = append(issues, ValidationIssue{
+			Level:   LevelError,
+			Path:    proposalPath,
+			Line:    findDependencyLine(lines, next),
+			Message: "Dependency cycle: " + graph.FormatCycle(cycle),
+		})
+	}
+
+	return issues
+}
+
+// findDependencyLine returns the first line mentioning the change ID
+// dep as a whole word, or 1
+func findDependencyLine(lines []string, dep string) int {
+	pattern := regexp.MustCompile(`(^|[^\w-])` + regexp.QuoteMeta(dep) + `($|[^\w-])`)
+	for i, line := range lines {
+		if pattern.MatchString(line) {
+			return i + 1
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,52 @@
+package validation
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateChangeDependencies(t *testing.T) {
+	spectrRoot := filepath.Join(t.TempDir(), "spectr")
+	changesDir := filepath.Join(spectrRoot, "changes")
+	proposals := map[string]string{
+		"archive/2025-01-01-base/proposal.md": "# Change: Base\n",
+		"a/proposal.md":                       "---\ndepends_on: [base, b, gone]\n---\n# Change: A\n",
+		"b/proposal.md":                       "# Change: B\n\n## Depends On\n- a\n",
+		"c/proposal.md":                       "---\ndepends_on: oops\n  bad\n---\n",
+	}
+	for path, content := range proposals {
+		path = filepath.Join(changesDir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	issues := validateChangeDependencies(filepath.Join(changesDir, "a"), spectrRoot)
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 issues, got %+v", issues)
+	}
+	if !strings.Contains(issues[0].Message, `Dependency "gone" is neither`) ||
+		issues[0].Line != 2 {
+		t.Errorf("Unexpected missing dependency issue: %+v", issues[0])
+	}
+	if issues[1].Message != "Dependency cycle: a → b → a" {
+		t.Errorf("Unexpected cycle issue: %+v", issues[1])
+	}
+
+	// The other end of the cycle reports it at its own dependency
+	issues = validateChangeDependencies(filepath.Join(changesDir, "b"), spectrRoot)
+	if len(issues) != 1 || issues[0].Line != 4 {
+		t.Errorf("Expected cycle issue on line 4, got %+v", issues)
+	}
+
+	// Malformed frontmatter is left to the metadata rule
+	issues = validateChangeDependencies(filepath.Join(changesDir, "c"), spectrRoot)
+	if len(issues) != 0 {
+		t.Errorf("Expected no dependency issues, got %+v", issues)
+	}
+}
@@ -0,0 +1,83 @@
+package validation
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// fixFilePerm is the permission used when rewriting fixed files
+const fixFilePerm = 0o644
+
+// ApplyFixes rewrites the requirement and scenario references of every
+// issue that carries a Fix, replacing the old name on the issue's line
+// with the suggested one. It returns the issues whose fix was applied. Issues
+// whose line no longer contains the old name are skipped.
+func ApplyFixes(issues []ValidationIssue) ([]ValidationIssue, error) {
+	byPath := make(map[string][]ValidationIssue)
+	var paths []string
+	for _, issue := range issues {
+		if issue.Fix == nil || issue.Line < 1 {
+			continue
+		}
+		if _, seen := byPath[issue.Path]; !seen {
+			paths = append(paths, issue.Path)
+		}
+		byPath[issue.Path] = append(byPath[issue.Path], issue)
+	}
+
+	var applied []ValidationIssue
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return applied, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		lines := strings.Split(string(content), "\n")
+		var fixed []ValidationIssue
+		for _, issue := range byPath[path] {
+			if issue.Line > len(lines) {
+				continue
+			}
+
+			line, ok := renameHeaderLine(lines[issue.Line-1], issue.Fix)
+			if !ok {
+				continue
+			}
+			lines[issue.Line-1] = line
+			fixed = append(fixed, issue)
+		}
+		if len(fixed) == 0 {
+			continue
+		}
+
+		output := []byte(strings.Join(lines, "\n"))
+		if err := os.WriteFile(path, output, fixFilePerm); err != nil {
+			return applied, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		applied = append(applied, fixed...)
+	}
+
+	return applied, nil
+}
+
+// renameHeaderLine applies fix to a requirement or scenario header line,
+// reporting false when the line is not such a header naming fix.Old
+func renameHeaderLine(line string, fix *IssueFix) (string, bool) {
+	if !strings.Contains(line, fix.Old) {
+		return line, false
+	}
+
+	if strings.Contains(line, "Requirement:") {
+		return parsers.RenameRequirementHeader(line, fix.Old, fix.New), true
+	}
+
+	prefix, name, found := strings.Cut(line, "Scenario:")
+	if !found {
+		return line, false
+	}
+
+	return prefix + "Scenario:" + strings.Replace(name, fix.Old, fix.New, 1), true
+}
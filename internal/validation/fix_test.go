@@ -0,0 +1,104 @@
+package validation
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestApplyFixes_FromChangeValidation(t *testing.T) {
+	specs := map[string]string{
+		"auth/spec.md": `## REMOVED Requirements
+
+### Requirement: Logn {#AUTH-001}
+
+## RENAMED Requirements
+
+- FROM: ` + "`### Requirement: Logout User`" + `
+- TO: ` + "`### Requirement: Sign Out`" + `
+`,
+	}
+	changeDir, spectrRoot := createChangeDir(t, specs)
+	createBaseSpec(t, spectrRoot, "auth", `# Auth Specification
+
+## Requirements
+
+### Requirement: Login {#AUTH-001}
+The system SHALL log users in.
+
+### Requirement: User Logout
+The system SHALL log users out.
+`)
+
+	report, err := ValidateChangeDeltaSpecs(changeDir, spectrRoot, false)
+	if err != nil {
+		t.Fatalf("ValidateChangeDeltaSpecs returned error: %v", err)
+	}
+
+	// REMOVED resolves by ID, so only the RENAMED FROM is unknown
+	var issue *ValidationIssue
+	for i := range report.Issues {
+		if report.Issues[i].Fix != nil {
+			issue = &report.Issues[i]
+		}
+	}
+	if issue == nil {
+		t.Fatalf("Expected an issue with a fix, got %+v", report.Issues)
+	}
+	if issue.Fix.Old != "Logout User" || issue.Fix.New != "User Logout" {
+		t.Errorf("Unexpected fix %+v", issue.Fix)
+	}
+	if !strings.Contains(issue.Message, `did you mean "User Logout"?`) {
+		t.Errorf("Expected suggestion in message, got %q", issue.Message)
+	}
+
+	applied, err := ApplyFixes(report.Issues)
+	if err != nil {
+		t.Fatalf("ApplyFixes returned error: %v", err)
+	}
+	if len(applied) != 1 {
+		t.Fatalf("Expected 1 applied fix, got %d", len(applied))
+	}
+
+	content, err := os.ReadFile(issue.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "- FROM: `### Requirement: User Logout`") {
+		t.Errorf("RENAMED FROM not fixed:\n%s", content)
+	}
+
+	report, err = ValidateChangeDeltaSpecs(changeDir, spectrRoot, false)
+	if err != nil {
+		t.Fatalf("ValidateChangeDeltaSpecs returned error: %v", err)
+	}
+	if !report.Valid {
+		t.Errorf("Expected valid report after fix, got %+v", report.Issues)
+	}
+}
+
+func TestApplyFixes_SkipsStaleLines(t *testing.T) {
+	path := t.TempDir() + "/spec.md"
+	original := "## MODIFIED Requirements\n\n### Requirement: Login\n"
+	if err := os.WriteFile(path, []byte(original), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	applied, err := ApplyFixes([]ValidationIssue{{
+		Level: LevelError,
+		Path:  path,
+		Line:  3,
+		Fix:   &IssueFix{Old: "Logn", New: "Login"},
+	}})
+	if err != nil {
+		t.Fatalf("ApplyFixes returned error: %v", err)
+	}
+	if len(applied) != 0 {
+		t.Errorf("Expected no fixes applied, got %+v", applied)
+	}
+
+	content, _ := os.ReadFile(path)
+	if string(content) != original {
+		t.Errorf("File should be unchanged, got:\n%s", content)
+	}
+}
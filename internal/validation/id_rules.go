@@ -0,0 +1,128 @@
+package validation
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+var requirementHeaderLine = regexp.MustCompile(`^###\s+Requirement:\s*(.+)$`)
+
+// validateRequirementIDs checks the stable IDs declared on requirement
+// headers of a spec or delta file: each ID may be used only once per
+// file, and ID-like suffixes that cannot be parsed are reported.
+func validateRequirementIDs(path string, lines []string) []ValidationIssue {
+	var issues []ValidationIssue
+	seen := make(map[string]int)
+
+	for i, line := range lines {
+		matches := requirementHeaderLine.FindStringSubmatch(strings.TrimSpace(line))
+		if matches == nil {
+			continue
+		}
+
+		name, id := parsers.SplitRequirementID(matches[1])
+		if id == "" {
+			if strings.Contains(name, "{#") || strings.Contains(name, "<!-- id:") {
+				issues = append(issues, ValidationIssue{
+					Level: LevelError,
+					Path:  path,
+					Line:  i + 1,
+					Message: fmt.Sprintf(
+						"Malformed requirement ID in '%s' "+
+							"(expected '{#ID}' where ID starts with a letter, "+
+							"e.g. '{#VAL-012}')",
+						name,
+					),
+				})
+			}
+
+			continue
+		}
+
+		if firstLine, exists := seen[id]; exists {
+			issues = append(issues, ValidationIssue{
+				Level: LevelError,
+				Path:  path,
+				Line:  i + 1,
+				Message: fmt.Sprintf(
+					"Duplicate requirement ID %s (first used on line %d)",
+					id,
+					firstLine,
+				),
+			})
+
+			continue
+		}
+		seen[id] = i + 1
+	}
+
+	return issues
+}
+
+// validateIDsAcrossSpecs reports requirement IDs of the spec at specPath
+// that are also used by another spec in the same specs directory. It
+// only applies to specs laid out as specs/<capability>/spec.md, where
+// the capability may be nested (specs/api/auth/spec.md).
+func validateIDsAcrossSpecs(
+	specPath string,
+	lines []string,
+	requirements []Requirement,
+) []ValidationIssue {
+	capabilityDir := filepath.Dir(specPath)
+	specsDir, ok := findSpecsDir(specPath)
+	if !ok {
+		return nil
+	}
+
+	ids := make(map[string]string)
+	for _, req := range requirements {
+		if req.ID != "" {
+			ids[req.ID] = req.Name
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var issues []ValidationIssue
+	_ = filepath.WalkDir(specsDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() != "spec.md" ||
+			filepath.Dir(path) == capabilityDir {
+			return nil
+		}
+
+		others, err := parsers.ParseRequirements(path)
+		if err != nil {
+			return nil
+		}
+
+		other := discovery.CapabilityID(specsDir, filepath.Dir(path))
+		for _, req := range others {
+			name, clash := ids[req.ID]
+			if req.ID == "" || !clash {
+				continue
+			}
+			issues = append(issues, ValidationIssue{
+				Level: LevelError,
+				Path:  fmt.Sprintf("%s: Requirement '%s'", specPath, name),
+				Line:  findRequirementLine(lines, name, 1),
+				Message: fmt.Sprintf(
+					"Requirement ID %s is also used by '%s' in spec '%s'",
+					req.ID,
+					req.Name,
+					other,
+				),
+			})
+		}
+
+		return nil
+	})
+
+	return issues
+}
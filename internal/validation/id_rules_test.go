@@ -0,0 +1,159 @@
+package validation
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+func TestValidateRequirementIDs(t *testing.T) {
+	lines := strings.Split(`## Requirements
+
+### Requirement: Login {#AUTH-001}
+### Requirement: Logout {#AUTH-001}
+### Requirement: Signup {#1-bad}
+### Requirement: Reset <!-- id: AUTH-003 -->`, "\n")
+
+	issues := validateRequirementIDs("spec.md", lines)
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 issues, got %d: %+v", len(issues), issues)
+	}
+	if !strings.Contains(issues[0].Message, "Duplicate requirement ID AUTH-001") ||
+		issues[0].Line != 4 {
+		t.Errorf("Expected duplicate ID on line 4, got %+v", issues[0])
+	}
+	if !strings.Contains(issues[1].Message, "Malformed requirement ID") ||
+		issues[1].Line != 5 {
+		t.Errorf("Expected malformed ID on line 5, got %+v", issues[1])
+	}
+}
+
+func TestValidateSpecFile_IDUsedByAnotherSpec(t *testing.T) {
+	specsDir := filepath.Join(t.TempDir(), "spectr", "specs")
+	spec := func(name string) string {
+		return `# ` + name + ` Specification
+
+## Purpose
+This specification defines requirements for the ` + name + ` capability.
+
+## Requirements
+
+### Requirement: ` + name + ` Feature {#SHARED-001}
+The system SHALL provide the ` + name + ` feature.
+
+#### Scenario: Feature works
+- **WHEN** the user uses the feature
+- **THEN** it works
+`
+	}
+	for _, name := range []string{"alpha", "beta", "api/gamma"} {
+		dir := filepath.Join(specsDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "spec.md"), []byte(spec(name)), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report, err := ValidateSpecFile(filepath.Join(specsDir, "alpha", "spec.md"), false)
+	if err != nil {
+		t.Fatalf("ValidateSpecFile returned error: %v", err)
+	}
+
+	found := false
+	for _, issue := range report.Issues {
+		if strings.Contains(issue.Message, "SHARED-001 is also used by 'beta Feature' in spec 'beta'") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected cross-spec ID issue, got %+v", report.Issues)
+	}
+
+	// Nested capabilities are checked against the whole specs tree
+	report, err = ValidateSpecFile(filepath.Join(specsDir, "api", "gamma", "spec.md"), false)
+	if err != nil {
+		t.Fatalf("ValidateSpecFile returned error: %v", err)
+	}
+
+	found = false
+	for _, issue := range report.Issues {
+		if strings.Contains(issue.Message, "SHARED-001 is also used by 'alpha Feature' in spec 'alpha'") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected cross-spec ID issue for nested spec, got %+v", report.Issues)
+	}
+}
+
+func TestValidatePreMerge_RequirementIDs(t *testing.T) {
+	basePath := filepath.Join(t.TempDir(), "spec.md")
+	base := `# Auth Specification
+
+## Requirements
+
+### Requirement: Login {#AUTH-001}
+The system SHALL log users in.
+
+### Requirement: Logout
+The system SHALL log users out.
+`
+	if err := os.WriteFile(basePath, []byte(base), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		plan    parsers.DeltaPlan
+		wantErr string
+	}{
+		{
+			name: "modified renamed by ID",
+			plan: parsers.DeltaPlan{
+				Modified: []parsers.RequirementBlock{{Name: "Sign In", ID: "AUTH-001"}},
+			},
+		},
+		{
+			name: "modified with unknown ID",
+			plan: parsers.DeltaPlan{
+				Modified: []parsers.RequirementBlock{{Name: "Login", ID: "AUTH-009"}},
+			},
+			wantErr: `MODIFIED requirement "Login" does not exist in base spec (no requirement with ID AUTH-009)`,
+		},
+		{
+			name: "removed by ID",
+			plan: parsers.DeltaPlan{
+				Removed:    []string{"Old Login"},
+				RemovedIDs: map[string]string{"Old Login": "AUTH-001"},
+			},
+		},
+		{
+			name: "added with existing ID",
+			plan: parsers.DeltaPlan{
+				Added: []parsers.RequirementBlock{{Name: "Signup", ID: "AUTH-001"}},
+			},
+			wantErr: `ADDED requirement "Signup" uses ID AUTH-001, which already exists in base spec`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePreMerge(basePath, &tt.plan, true)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Expected error %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
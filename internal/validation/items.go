@@ -41,6 +41,31 @@ func CreateValidationItems(
 	return items
 }
 
+// GetWorkspaceItems collects the items of every root with get and
+// prefixes their names with the root's path relative to the workspace,
+// e.g. "services/api:add-auth"
+func GetWorkspaceItems(
+	workspacePath string,
+	roots []string,
+	get func(projectPath string) ([]ValidationItem, error),
+) ([]ValidationItem, error) {
+	var items []ValidationItem
+	for _, root := range roots {
+		rootItems, err := get(root)
+		if err != nil {
+			return nil, err
+		}
+
+		label := discovery.RootLabel(workspacePath, root)
+		for i := range rootItems {
+			rootItems[i].Name = label + ":" + rootItems[i].Name
+		}
+		items = append(items, rootItems...)
+	}
+
+	return items, nil
+}
+
 // GetAllItems returns all changes and specs from the project path.
 func GetAllItems(
 	projectPath string,
@@ -0,0 +1,305 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// linkTargets indexes the requirements of every capability by its ID
+type linkTargets map[string]*parsers.RequirementIndex
+
+// findSpecsDir returns the "specs" directory above a spec file laid out
+// as specs/<capability>/spec.md, where the capability may be nested
+func findSpecsDir(specPath string) (string, bool) {
+	specsDir := filepath.Dir(filepath.Dir(specPath))
+	for filepath.Base(specsDir) != "specs" {
+		parent := filepath.Dir(specsDir)
+		if parent == specsDir {
+			return "", false
+		}
+		specsDir = parent
+	}
+
+	return specsDir, true
+}
+
+// loadLinkTargets indexes the requirements of all specs below specsDir.
+// A missing directory yields no targets.
+func loadLinkTargets(specsDir string) linkTargets {
+	targets := make(linkTargets)
+	_ = filepath.WalkDir(specsDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() != "spec.md" {
+			return nil
+		}
+
+		blocks, err := parsers.ParseRequirements(path)
+		if err != nil {
+			return nil
+		}
+		capability := discovery.CapabilityID(specsDir, filepath.Dir(path))
+		targets[capability] = parsers.NewRequirementIndex(blocks)
+
+		return nil
+	})
+
+	return targets
+}
+
+// resolve checks that the link, found in the spec of capability self,
+// points to an existing requirement
+func (t linkTargets) resolve(link parsers.RequirementLink, self string) error {
+	capability := link.TargetCapability(self)
+	ix, ok := t[capability]
+	if !ok {
+		suggestions, _ := suggestRequirementNames(capability, t.capabilities())
+
+		return errors.New(fmt.Sprintf(
+			"capability %q does not exist", capability,
+		) + didYouMean(suggestions))
+	}
+
+	if ix.HasID(link.Requirement) || ix.HasName(link.Requirement) {
+		return nil
+	}
+
+	names := make([]string, 0, ix.Len())
+	for _, block := range ix.Blocks() {
+		names = append(names, block.Name)
+	}
+	suggestions, _ := suggestRequirementNames(link.Requirement, names)
+
+	return errors.New(fmt.Sprintf(
+		"requirement %q does not exist in capability %q",
+		link.Requirement,
+		capability,
+	) + didYouMean(suggestions))
+}
+
+// capabilities returns the sorted capability IDs
+func (t linkTargets) capabilities() []string {
+	ids := make([]string, 0, len(t))
+	for id := range t {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// validateSpecLinks reports links in the spec at path whose target
+// requirement does not exist
+func validateSpecLinks(path, content string) []ValidationIssue {
+	links := parsers.ExtractRequirementLinks(content)
+	if len(links) == 0 {
+		return nil
+	}
+
+	specsDir, ok := findSpecsDir(path)
+	if !ok {
+		return nil
+	}
+	targets := loadLinkTargets(specsDir)
+	self := discovery.CapabilityID(specsDir, filepath.Dir(path))
+
+	var issues []ValidationIssue
+	for _, link := range links {
+		if err := targets.resolve(link, self); err != nil {
+			issues = append(issues, ValidationIssue{
+				Level:   LevelError,
+				Path:    path,
+				Line:    link.Line,
+				Message: fmt.Sprintf("Dangling link %s: %v", link, err),
+			})
+		}
+	}
+
+	return issues
+}
+
+// changeLinkState is the effect of a change's deltas on link targets
+type changeLinkState struct {
+	// targets are the requirements once the change is archived
+	targets linkTargets
+	// renamed maps capability and normalized old name to the new name;
+	// links to old names are rewritten by archive
+	renamed map[string]map[string]string
+	// removed maps capability and normalized name to the REMOVED entry
+	removed map[string]map[string]removedEntry
+	// replaced holds, per capability, the normalized names of
+	// requirements whose content the change replaces
+	replaced map[string]map[string]bool
+}
+
+// removedEntry is a requirement removed by a delta file
+type removedEntry struct {
+	deltaPath string
+	name      string
+}
+
+// setKey records value under capability and the normalized name
+func setKey[V any](m map[string]map[string]V, capability, name string, value V) {
+	if m[capability] == nil {
+		m[capability] = make(map[string]V)
+	}
+	m[capability][parsers.NormalizeRequirementName(name)] = value
+}
+
+// applyChangeDeltas computes the link targets after applying the delta
+// files of a change to the specs in baseSpecsDir
+func applyChangeDeltas(
+	specFiles []string,
+	changeSpecsDir, baseSpecsDir string,
+) *changeLinkState {
+	state := &changeLinkState{
+		targets:  loadLinkTargets(baseSpecsDir),
+		renamed:  make(map[string]map[string]string),
+		removed:  make(map[string]map[string]removedEntry),
+		replaced: make(map[string]map[string]bool),
+	}
+
+	for _, specPath := range specFiles {
+		plan, err := parsers.ParseDeltaSpec(specPath)
+		if err != nil {
+			continue
+		}
+
+		capability := discovery.CapabilityID(changeSpecsDir, filepath.Dir(specPath))
+		ix, ok := state.targets[capability]
+		if !ok {
+			ix = parsers.NewRequirementIndex(nil)
+			state.targets[capability] = ix
+		}
+
+		for _, op := range plan.Renamed {
+			setKey(state.renamed, capability, op.From, op.To)
+			if i, ok := ix.Find(op.ID, op.From); ok {
+				block := ix.Get(i)
+				block.Name = op.To
+				ix.Replace(i, block)
+			}
+		}
+		for _, name := range plan.Removed {
+			setKey(state.removed, capability, name, removedEntry{specPath, name})
+			setKey(state.replaced, capability, name, true)
+			if i, ok := ix.Find(plan.RemovedIDs[name], name); ok {
+				ix.Remove(i)
+			}
+		}
+		for _, block := range plan.Modified {
+			setKey(state.replaced, capability, block.Name, true)
+		}
+		for _, block := range plan.Added {
+			if _, ok := ix.Find(block.ID, block.Name); !ok {
+				ix.Add(block)
+			}
+		}
+	}
+
+	return state
+}
+
+// isRenamed reports whether the link points to the old name of a
+// requirement the change renames
+func (s *changeLinkState) isRenamed(link parsers.RequirementLink, self string) bool {
+	_, ok := s.renamed[link.TargetCapability(self)][parsers.NormalizeRequirementName(link.Requirement)]
+
+	return ok
+}
+
+// validateChangeLinks checks links against the specs as they will be
+// once the change is archived. Links in the delta files must resolve,
+// and links in base specs must not point to requirements the change
+// removes. Links to renamed requirements are accepted, since archiving
+// the change rewrites them.
+func validateChangeLinks(
+	specFiles []string,
+	changeSpecsDir, spectrRoot string,
+) []ValidationIssue {
+	baseSpecsDir := filepath.Join(spectrRoot, "specs")
+	state := applyChangeDeltas(specFiles, changeSpecsDir, baseSpecsDir)
+
+	var issues []ValidationIssue
+	for _, specPath := range specFiles {
+		content, err := os.ReadFile(specPath)
+		if err != nil {
+			continue
+		}
+
+		self := discovery.CapabilityID(changeSpecsDir, filepath.Dir(specPath))
+		for _, link := range parsers.ExtractRequirementLinks(string(content)) {
+			if state.isRenamed(link, self) {
+				continue
+			}
+			if err := state.targets.resolve(link, self); err != nil {
+				issues = append(issues, ValidationIssue{
+					Level:   LevelError,
+					Path:    specPath,
+					Line:    link.Line,
+					Message: fmt.Sprintf("Dangling link %s: %v", link, err),
+				})
+			}
+		}
+	}
+
+	return append(issues, validateInboundLinks(state, baseSpecsDir)...)
+}
+
+// validateInboundLinks reports links in base specs that resolve today
+// but point to a requirement the change removes. Links inside
+// requirements the change replaces are skipped, as their content is
+// taken from the delta.
+func validateInboundLinks(state *changeLinkState, baseSpecsDir string) []ValidationIssue {
+	if len(state.removed) == 0 {
+		return nil
+	}
+
+	var issues []ValidationIssue
+	_ = filepath.WalkDir(baseSpecsDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() != "spec.md" {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+
+		self := discovery.CapabilityID(baseSpecsDir, filepath.Dir(path))
+		for _, link := range parsers.ExtractRequirementLinks(string(content)) {
+			if state.replaced[self][parsers.NormalizeRequirementName(link.Source)] {
+				continue
+			}
+
+			capability := link.TargetCapability(self)
+			entry, ok := state.removed[capability][parsers.NormalizeRequirementName(link.Requirement)]
+			if !ok {
+				continue
+			}
+
+			deltaContent, _ := os.ReadFile(entry.deltaPath)
+			issues = append(issues, ValidationIssue{
+				Level: LevelError,
+				Path:  entry.deltaPath,
+				Line: findRequirementLine(
+					strings.Split(string(deltaContent), "\n"), entry.name, 1,
+				),
+				Message: fmt.Sprintf(
+					"REMOVED requirement %q is still linked from spec %q (line %d)",
+					entry.name,
+					self,
+					link.Line,
+				),
+			})
+		}
+
+		return nil
+	})
+
+	return issues
+}
@@ -0,0 +1,124 @@
+package validation
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeLinkFile writes content to path, creating its directory
+func writeLinkFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// linkSpec returns a spec with one requirement per name, each
+// containing the given body line
+func linkSpec(body string, names ...string) string {
+	var sb strings.Builder
+	sb.WriteString("# Spec\n\n## Purpose\nThis specification covers a capability used to test requirement links.\n\n## Requirements\n")
+	for _, name := range names {
+		sb.WriteString("\n### Requirement: " + name + "\n")
+		sb.WriteString("The system SHALL work. " + body + "\n\n")
+		sb.WriteString("#### Scenario: Works\n- **WHEN** used\n- **THEN** it works\n")
+	}
+
+	return sb.String()
+}
+
+func TestValidateSpecFile_Links(t *testing.T) {
+	specsDir := filepath.Join(t.TempDir(), "spectr", "specs")
+	writeLinkFile(t, filepath.Join(specsDir, "auth", "spec.md"),
+		linkSpec("", "User Login {#AUTH-001}", "User Logout"))
+	billing := filepath.Join(specsDir, "api", "billing", "spec.md")
+	writeLinkFile(t, billing, linkSpec(
+		"See [[auth#User Login]], [[auth#AUTH-001]], [[#Invoices]], "+
+			"[[auth#User Logon]] and [[payments#Refunds]].",
+		"Invoices",
+	))
+
+	report, err := ValidateSpecFile(billing, false)
+	if err != nil {
+		t.Fatalf("ValidateSpecFile returned error: %v", err)
+	}
+
+	var messages []string
+	for _, issue := range report.Issues {
+		if strings.Contains(issue.Message, "Dangling link") {
+			messages = append(messages, issue.Message)
+			if issue.Line != 9 {
+				t.Errorf("Expected issue on line 9, got %+v", issue)
+			}
+		}
+	}
+	if len(messages) != 2 {
+		t.Fatalf("Expected 2 dangling links, got %v", report.Issues)
+	}
+	if !strings.Contains(messages[0], `[[auth#User Logon]]`) ||
+		!strings.Contains(messages[0], `did you mean "User Login"`) {
+		t.Errorf("Unexpected message: %s", messages[0])
+	}
+	if !strings.Contains(messages[1], `capability "payments" does not exist`) {
+		t.Errorf("Unexpected message: %s", messages[1])
+	}
+}
+
+func TestValidateChangeDeltaSpecs_Links(t *testing.T) {
+	spectrRoot := filepath.Join(t.TempDir(), "spectr")
+	specsDir := filepath.Join(spectrRoot, "specs")
+	writeLinkFile(t, filepath.Join(specsDir, "auth", "spec.md"),
+		linkSpec("", "User Login", "User Logout", "Password Reset"))
+	writeLinkFile(t, filepath.Join(specsDir, "billing", "spec.md"),
+		linkSpec("Requires [[auth#User Login]] and [[auth#Password Reset]].", "Invoices"))
+
+	changeDir := filepath.Join(spectrRoot, "changes", "rework-auth")
+	delta := filepath.Join(changeDir, "specs", "auth", "spec.md")
+	writeLinkFile(t, delta, `## RENAMED Requirements
+- FROM: `+"`### Requirement: User Login`"+`
+- TO: `+"`### Requirement: Sign In`"+`
+
+## REMOVED Requirements
+### Requirement: Password Reset
+**Reason**: Replaced by magic links
+**Migration**: Use magic links
+
+## ADDED Requirements
+### Requirement: Magic Link
+The system SHALL send magic links after [[#Sign In]] fails, unlike [[#User Logout]].
+Old name [[#User Login]] is rewritten on archive; [[#Password Reset]] is gone.
+
+#### Scenario: Link sent
+- **WHEN** a user requests a link
+- **THEN** it is sent
+`)
+
+	report, err := ValidateChangeDeltaSpecs(changeDir, spectrRoot, false)
+	if err != nil {
+		t.Fatalf("ValidateChangeDeltaSpecs returned error: %v", err)
+	}
+
+	var dangling, inbound []ValidationIssue
+	for _, issue := range report.Issues {
+		switch {
+		case strings.Contains(issue.Message, "Dangling link"):
+			dangling = append(dangling, issue)
+		case strings.Contains(issue.Message, "still linked"):
+			inbound = append(inbound, issue)
+		}
+	}
+
+	if len(dangling) != 1 || !strings.Contains(dangling[0].Message, "[[#Password Reset]]") ||
+		dangling[0].Line != 13 {
+		t.Errorf("Expected dangling link to Password Reset on line 13, got %+v", dangling)
+	}
+	if len(inbound) != 1 || inbound[0].Path != delta || inbound[0].Line != 6 ||
+		!strings.Contains(inbound[0].Message, `"Password Reset" is still linked from spec "billing"`) {
+		t.Errorf("Expected inbound link issue on the REMOVED entry, got %+v", inbound)
+	}
+}
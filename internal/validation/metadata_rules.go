@@ -0,0 +1,64 @@
+package validation
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// validateProposalMetadata checks the frontmatter of the proposal of the
+// change in changeDir against the proposal schema. Unknown fields are
+// warnings; malformed frontmatter and invalid values are errors.
+func validateProposalMetadata(changeDir string) []ValidationIssue {
+	proposalPath := filepath.Join(changeDir, "proposal.md")
+	content, err := os.ReadFile(proposalPath)
+	if err != nil {
+		return nil
+	}
+
+	frontmatter, _, err := parsers.ParseFrontmatter(string(content))
+	if err != nil {
+		return []ValidationIssue{{
+			Level:   LevelError,
+			Path:    proposalPath,
+			Line:    1,
+			Message: fmt.Sprintf("Invalid proposal frontmatter: %v", err),
+		}}
+	}
+
+	lines := strings.Split(string(content), "\n")
+	var issues []ValidationIssue
+	for _, fieldErr := range parsers.CheckProposalFrontmatter(frontmatter) {
+		level := LevelError
+		if fieldErr.Unknown {
+			level = LevelWarning
+		}
+		issues = append(issues, ValidationIssue{
+			Level:   level,
+			Path:    proposalPath,
+			Line:    findFrontmatterLine(lines, fieldErr.Field),
+			Message: fmt.Sprintf("Frontmatter field %s", fieldErr.Error()),
+		})
+	}
+
+	return issues
+}
+
+// findFrontmatterLine returns the line declaring field in the leading
+// frontmatter block, or 1
+func findFrontmatterLine(lines []string, field string) int {
+	for i := 1; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if line == "---" {
+			break
+		}
+		if strings.HasPrefix(line, field+":") {
+			return i + 1
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,49 @@
+package validation
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateProposalMetadata(t *testing.T) {
+	changeDir := t.TempDir()
+	proposal := `---
+owner: alice
+status: shipped
+reviewer: bob
+created: 2025-01-15
+---
+# Change: Example
+`
+	if err := os.WriteFile(filepath.Join(changeDir, "proposal.md"), []byte(proposal), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	issues := validateProposalMetadata(changeDir)
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 issues, got %+v", issues)
+	}
+	if issues[0].Level != LevelWarning || issues[0].Line != 4 ||
+		!strings.Contains(issues[0].Message, "reviewer: is not a known proposal field") {
+		t.Errorf("Unexpected unknown field issue: %+v", issues[0])
+	}
+	if issues[1].Level != LevelError || issues[1].Line != 3 ||
+		!strings.Contains(issues[1].Message, `status: "shipped" is not one of`) {
+		t.Errorf("Unexpected status issue: %+v", issues[1])
+	}
+}
+
+func TestValidateProposalMetadata_Malformed(t *testing.T) {
+	changeDir := t.TempDir()
+	proposal := "---\n- orphan\n---\n# Change: Example\n"
+	if err := os.WriteFile(filepath.Join(changeDir, "proposal.md"), []byte(proposal), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	issues := validateProposalMetadata(changeDir)
+	if len(issues) != 1 || !strings.Contains(issues[0].Message, "Invalid proposal frontmatter") {
+		t.Errorf("Expected frontmatter issue, got %+v", issues)
+	}
+}
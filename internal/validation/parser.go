@@ -5,11 +5,15 @@ import (
 	"bufio"
 	"regexp"
 	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
 )
 
 // Requirement represents a parsed requirement with its content and scenarios
 type Requirement struct {
-	Name      string
+	Name string
+	// ID is the stable requirement ID from the header, if any
+	ID        string
 	Content   string
 	Scenarios []string
 }
@@ -79,8 +83,10 @@ func ExtractRequirements(content string) []Requirement {
 			)
 
 			// Start new requirement
+			name, id := parsers.SplitRequirementID(matches[1])
 			currentRequirement = &Requirement{
-				Name: strings.TrimSpace(matches[1]),
+				Name: name,
+				ID:   id,
 			}
 			currentContent.Reset()
 
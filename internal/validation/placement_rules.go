@@ -0,0 +1,131 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// validatePlacements checks the placement hints of ADDED requirements:
+// "after:" and "before:" must name a requirement that still exists once
+// renames and removals apply, or another ADDED requirement, and
+// "group:" must name a grouping heading of the base spec. ADDED
+// requirements may not be placed relative to each other in a cycle.
+func validatePlacements(
+	baseReqs []parsers.RequirementBlock,
+	groups []string,
+	plan *parsers.DeltaPlan,
+) error {
+	if len(plan.AddedPlacements) == 0 {
+		return nil
+	}
+
+	target := parsers.NewRequirementIndex(baseReqs)
+	for _, op := range plan.Renamed {
+		if i, ok := target.Find(op.ID, op.From); ok {
+			req := target.Get(i)
+			req.Name = op.To
+			target.Replace(i, req)
+		}
+	}
+	for _, name := range plan.Removed {
+		if i, ok := target.Find(plan.RemovedIDs[name], name); ok {
+			target.Remove(i)
+		}
+	}
+
+	added := make(map[string]bool, len(plan.Added))
+	candidates := make([]string, 0, len(baseReqs)+len(plan.Added))
+	for _, req := range target.Blocks() {
+		candidates = append(candidates, req.Name)
+	}
+	for _, req := range plan.Added {
+		added[parsers.NormalizeRequirementName(req.Name)] = true
+		candidates = append(candidates, req.Name)
+	}
+
+	// anchors maps ADDED requirements to the ADDED requirement they are
+	// placed next to, for cycle detection
+	anchors := make(map[string]string)
+	for _, req := range plan.Added {
+		placement, ok := plan.AddedPlacements[req.Name]
+		if !ok {
+			continue
+		}
+		name := parsers.NormalizeRequirementName(req.Name)
+
+		if placement.Group != "" {
+			if !containsNormalized(groups, placement.Group) {
+				suggestions, _ := suggestRequirementNames(placement.Group, groups)
+
+				return errors.New(fmt.Sprintf(
+					"ADDED requirement %q is placed in group %q, "+
+						"which does not exist in base spec",
+					req.Name,
+					placement.Group,
+				) + didYouMean(suggestions))
+			}
+
+			continue
+		}
+
+		relation := "after"
+		if placement.Before != "" {
+			relation = "before"
+		}
+		anchor := placement.Target()
+		normalized := parsers.NormalizeRequirementName(anchor)
+
+		if _, ok := target.Find(anchor, ""); ok {
+			continue
+		}
+		if _, ok := target.Find("", anchor); ok {
+			continue
+		}
+		if added[normalized] && normalized != name {
+			anchors[name] = normalized
+
+			continue
+		}
+
+		suggestions, _ := suggestRequirementNames(anchor, candidates)
+
+		return errors.New(fmt.Sprintf(
+			"ADDED requirement %q is placed %s %q, "+
+				"which does not exist in base spec",
+			req.Name,
+			relation,
+			anchor,
+		) + didYouMean(suggestions))
+	}
+
+	for start := range anchors {
+		seen := map[string]bool{start: true}
+		for next, ok := anchors[start]; ok; next, ok = anchors[next] {
+			if seen[next] {
+				return fmt.Errorf(
+					"placement hints of ADDED requirements form a cycle "+
+						"through %q",
+					next,
+				)
+			}
+			seen[next] = true
+		}
+	}
+
+	return nil
+}
+
+// containsNormalized reports whether names contains name, comparing
+// normalized names
+func containsNormalized(names []string, name string) bool {
+	normalized := parsers.NormalizeRequirementName(name)
+	for _, n := range names {
+		if parsers.NormalizeRequirementName(n) == normalized {
+			return true
+		}
+	}
+
+	return false
+}
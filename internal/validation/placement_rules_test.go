@@ -0,0 +1,165 @@
+package validation
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+const placementBaseSpec = `# Auth Specification
+
+## Purpose
+This specification defines authentication requirements for the system.
+
+## Requirements
+
+### Sessions
+
+### Requirement: User Login {#AUTH-001}
+The system SHALL log users in.
+
+#### Scenario: Valid credentials
+- **WHEN** credentials are valid
+- **THEN** the user is logged in
+
+### Requirement: User Logout
+The system SHALL log users out.
+
+#### Scenario: Logout works
+- **WHEN** the user logs out
+- **THEN** the session ends
+`
+
+func TestValidatePreMerge_Placements(t *testing.T) {
+	basePath := filepath.Join(t.TempDir(), "spec.md")
+	if err := os.WriteFile(basePath, []byte(placementBaseSpec), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		plan    parsers.DeltaPlan
+		wantErr string
+	}{
+		{
+			name: "after existing requirement",
+			plan: parsers.DeltaPlan{
+				Added:           []parsers.RequirementBlock{{Name: "Session Timeout"}},
+				AddedPlacements: map[string]parsers.Placement{"Session Timeout": {After: "user login"}},
+			},
+		},
+		{
+			name: "before requirement by ID",
+			plan: parsers.DeltaPlan{
+				Added:           []parsers.RequirementBlock{{Name: "Session Timeout"}},
+				AddedPlacements: map[string]parsers.Placement{"Session Timeout": {Before: "AUTH-001"}},
+			},
+		},
+		{
+			name: "existing group",
+			plan: parsers.DeltaPlan{
+				Added:           []parsers.RequirementBlock{{Name: "Session Timeout"}},
+				AddedPlacements: map[string]parsers.Placement{"Session Timeout": {Group: "Sessions"}},
+			},
+		},
+		{
+			name: "after renamed requirement",
+			plan: parsers.DeltaPlan{
+				Renamed:         []parsers.RenameOp{{From: "User Login", To: "Sign In"}},
+				Added:           []parsers.RequirementBlock{{Name: "Session Timeout"}},
+				AddedPlacements: map[string]parsers.Placement{"Session Timeout": {After: "Sign In"}},
+			},
+		},
+		{
+			name: "after another added requirement",
+			plan: parsers.DeltaPlan{
+				Added: []parsers.RequirementBlock{{Name: "Session Timeout"}, {Name: "Idle Warning"}},
+				AddedPlacements: map[string]parsers.Placement{
+					"Session Timeout": {After: "User Login"},
+					"Idle Warning":    {After: "Session Timeout"},
+				},
+			},
+		},
+		{
+			name: "unknown requirement",
+			plan: parsers.DeltaPlan{
+				Added:           []parsers.RequirementBlock{{Name: "Session Timeout"}},
+				AddedPlacements: map[string]parsers.Placement{"Session Timeout": {After: "User Logn"}},
+			},
+			wantErr: `ADDED requirement "Session Timeout" is placed after "User Logn", ` +
+				`which does not exist in base spec; did you mean "User Login"`,
+		},
+		{
+			name: "removed requirement",
+			plan: parsers.DeltaPlan{
+				Removed:         []string{"User Logout"},
+				Added:           []parsers.RequirementBlock{{Name: "Session Timeout"}},
+				AddedPlacements: map[string]parsers.Placement{"Session Timeout": {Before: "User Logout"}},
+			},
+			wantErr: `is placed before "User Logout", which does not exist in base spec`,
+		},
+		{
+			name: "unknown group",
+			plan: parsers.DeltaPlan{
+				Added:           []parsers.RequirementBlock{{Name: "Session Timeout"}},
+				AddedPlacements: map[string]parsers.Placement{"Session Timeout": {Group: "Sesions"}},
+			},
+			wantErr: `is placed in group "Sesions", which does not exist in base spec; did you mean "Sessions"?`,
+		},
+		{
+			name: "placed after itself",
+			plan: parsers.DeltaPlan{
+				Added:           []parsers.RequirementBlock{{Name: "Session Timeout"}},
+				AddedPlacements: map[string]parsers.Placement{"Session Timeout": {After: "Session Timeout"}},
+			},
+			wantErr: `is placed after "Session Timeout", which does not exist in base spec`,
+		},
+		{
+			name: "cycle",
+			plan: parsers.DeltaPlan{
+				Added: []parsers.RequirementBlock{{Name: "A"}, {Name: "B"}},
+				AddedPlacements: map[string]parsers.Placement{
+					"A": {After: "B"},
+					"B": {Before: "A"},
+				},
+			},
+			wantErr: "placement hints of ADDED requirements form a cycle",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePreMerge(basePath, &tt.plan, true)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidatePreMerge_PlacementsInNewSpec(t *testing.T) {
+	basePath := filepath.Join(t.TempDir(), "spec.md")
+
+	plan := &parsers.DeltaPlan{
+		Added:           []parsers.RequirementBlock{{Name: "Login"}, {Name: "Logout"}},
+		AddedPlacements: map[string]parsers.Placement{"Logout": {Before: "Login"}},
+	}
+	if err := ValidatePreMerge(basePath, plan, false); err != nil {
+		t.Errorf("Expected placement relative to ADDED requirement, got %v", err)
+	}
+
+	plan.AddedPlacements["Logout"] = parsers.Placement{Group: "Sessions"}
+	if err := ValidatePreMerge(basePath, plan, false); err == nil {
+		t.Error("Expected error for group in a new spec")
+	}
+}
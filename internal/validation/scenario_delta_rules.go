@@ -0,0 +1,309 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// scenarioSections lists the scenario delta sections in the order the
+// archive merger applies them
+var scenarioSections = []string{"REMOVED", "MODIFIED", "ADDED"}
+
+// UnknownScenarioError reports a MODIFIED or REMOVED scenario that does
+// not exist in the targeted base requirement, together with similarly
+// named scenarios of that requirement
+type UnknownScenarioError struct {
+	// Operation is "MODIFIED" or "REMOVED"
+	Operation string
+	// Requirement is the name of the targeted requirement
+	Requirement string
+	// Name is the scenario name the delta referenced
+	Name string
+	// Suggestions are scenario names of the requirement similar to Name
+	Suggestions []string
+	// Fix is the suggestion that is safe to apply automatically, if any
+	Fix string
+}
+
+func (e *UnknownScenarioError) Error() string {
+	return fmt.Sprintf(
+		"%s scenario %q does not exist in requirement %q",
+		e.Operation,
+		e.Name,
+		e.Requirement,
+	) + didYouMean(e.Suggestions)
+}
+
+// scenarioDeltas returns the scenario deltas of plan for a section type
+func scenarioDeltas(plan *parsers.DeltaPlan, sectionType string) []parsers.ScenarioDelta {
+	switch sectionType {
+	case "ADDED":
+		return plan.AddedScenarios
+	case "MODIFIED":
+		return plan.ModifiedScenarios
+	default:
+		return plan.RemovedScenarios
+	}
+}
+
+// CheckScenarioDeltaConflicts reports scenario deltas that cannot be
+// applied unambiguously: deltas without scenarios, deltas targeting a
+// requirement that the same file adds, modifies or removes as a whole,
+// and scenarios named more than once for the same requirement.
+func CheckScenarioDeltaConflicts(plan *parsers.DeltaPlan) error {
+	wholeRequirement := make(map[string]string)
+	for _, req := range plan.Added {
+		wholeRequirement[parsers.NormalizeRequirementName(req.Name)] = "ADDED"
+	}
+	for _, req := range plan.Modified {
+		wholeRequirement[parsers.NormalizeRequirementName(req.Name)] = "MODIFIED"
+	}
+	for _, name := range plan.Removed {
+		wholeRequirement[parsers.NormalizeRequirementName(name)] = "REMOVED"
+	}
+
+	// requirement -> scenario -> section that names it
+	seen := make(map[string]map[string]string)
+	for _, sectionType := range scenarioSections {
+		for _, delta := range scenarioDeltas(plan, sectionType) {
+			req := parsers.NormalizeRequirementName(delta.Requirement)
+			if len(delta.Scenarios) == 0 {
+				return fmt.Errorf(
+					"%s Scenarios entry for requirement %q lists no scenarios",
+					sectionType,
+					delta.Requirement,
+				)
+			}
+			if section, ok := wholeRequirement[req]; ok {
+				return fmt.Errorf(
+					"requirement %q appears in both %s Requirements and "+
+						"%s Scenarios sections; edit its scenarios in %s Requirements",
+					delta.Requirement,
+					section,
+					sectionType,
+					section,
+				)
+			}
+
+			if seen[req] == nil {
+				seen[req] = make(map[string]string)
+			}
+			for _, scenario := range delta.Scenarios {
+				name := parsers.NormalizeRequirementName(scenario.Name)
+				if section, ok := seen[req][name]; ok {
+					return fmt.Errorf(
+						"scenario %q of requirement %q appears in both "+
+							"%s Scenarios and %s Scenarios sections",
+						scenario.Name,
+						delta.Requirement,
+						section,
+						sectionType,
+					)
+				}
+				seen[req][name] = sectionType
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateScenarioDeltasAgainstBase checks scenario deltas against the
+// base requirements after renames have been applied: targeted
+// requirements and MODIFIED/REMOVED scenarios must exist, ADDED
+// scenarios must not, and every requirement must keep a scenario.
+func validateScenarioDeltasAgainstBase(
+	baseReqs []parsers.RequirementBlock,
+	plan *parsers.DeltaPlan,
+) error {
+	target := parsers.NewRequirementIndex(baseReqs)
+	for _, op := range plan.Renamed {
+		if i, ok := target.Find(op.ID, op.From); ok {
+			req := target.Get(i)
+			req.Name = op.To
+			target.Replace(i, req)
+		}
+	}
+
+	// Scenario names per targeted requirement, updated as deltas apply
+	scenarios := make(map[int][]string)
+	for _, sectionType := range scenarioSections {
+		for _, delta := range scenarioDeltas(plan, sectionType) {
+			i, ok := target.Find(delta.RequirementID, delta.Requirement)
+			if !ok {
+				return newUnknownRequirementError(
+					sectionType+" Scenarios target",
+					delta.Requirement,
+					delta.RequirementID,
+					target.Blocks(),
+				)
+			}
+
+			req := target.Get(i)
+			if _, loaded := scenarios[i]; !loaded {
+				_, blocks := parsers.SplitRequirementScenarios(req.Raw)
+				for _, block := range blocks {
+					scenarios[i] = append(scenarios[i], block.Name)
+				}
+			}
+
+			names, err := applyScenarioNames(sectionType, req.Name, scenarios[i], delta.Scenarios)
+			if err != nil {
+				return err
+			}
+			scenarios[i] = names
+		}
+	}
+
+	for i, names := range scenarios {
+		if len(names) == 0 {
+			return fmt.Errorf(
+				"REMOVED Scenarios leave requirement %q without scenarios",
+				target.Get(i).Name,
+			)
+		}
+	}
+
+	return nil
+}
+
+// applyScenarioNames applies one scenario delta to the scenario names of
+// a requirement, returning an error for scenarios that cannot apply
+func applyScenarioNames(
+	sectionType, requirement string,
+	names []string,
+	deltas []parsers.ScenarioBlock,
+) ([]string, error) {
+	for _, scenario := range deltas {
+		j := -1
+		for k, name := range names {
+			if parsers.NormalizeRequirementName(name) ==
+				parsers.NormalizeRequirementName(scenario.Name) {
+				j = k
+			}
+		}
+
+		switch {
+		case sectionType == "ADDED" && j >= 0:
+			return nil, fmt.Errorf(
+				"ADDED scenario %q already exists in requirement %q",
+				scenario.Name,
+				requirement,
+			)
+		case sectionType == "ADDED":
+			names = append(names, scenario.Name)
+		case j < 0:
+			suggestions, fix := suggestRequirementNames(scenario.Name, names)
+
+			return nil, &UnknownScenarioError{
+				Operation:   sectionType,
+				Requirement: requirement,
+				Name:        scenario.Name,
+				Suggestions: suggestions,
+				Fix:         fix,
+			}
+		case sectionType == "REMOVED":
+			names = append(names[:j], names[j+1:]...)
+		}
+	}
+
+	return names, nil
+}
+
+// validateScenarioDeltaSections checks the structure of the scenario
+// delta sections of one delta file. It returns the issues found and the
+// number of scenario delta sections present.
+func validateScenarioDeltaSections(
+	specPath string,
+	lines []string,
+	sections map[string]string,
+) ([]ValidationIssue, int, error) {
+	var present []string
+	for _, sectionType := range scenarioSections {
+		if _, ok := sections[sectionType+" Scenarios"]; ok {
+			present = append(present, sectionType)
+		}
+	}
+	if len(present) == 0 {
+		return nil, 0, nil
+	}
+
+	plan, err := parsers.ParseDeltaSpec(specPath)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to parse delta spec: %w", err)
+	}
+
+	var issues []ValidationIssue
+	for _, sectionType := range present {
+		sectionName := sectionType + " Scenarios"
+		sectionLine := findDeltaSectionLine(lines, sectionName)
+		deltas := scenarioDeltas(plan, sectionType)
+		if len(deltas) == 0 {
+			issues = append(issues, ValidationIssue{
+				Level:   LevelError,
+				Path:    specPath,
+				Line:    sectionLine,
+				Message: sectionName + " section is empty (no requirements found)",
+			})
+
+			continue
+		}
+
+		if sectionType == "REMOVED" {
+			continue
+		}
+
+		// ADDED and MODIFIED scenarios carry full content
+		for _, delta := range deltas {
+			reqLine := findRequirementLineInSection(lines, delta.Requirement, sectionLine)
+			for _, scenario := range delta.Scenarios {
+				parsed := parsers.ParseScenarioBlocks(scenario.Raw)
+				if len(parsed) > 0 && len(parsed[0].Steps) > 0 {
+					continue
+				}
+				issues = append(issues, ValidationIssue{
+					Level: LevelError,
+					Path: fmt.Sprintf(
+						"%s: %s Scenario '%s'",
+						specPath,
+						sectionType,
+						scenario.Name,
+					),
+					Line: findScenarioLine(lines, scenario.Name, reqLine),
+					Message: fmt.Sprintf(
+						"%s scenario must have at least one step "+
+							"(e.g. - **WHEN** ... / - **THEN** ...)",
+						sectionType,
+					),
+				})
+			}
+		}
+	}
+
+	if err := CheckScenarioDeltaConflicts(plan); err != nil {
+		issues = append(issues, ValidationIssue{
+			Level:   LevelError,
+			Path:    specPath,
+			Line:    findDeltaSectionLine(lines, present[0]+" Scenarios"),
+			Message: err.Error(),
+		})
+	}
+
+	return issues, len(present), nil
+}
+
+// findScenarioLine finds the line of a "#### Scenario:" header at or
+// after startLine. Returns startLine if not found.
+func findScenarioLine(lines []string, name string, startLine int) int {
+	for i := max(startLine-1, 0); i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		header, found := strings.CutPrefix(trimmed, "#### Scenario:")
+		if found && strings.TrimSpace(header) == name {
+			return i + 1
+		}
+	}
+
+	return startLine
+}
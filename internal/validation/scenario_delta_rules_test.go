@@ -0,0 +1,261 @@
+package validation
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+const scenarioBaseSpec = `# Auth Specification
+
+## Purpose
+This specification defines authentication requirements for the system.
+
+## Requirements
+
+### Requirement: User Login
+The system SHALL log users in.
+
+#### Scenario: Valid credentials
+- **WHEN** credentials are valid
+- **THEN** the user is logged in
+
+### Requirement: User Logout
+The system SHALL log users out.
+
+#### Scenario: Logout works
+- **WHEN** the user logs out
+- **THEN** the session ends
+
+#### Scenario: Idle timeout
+- **WHEN** the user is idle
+- **THEN** the session ends
+`
+
+func TestValidateChangeDeltaSpecs_ScenarioDeltas(t *testing.T) {
+	specs := map[string]string{
+		"auth/spec.md": `## ADDED Scenarios
+
+### Requirement: User Login
+
+#### Scenario: Remember me
+- **WHEN** the user ticks remember me
+- **THEN** the session persists
+
+## REMOVED Scenarios
+
+### Requirement: User Logout
+
+#### Scenario: Idle timeout
+`,
+	}
+	changeDir, spectrRoot := createChangeDir(t, specs)
+	createBaseSpec(t, spectrRoot, "auth", scenarioBaseSpec)
+
+	report, err := ValidateChangeDeltaSpecs(changeDir, spectrRoot, false)
+	if err != nil {
+		t.Fatalf("ValidateChangeDeltaSpecs returned error: %v", err)
+	}
+	if !report.Valid {
+		t.Errorf("Expected valid report, got %+v", report.Issues)
+	}
+}
+
+func TestValidateChangeDeltaSpecs_ScenarioDeltaErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		delta    string
+		wantMsg  string
+		wantLine int
+	}{
+		{
+			name: "scenario without steps",
+			delta: `## ADDED Scenarios
+
+### Requirement: User Login
+
+#### Scenario: Remember me
+The session persists.
+`,
+			wantMsg:  "ADDED scenario must have at least one step",
+			wantLine: 5,
+		},
+		{
+			name: "unknown scenario",
+			delta: `## MODIFIED Scenarios
+
+### Requirement: User Logout
+
+#### Scenario: Idle timeot
+- **WHEN** the user is idle for an hour
+- **THEN** the session ends
+`,
+			wantMsg:  `MODIFIED scenario "Idle timeot" does not exist in requirement "User Logout"; did you mean "Idle timeout"?`,
+			wantLine: 5,
+		},
+		{
+			name: "unknown requirement",
+			delta: `## REMOVED Scenarios
+
+### Requirement: User Signup
+
+#### Scenario: Valid credentials
+`,
+			wantMsg:  `REMOVED Scenarios target requirement "User Signup" does not exist in base spec`,
+			wantLine: 3,
+		},
+		{
+			name: "added scenario exists",
+			delta: `## ADDED Scenarios
+
+### Requirement: User Login
+
+#### Scenario: Valid credentials
+- **WHEN** credentials are valid
+- **THEN** the user is logged in
+`,
+			wantMsg:  `ADDED scenario "Valid credentials" already exists in requirement "User Login"`,
+			wantLine: 5,
+		},
+		{
+			name: "last scenario removed",
+			delta: `## REMOVED Scenarios
+
+### Requirement: User Login
+
+#### Scenario: Valid credentials
+`,
+			wantMsg:  `REMOVED Scenarios leave requirement "User Login" without scenarios`,
+			wantLine: 3,
+		},
+		{
+			name: "conflicts with MODIFIED requirement",
+			delta: `## MODIFIED Requirements
+
+### Requirement: User Login
+The system SHALL log users in.
+
+#### Scenario: Valid credentials
+- **WHEN** credentials are valid
+- **THEN** the user is logged in
+
+## REMOVED Scenarios
+
+### Requirement: User Login
+
+#### Scenario: Valid credentials
+`,
+			wantMsg:  "appears in both MODIFIED Requirements and REMOVED Scenarios sections",
+			wantLine: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changeDir, spectrRoot := createChangeDir(t, map[string]string{"auth/spec.md": tt.delta})
+			createBaseSpec(t, spectrRoot, "auth", scenarioBaseSpec)
+
+			report, err := ValidateChangeDeltaSpecs(changeDir, spectrRoot, false)
+			if err != nil {
+				t.Fatalf("ValidateChangeDeltaSpecs returned error: %v", err)
+			}
+
+			for _, issue := range report.Issues {
+				if strings.Contains(issue.Message, tt.wantMsg) {
+					if issue.Line != tt.wantLine {
+						t.Errorf("Expected line %d, got %d", tt.wantLine, issue.Line)
+					}
+
+					return
+				}
+			}
+			t.Errorf("Expected issue containing %q, got %+v", tt.wantMsg, report.Issues)
+		})
+	}
+}
+
+func TestCheckScenarioDeltaConflicts(t *testing.T) {
+	scenario := func(name string) []parsers.ScenarioBlock {
+		return []parsers.ScenarioBlock{{Name: name}}
+	}
+
+	tests := []struct {
+		name    string
+		plan    parsers.DeltaPlan
+		wantErr string
+	}{
+		{
+			name: "independent operations",
+			plan: parsers.DeltaPlan{
+				AddedScenarios:   []parsers.ScenarioDelta{{Requirement: "Login", Scenarios: scenario("A")}},
+				RemovedScenarios: []parsers.ScenarioDelta{{Requirement: "Login", Scenarios: scenario("B")}},
+			},
+		},
+		{
+			name: "same scenario twice",
+			plan: parsers.DeltaPlan{
+				ModifiedScenarios: []parsers.ScenarioDelta{{Requirement: "Login", Scenarios: scenario("A")}},
+				RemovedScenarios:  []parsers.ScenarioDelta{{Requirement: "login", Scenarios: scenario("a")}},
+			},
+			wantErr: `scenario "A" of requirement "Login" appears in both REMOVED Scenarios and MODIFIED Scenarios sections`,
+		},
+		{
+			name: "no scenarios",
+			plan: parsers.DeltaPlan{
+				AddedScenarios: []parsers.ScenarioDelta{{Requirement: "Login"}},
+			},
+			wantErr: `ADDED Scenarios entry for requirement "Login" lists no scenarios`,
+		},
+		{
+			name: "requirement removed",
+			plan: parsers.DeltaPlan{
+				Removed:        []string{"Login"},
+				AddedScenarios: []parsers.ScenarioDelta{{Requirement: "Login", Scenarios: scenario("A")}},
+			},
+			wantErr: "appears in both REMOVED Requirements and ADDED Scenarios sections",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckScenarioDeltaConflicts(&tt.plan)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidatePreMerge_ScenarioDeltasAfterRename(t *testing.T) {
+	basePath := filepath.Join(t.TempDir(), "spec.md")
+	if err := os.WriteFile(basePath, []byte(scenarioBaseSpec), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	plan := &parsers.DeltaPlan{
+		Renamed: []parsers.RenameOp{{From: "User Login", To: "Sign In"}},
+		AddedScenarios: []parsers.ScenarioDelta{{
+			Requirement: "Sign In",
+			Scenarios:   []parsers.ScenarioBlock{{Name: "Remember me"}},
+		}},
+	}
+	if err := ValidatePreMerge(basePath, plan, true); err != nil {
+		t.Errorf("Expected scenario delta to target renamed requirement, got %v", err)
+	}
+
+	// "User Login" no longer exists once the rename applies
+	plan.AddedScenarios[0].Requirement = "User Login"
+	if err := ValidatePreMerge(basePath, plan, true); err == nil {
+		t.Error("Expected error when targeting the pre-rename name")
+	}
+}
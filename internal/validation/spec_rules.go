@@ -110,8 +110,18 @@ func ValidateSpecFile(path string, strictMode bool) (*ValidationReport, error) {
 				})
 			}
 		}
+
+		// Rule 8: Stable requirement IDs must be unique
+		issues = append(issues, validateRequirementIDs(path, lines)...)
+		issues = append(
+			issues,
+			validateIDsAcrossSpecs(path, lines, requirements)...,
+		)
 	}
 
+	// Rule 9: Requirement links must point to existing requirements
+	issues = append(issues, validateSpecLinks(path, contentStr)...)
+
 	// Apply strict mode: convert warnings to errors
 	if strictMode {
 		for i := range issues {
@@ -0,0 +1,149 @@
+package validation
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+const (
+	// maxSuggestions caps how many "did you mean" names are offered
+	maxSuggestions = 3
+	// minSuggestionScore is the similarity below which names are not offered
+	minSuggestionScore = 0.6
+	// minFixScore is the similarity the top suggestion needs to be applied
+	minFixScore = 0.8
+	// minFixMargin is how far the top suggestion must lead the runner-up
+	minFixMargin = 0.1
+	// prefixTokenScore is the score for a word that abbreviates another
+	prefixTokenScore = 0.8
+	// minPrefixTokenLength is the shortest word treated as an abbreviation
+	minPrefixTokenLength = 3
+)
+
+// suggestion is a candidate requirement name with its similarity score
+type suggestion struct {
+	name  string
+	score float64
+}
+
+// suggestRequirementNames ranks candidates by similarity to name and
+// returns up to maxSuggestions of them. fix is the top suggestion when
+// it is close enough and clearly ahead of the rest to apply without
+// asking; otherwise it is empty.
+func suggestRequirementNames(
+	name string,
+	candidates []string,
+) (suggestions []string, fix string) {
+	target := parsers.NormalizeRequirementName(name)
+
+	var ranked []suggestion
+	for _, candidate := range candidates {
+		normalized := parsers.NormalizeRequirementName(candidate)
+		if normalized == target {
+			continue
+		}
+
+		score := max(
+			editSimilarity(target, normalized),
+			tokenSimilarity(target, normalized),
+		)
+		if score >= minSuggestionScore {
+			ranked = append(ranked, suggestion{name: candidate, score: score})
+		}
+	}
+
+	slices.SortStableFunc(ranked, func(a, b suggestion) int {
+		switch {
+		case a.score > b.score:
+			return -1
+		case a.score < b.score:
+			return 1
+		default:
+			return strings.Compare(a.name, b.name)
+		}
+	})
+	if len(ranked) == 0 {
+		return nil, ""
+	}
+
+	for _, s := range ranked[:min(maxSuggestions, len(ranked))] {
+		suggestions = append(suggestions, s.name)
+	}
+
+	top := ranked[0]
+	if top.score >= minFixScore &&
+		(len(ranked) == 1 || top.score-ranked[1].score >= minFixMargin) {
+		fix = top.name
+	}
+
+	return suggestions, fix
+}
+
+// editSimilarity scores two strings from 0 to 1 by edit distance
+func editSimilarity(a, b string) float64 {
+	longest := max(len([]rune(a)), len([]rune(b)))
+	if longest == 0 {
+		return 1
+	}
+
+	return 1 - float64(editDistance(a, b))/float64(longest)
+}
+
+// tokenSimilarity scores two names by matching their words regardless
+// of order, so "login user" is close to "user login" and "user auth"
+// is close to "user authentication"
+func tokenSimilarity(a, b string) float64 {
+	aTokens := strings.Fields(a)
+	bTokens := strings.Fields(b)
+	if len(aTokens) == 0 || len(bTokens) == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for _, at := range aTokens {
+		best := 0.0
+		for _, bt := range bTokens {
+			score := editSimilarity(at, bt)
+			if min(len(at), len(bt)) >= minPrefixTokenLength &&
+				(strings.HasPrefix(at, bt) || strings.HasPrefix(bt, at)) {
+				score = max(score, prefixTokenScore)
+			}
+			best = max(best, score)
+		}
+		total += best
+	}
+
+	return total / float64(max(len(aTokens), len(bTokens)))
+}
+
+// editDistance returns the optimal string alignment distance between two
+// strings: Levenshtein distance where swapping two adjacent characters
+// counts as a single edit, the most common kind of typo
+func editDistance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	prev2 := make([]int, len(br)+1)
+	prev := make([]int, len(br)+1)
+	curr := make([]int, len(br)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ar); i++ {
+		curr[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ar[i-1] == br[j-2] && ar[i-2] == br[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	return prev[len(br)]
+}
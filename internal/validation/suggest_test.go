@@ -0,0 +1,85 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSuggestRequirementNames(t *testing.T) {
+	candidates := []string{"User Login", "User Logout", "Password Reset", "Session Timeout"}
+
+	tests := []struct {
+		name            string
+		input           string
+		wantSuggestions []string
+		wantFix         string
+	}{
+		{
+			name:            "typo",
+			input:           "User Logn",
+			wantSuggestions: []string{"User Login", "User Logout"},
+			wantFix:         "User Login",
+		},
+		{
+			name:            "word order",
+			input:           "Reset Password",
+			wantSuggestions: []string{"Password Reset"},
+			wantFix:         "Password Reset",
+		},
+		{
+			name:            "abbreviation",
+			input:           "Session Time",
+			wantSuggestions: []string{"Session Timeout"},
+			wantFix:         "Session Timeout",
+		},
+		{
+			name:            "ambiguous",
+			input:           "User Log",
+			wantSuggestions: []string{"User Login", "User Logout"},
+			wantFix:         "",
+		},
+		{
+			name:            "unrelated",
+			input:           "Billing",
+			wantSuggestions: nil,
+			wantFix:         "",
+		},
+		{
+			name:            "exact match is not suggested",
+			input:           "user login",
+			wantSuggestions: []string{"User Logout"},
+			wantFix:         "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suggestions, fix := suggestRequirementNames(tt.input, candidates)
+			if !reflect.DeepEqual(suggestions, tt.wantSuggestions) {
+				t.Errorf("suggestions = %q, want %q", suggestions, tt.wantSuggestions)
+			}
+			if fix != tt.wantFix {
+				t.Errorf("fix = %q, want %q", fix, tt.wantFix)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"login", "logn", 1},
+		{"kitten", "sitting", 3},
+		{"works", "wroks", 1},
+	}
+
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package validation
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// validateTasks checks the tasks.md of the change in changeDir. Task
+// IDs must be unique, sections should contain tasks, and requirement
+// links must point to requirements that exist once the change is
+// archived. A missing tasks.md is not reported.
+func validateTasks(
+	changeDir string,
+	specFiles []string,
+	changeSpecsDir, spectrRoot string,
+) []ValidationIssue {
+	tasksPath := filepath.Join(changeDir, "tasks.md")
+	list, err := parsers.ParseTasksFile(tasksPath)
+	if err != nil {
+		return nil
+	}
+
+	var issues []ValidationIssue
+	for _, section := range list.Sections {
+		if section.Line > 0 && len(section.Tasks) == 0 {
+			issues = append(issues, ValidationIssue{
+				Level:   LevelWarning,
+				Path:    tasksPath,
+				Line:    section.Line,
+				Message: fmt.Sprintf("Task section %q has no tasks", section.Heading()),
+			})
+		}
+	}
+
+	seen := make(map[string]int)
+	var links []parsers.RequirementLink
+	list.Walk(func(task *parsers.Task) {
+		links = append(links, task.Requirements...)
+		if task.ID == "" {
+			return
+		}
+		if first, ok := seen[task.ID]; ok {
+			issues = append(issues, ValidationIssue{
+				Level:   LevelError,
+				Path:    tasksPath,
+				Line:    task.Line,
+				Message: fmt.Sprintf("Duplicate task ID %s (first used on line %d)", task.ID, first),
+			})
+
+			return
+		}
+		seen[task.ID] = task.Line
+	})
+
+	if len(links) == 0 {
+		return issues
+	}
+
+	state := applyChangeDeltas(
+		specFiles,
+		changeSpecsDir,
+		filepath.Join(spectrRoot, "specs"),
+	)
+	for _, link := range links {
+		if link.Capability == "" {
+			issues = append(issues, ValidationIssue{
+				Level:   LevelError,
+				Path:    tasksPath,
+				Line:    link.Line,
+				Message: fmt.Sprintf("Task link %s must name a capability", link),
+			})
+
+			continue
+		}
+		if err := state.targets.resolve(link, ""); err != nil {
+			issues = append(issues, ValidationIssue{
+				Level:   LevelError,
+				Path:    tasksPath,
+				Line:    link.Line,
+				Message: fmt.Sprintf("Dangling link %s: %v", link, err),
+			})
+		}
+	}
+
+	return issues
+}
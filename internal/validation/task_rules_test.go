@@ -0,0 +1,65 @@
+package validation
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateChangeDeltaSpecs_Tasks(t *testing.T) {
+	spectrRoot := filepath.Join(t.TempDir(), "spectr")
+	writeLinkFile(t, filepath.Join(spectrRoot, "specs", "auth", "spec.md"),
+		linkSpec("", "User Login"))
+
+	changeDir := filepath.Join(spectrRoot, "changes", "add-mfa")
+	writeLinkFile(t, filepath.Join(changeDir, "specs", "auth", "spec.md"), `## ADDED Requirements
+### Requirement: MFA
+The system SHALL require a second factor.
+
+#### Scenario: Code required
+- **WHEN** a user signs in
+- **THEN** a code is required
+`)
+	writeLinkFile(t, filepath.Join(changeDir, "tasks.md"), `## 1. Implementation
+- [ ] 1.1 Implement [[auth#MFA]] and [[auth#User Login]]
+- [ ] 1.2 Extend [[auth#User Logn]]
+- [ ] 1.1 Write docs for [[#MFA]]
+
+## 2. Rollout
+`)
+
+	report, err := ValidateChangeDeltaSpecs(changeDir, spectrRoot, false)
+	if err != nil {
+		t.Fatalf("ValidateChangeDeltaSpecs returned error: %v", err)
+	}
+
+	var messages []string
+	lines := make(map[string]int)
+	for _, issue := range report.Issues {
+		if strings.HasSuffix(issue.Path, "tasks.md") {
+			messages = append(messages, issue.Message)
+			lines[issue.Message] = issue.Line
+		}
+	}
+
+	expected := map[string]int{
+		"Task section \"2. Rollout\" has no tasks":     6,
+		"Duplicate task ID 1.1 (first used on line 2)": 4,
+		"Task link [[#MFA]] must name a capability":    4,
+	}
+	for message, line := range expected {
+		if got, ok := lines[message]; !ok || got != line {
+			t.Errorf("Expected %q on line %d, got issues %v", message, line, messages)
+		}
+	}
+
+	var dangling []string
+	for _, message := range messages {
+		if strings.HasPrefix(message, "Dangling link") {
+			dangling = append(dangling, message)
+		}
+	}
+	if len(dangling) != 1 || !strings.Contains(dangling[0], `did you mean "User Login"`) {
+		t.Errorf("Expected one dangling link to User Logn, got %v", dangling)
+	}
+}
@@ -18,6 +18,17 @@ type ValidationIssue struct {
 	Path    string          `json:"path"`
 	Line    int             `json:"line,omitempty"`
 	Message string          `json:"message"`
+	// Suggestions lists likely intended names for an unknown reference
+	Suggestions []string `json:"suggestions,omitempty"`
+	// Fix is the replacement `spectr validate --fix` applies, if any
+	Fix *IssueFix `json:"fix,omitempty"`
+}
+
+// IssueFix is an unambiguous correction for an issue: Old is replaced
+// with New on the issue's line
+type IssueFix struct {
+	Old string `json:"old"`
+	New string `json:"new"`
 }
 
 // ValidationSummary provides aggregate counts of validation issues
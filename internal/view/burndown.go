@@ -0,0 +1,211 @@
+package view
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/connerohnesorge/spectr/internal/git"
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+const (
+	// dateLayout is the layout of dates in burndown output
+	dateLayout = "2006-01-02"
+	// sparklineWidth is the maximum number of days shown in a sparkline
+	sparklineWidth = 60
+	// hoursPerDay converts durations to days
+	hoursPerDay = 24
+	// velocityPrecision rounds the velocity to two decimals
+	velocityPrecision = 100
+)
+
+// sparkLevels are the sparkline characters from lowest to highest
+var sparkLevels = []rune("▁▂▃▄▅▆▇█")
+
+// ProgressPoint is the task progress of a change at one point in time
+type ProgressPoint struct {
+	Date      string `json:"date"`
+	Total     int    `json:"total"`
+	Completed int    `json:"completed"`
+	Remaining int    `json:"remaining"`
+	// time is the exact time of the point, used to place it in the series
+	time time.Time
+}
+
+// Burndown is the task completion history of a change
+type Burndown struct {
+	// Series holds the progress at the end of each day, from the first
+	// commit of tasks.md until today
+	Series []ProgressPoint `json:"series"`
+	// Velocity is the average number of tasks completed per day
+	Velocity float64 `json:"velocity"`
+	// EstimatedCompletion is the date all tasks are expected to be done
+	// at the current velocity, if it can be estimated
+	EstimatedCompletion string `json:"estimatedCompletion,omitempty"`
+}
+
+// CollectBurndown derives the task progress history of the change in
+// changeDir from the git history of its tasks.md. Uncommitted edits
+// count as progress made today. Without git history the burndown holds
+// only the current state.
+func CollectBurndown(changeDir string, now time.Time) (*Burndown, error) {
+	tasksPath := filepath.Join(changeDir, "tasks.md")
+
+	// Outside a git repository there is no history to read
+	revisions, err := git.FileHistory(tasksPath)
+	if err != nil {
+		revisions = nil
+	}
+
+	var points []ProgressPoint
+	for _, revision := range revisions {
+		content, err := git.ShowFile(revision.Commit, tasksPath)
+		if err != nil {
+			// The file was deleted or renamed in this commit
+			continue
+		}
+		points = append(points, newProgressPoint(
+			parsers.ParseTaskList(content).Status(),
+			revision.Time.In(now.Location()),
+		))
+	}
+
+	list, err := parsers.ParseTasksFile(tasksPath)
+	switch {
+	case err == nil:
+		points = append(points, newProgressPoint(list.Status(), now))
+	case !os.IsNotExist(err):
+		return nil, fmt.Errorf("read tasks: %w", err)
+	}
+
+	return NewBurndown(points, now), nil
+}
+
+// newProgressPoint returns the progress point of status at t
+func newProgressPoint(status parsers.TaskStatus, t time.Time) ProgressPoint {
+	return ProgressPoint{
+		Date:      t.Format(dateLayout),
+		Total:     status.Total,
+		Completed: status.Completed,
+		Remaining: status.Total - status.Completed,
+		time:      t,
+	}
+}
+
+// NewBurndown builds a daily burndown from progress points sorted by
+// time. The velocity is the net number of tasks completed per day
+// between the first and the last point; the completion estimate
+// extends it over the remaining tasks.
+func NewBurndown(points []ProgressPoint, now time.Time) *Burndown {
+	burndown := &Burndown{Series: make([]ProgressPoint, 0)}
+	if len(points) == 0 {
+		return burndown
+	}
+
+	burndown.Series = dailySeries(points, now)
+
+	first, last := points[0], points[len(points)-1]
+	days := last.time.Sub(first.time).Hours() / hoursPerDay
+	done := last.Completed - first.Completed
+	if days <= 0 || done <= 0 {
+		return burndown
+	}
+
+	velocity := float64(done) / days
+	burndown.Velocity = math.Round(velocity*velocityPrecision) / velocityPrecision
+	if last.Remaining > 0 {
+		remainingDays := float64(last.Remaining) / velocity
+		estimate := last.time.Add(time.Duration(remainingDays * hoursPerDay * float64(time.Hour)))
+		burndown.EstimatedCompletion = estimate.Format(dateLayout)
+	}
+
+	return burndown
+}
+
+// dailySeries returns one point per day from the first point until now,
+// each holding the progress of the last point on or before that day
+func dailySeries(points []ProgressPoint, now time.Time) []ProgressPoint {
+	start := startOfDay(points[0].time)
+	end := startOfDay(now)
+	if last := startOfDay(points[len(points)-1].time); last.After(end) {
+		end = last
+	}
+
+	var series []ProgressPoint
+	next := 0
+	current := points[0]
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		for next < len(points) && startOfDay(points[next].time).Compare(day) <= 0 {
+			current = points[next]
+			next++
+		}
+		point := current
+		point.Date = day.Format(dateLayout)
+		series = append(series, point)
+	}
+
+	return series
+}
+
+// startOfDay truncates t to midnight in its location
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// RenderSparkline renders the remaining tasks of the series as a
+// sparkline, one character per day
+func RenderSparkline(series []ProgressPoint) string {
+	highest := 0
+	for _, point := range series {
+		highest = max(highest, point.Remaining)
+	}
+
+	var sb strings.Builder
+	for _, point := range series {
+		level := 0
+		if highest > 0 {
+			level = point.Remaining * (len(sparkLevels) - 1) / highest
+		}
+		sb.WriteRune(sparkLevels[level])
+	}
+
+	return sb.String()
+}
+
+// FormatBurndownText formats the burndown as human-readable terminal
+// output with a sparkline of the remaining tasks over at most the last
+// sparklineWidth days
+func FormatBurndownText(burndown *Burndown) string {
+	lines := []string{headerStyle.Render("Burndown"), singleLineSeparator}
+	if len(burndown.Series) == 0 {
+		return strings.Join(append(lines, "No task history"), newline)
+	}
+
+	series := burndown.Series
+	if len(series) > sparklineWidth {
+		series = series[len(series)-sparklineWidth:]
+	}
+	first, last := series[0], series[len(series)-1]
+	lines = append(lines,
+		fmt.Sprintf("%s %s %s", first.Date, RenderSparkline(series), last.Date),
+		fmt.Sprintf("Remaining: %d of %d tasks", last.Remaining, last.Total),
+		fmt.Sprintf("Velocity: %.1f tasks/day", burndown.Velocity),
+	)
+
+	switch {
+	case last.Total > 0 && last.Remaining == 0:
+		lines = append(lines, "Estimated completion: done")
+	case burndown.EstimatedCompletion != "":
+		lines = append(lines, "Estimated completion: "+burndown.EstimatedCompletion)
+	default:
+		lines = append(lines, "Estimated completion: unknown (no net progress yet)")
+	}
+
+	return strings.Join(lines, newline)
+}
@@ -0,0 +1,85 @@
+package view
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+// point returns a progress point on the given day of January 2025
+func point(day, total, completed int) ProgressPoint {
+	return newProgressPoint(
+		parsers.TaskStatus{Total: total, Completed: completed},
+		time.Date(2025, time.January, day, 12, 0, 0, 0, time.UTC),
+	)
+}
+
+func TestNewBurndown(t *testing.T) {
+	now := time.Date(2025, time.January, 5, 12, 0, 0, 0, time.UTC)
+	burndown := NewBurndown([]ProgressPoint{
+		point(1, 8, 0),
+		point(3, 8, 2),
+		point(3, 8, 3),
+		point(5, 8, 4),
+	}, now)
+
+	remaining := make([]int, len(burndown.Series))
+	for i, p := range burndown.Series {
+		remaining[i] = p.Remaining
+	}
+	if got := remaining; len(got) != 5 || got[0] != 8 || got[1] != 8 || got[2] != 5 || got[3] != 5 || got[4] != 4 {
+		t.Errorf("Unexpected series: %+v", burndown.Series)
+	}
+	if burndown.Series[1].Date != "2025-01-02" {
+		t.Errorf("Unexpected date: %s", burndown.Series[1].Date)
+	}
+
+	// 4 tasks in 4 days leaves 4 tasks for another 4 days
+	if burndown.Velocity != 1 {
+		t.Errorf("Velocity = %v, want 1", burndown.Velocity)
+	}
+	if burndown.EstimatedCompletion != "2025-01-09" {
+		t.Errorf("EstimatedCompletion = %q", burndown.EstimatedCompletion)
+	}
+}
+
+func TestNewBurndown_NoProgress(t *testing.T) {
+	now := time.Date(2025, time.January, 3, 0, 0, 0, 0, time.UTC)
+
+	burndown := NewBurndown([]ProgressPoint{point(1, 4, 1), point(2, 6, 1)}, now)
+	if burndown.Velocity != 0 || burndown.EstimatedCompletion != "" {
+		t.Errorf("Expected no estimate, got %+v", burndown)
+	}
+	if len(burndown.Series) != 3 {
+		t.Errorf("Expected the series to extend until now, got %+v", burndown.Series)
+	}
+
+	if empty := NewBurndown(nil, now); len(empty.Series) != 0 {
+		t.Errorf("Expected an empty series, got %+v", empty.Series)
+	}
+}
+
+func TestRenderSparkline(t *testing.T) {
+	series := []ProgressPoint{point(1, 8, 0), point(2, 8, 4), point(3, 8, 8)}
+	if got := RenderSparkline(series); got != "█▄▁" {
+		t.Errorf("RenderSparkline = %q", got)
+	}
+}
+
+func TestFormatBurndownText(t *testing.T) {
+	now := time.Date(2025, time.January, 5, 12, 0, 0, 0, time.UTC)
+
+	text := FormatBurndownText(NewBurndown([]ProgressPoint{point(1, 8, 0), point(5, 8, 4)}, now))
+	for _, expected := range []string{"2025-01-01", "2025-01-05", "Remaining: 4 of 8 tasks", "Velocity: 1.0", "2025-01-09"} {
+		if !strings.Contains(text, expected) {
+			t.Errorf("Expected %q in:\n%s", expected, text)
+		}
+	}
+
+	done := FormatBurndownText(NewBurndown([]ProgressPoint{point(1, 2, 0), point(5, 2, 2)}, now))
+	if !strings.Contains(done, "Estimated completion: done") {
+		t.Errorf("Expected a finished change:\n%s", done)
+	}
+}
@@ -0,0 +1,168 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/lifecycle"
+	"github.com/connerohnesorge/spectr/internal/parsers"
+)
+
+const (
+	// Task indicators in change details
+	doneTaskMark = "✓"
+	openTaskMark = "○"
+	// Indentation per level of nested tasks
+	taskIndent = "   "
+)
+
+// ChangeDetail is the detailed view of a single active change
+type ChangeDetail struct {
+	ID           string          `json:"id"`
+	Title        string          `json:"title"`
+	State        string          `json:"state"`
+	Capabilities []string        `json:"capabilities"`
+	Progress     ProgressMetrics `json:"progress"`
+	// Tasks is the parsed tasks.md, empty when the file is missing
+	Tasks *parsers.TaskList `json:"tasks"`
+	// Burndown is the task progress history, if it was collected
+	Burndown *Burndown `json:"burndown,omitempty"`
+	// Proposal frontmatter (owner, status, labels, ...)
+	parsers.ProposalMetadata
+}
+
+// CollectChange gathers the details of the active change changeID
+func CollectChange(projectPath, changeID string) (*ChangeDetail, error) {
+	changeDir := filepath.Join(projectPath, "spectr", "changes", changeID)
+	if info, err := os.Stat(changeDir); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("change not found: %s", changeID)
+	}
+
+	proposalPath := filepath.Join(changeDir, "proposal.md")
+	title, err := parsers.ExtractTitle(proposalPath)
+	if err != nil || title == "" {
+		title = changeID
+	}
+
+	// Invalid frontmatter is reported by validate, not here
+	metadata, err := parsers.ParseProposalMetadata(proposalPath)
+	if err != nil {
+		metadata = parsers.ProposalMetadata{}
+	}
+
+	tasks, err := parsers.ParseTasksFile(filepath.Join(changeDir, "tasks.md"))
+	if err != nil {
+		tasks = &parsers.TaskList{Sections: make([]*parsers.TaskSection, 0)}
+	}
+	status := tasks.Status()
+
+	return &ChangeDetail{
+		ID:           changeID,
+		Title:        title,
+		State:        string(lifecycle.Effective(metadata.Status)),
+		Capabilities: changeCapabilities(filepath.Join(changeDir, "specs")),
+		Progress: ProgressMetrics{
+			Total:      status.Total,
+			Completed:  status.Completed,
+			Percentage: calculatePercentage(status.Completed, status.Total),
+		},
+		Tasks:            tasks,
+		ProposalMetadata: metadata,
+	}, nil
+}
+
+// changeCapabilities returns the sorted capabilities with delta specs
+// below specsDir
+func changeCapabilities(specsDir string) []string {
+	capabilities := make([]string, 0)
+	_ = filepath.WalkDir(specsDir, func(path string, d os.DirEntry, err error) error {
+		if err == nil && !d.IsDir() && d.Name() == "spec.md" {
+			capabilities = append(
+				capabilities,
+				discovery.CapabilityID(specsDir, filepath.Dir(path)),
+			)
+		}
+
+		return nil
+	})
+	sort.Strings(capabilities)
+
+	return capabilities
+}
+
+// FormatChangeText formats the change details as human-readable
+// terminal output, listing tasks by tasks.md section
+func FormatChangeText(detail *ChangeDetail) string {
+	lines := []string{
+		headerStyle.Render(detail.ID) + ": " + detail.Title,
+		singleLineSeparator,
+		"State: " + detail.State,
+	}
+	if detail.Owner != "" {
+		lines = append(lines, "Owner: "+detail.Owner)
+	}
+	if len(detail.Labels) > 0 {
+		lines = append(lines, "Labels: "+strings.Join(detail.Labels, ", "))
+	}
+	if len(detail.Capabilities) > 0 {
+		lines = append(lines, "Capabilities: "+strings.Join(detail.Capabilities, ", "))
+	}
+	lines = append(lines, "Tasks: "+RenderBar(
+		detail.Progress.Completed,
+		detail.Progress.Total,
+	))
+
+	for _, section := range detail.Tasks.Sections {
+		status := section.Status()
+		heading := section.Heading()
+		if heading == "" {
+			heading = "Tasks"
+		}
+		lines = append(lines, "", fmt.Sprintf("%s (%d/%d)",
+			headerStyle.Render(heading),
+			status.Completed,
+			status.Total,
+		))
+		lines = appendTaskLines(lines, section.Tasks, 1)
+	}
+
+	if detail.Burndown != nil {
+		lines = append(lines, "", FormatBurndownText(detail.Burndown))
+	}
+
+	return strings.Join(lines, newline)
+}
+
+// appendTaskLines appends one line per task, indenting nested tasks
+func appendTaskLines(lines []string, tasks []*parsers.Task, depth int) []string {
+	for _, task := range tasks {
+		mark := activeChangeStyle.Render(openTaskMark)
+		if task.Done {
+			mark = completedChangeStyle.Render(doneTaskMark)
+		}
+
+		text := task.Text
+		if task.ID != "" {
+			text = task.ID + " " + text
+		}
+		lines = append(lines, strings.Repeat(taskIndent, depth)+mark+" "+text)
+		lines = appendTaskLines(lines, task.Children, depth+1)
+	}
+
+	return lines
+}
+
+// FormatChangeJSON formats the change details as indented JSON
+func FormatChangeJSON(detail *ChangeDetail) (string, error) {
+	data, err := json.MarshalIndent(detail, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal change to JSON: %w", err)
+	}
+
+	return string(data), nil
+}
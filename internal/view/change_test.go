@@ -0,0 +1,73 @@
+package view
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCollectChange(t *testing.T) {
+	tempDir := t.TempDir()
+	changeDir := filepath.Join(tempDir, "spectr", "changes", "add-mfa")
+	for _, dir := range []string{"specs/auth", "specs/api/keys"} {
+		if err := os.MkdirAll(filepath.Join(changeDir, filepath.FromSlash(dir)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(changeDir, filepath.FromSlash(dir), "spec.md"), []byte("## ADDED Requirements\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	proposal := "---\nowner: alice\nstatus: in-progress\nlabels: [auth]\n---\n# Change: Add MFA\n"
+	if err := os.WriteFile(filepath.Join(changeDir, "proposal.md"), []byte(proposal), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tasks := "## 1. Setup\n- [x] 1.1 Add config @bob\n  - [ ] 1.1.1 Document it\n\n## 2. Rollout\n- [ ] 2.1 Enable\n"
+	if err := os.WriteFile(filepath.Join(changeDir, "tasks.md"), []byte(tasks), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	detail, err := CollectChange(tempDir, "add-mfa")
+	if err != nil {
+		t.Fatalf("CollectChange failed: %v", err)
+	}
+	if detail.Title != "Add MFA" || detail.Owner != "alice" || detail.State != "in-progress" {
+		t.Errorf("Unexpected detail: %+v", detail)
+	}
+	if !reflect.DeepEqual(detail.Capabilities, []string{"api/keys", "auth"}) {
+		t.Errorf("Capabilities = %v", detail.Capabilities)
+	}
+	if detail.Progress != (ProgressMetrics{Total: 3, Completed: 1, Percentage: 33}) {
+		t.Errorf("Progress = %+v", detail.Progress)
+	}
+
+	text := FormatChangeText(detail)
+	for _, expected := range []string{
+		"Add MFA", "State: in-progress", "Owner: alice", "Capabilities: api/keys, auth",
+		"1. Setup", "(1/2)", "1.1 Add config @bob", "      ○ 1.1.1 Document it", "2. Rollout", "(0/1)",
+	} {
+		if !strings.Contains(text, expected) {
+			t.Errorf("Expected text to contain %q:\n%s", expected, text)
+		}
+	}
+
+	output, err := FormatChangeJSON(detail)
+	if err != nil {
+		t.Fatalf("FormatChangeJSON failed: %v", err)
+	}
+	var parsed ChangeDetail
+	if err := json.Unmarshal([]byte(output), &parsed); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if len(parsed.Tasks.Sections) != 2 || parsed.Tasks.Sections[0].Tasks[0].Assignees[0] != "bob" {
+		t.Errorf("Unexpected tasks in JSON: %s", output)
+	}
+}
+
+func TestCollectChange_NotFound(t *testing.T) {
+	if _, err := CollectChange(t.TempDir(), "missing"); err == nil {
+		t.Error("Expected an error for a missing change")
+	}
+}
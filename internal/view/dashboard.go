@@ -7,6 +7,7 @@ import (
 	"sort"
 
 	"github.com/connerohnesorge/spectr/internal/discovery"
+	"github.com/connerohnesorge/spectr/internal/lifecycle"
 	"github.com/connerohnesorge/spectr/internal/parsers"
 )
 
@@ -18,18 +19,19 @@ import (
 //  1. Discovers all changes in spectr/changes/ directory
 //  2. Parses each change's proposal.md for title
 //  3. Parses each change's tasks.md for task completion status
-//  4. Categorizes changes as active (incomplete) or completed
+//  4. Determines each change's lifecycle state; changes ready to
+//     archive are completed, all others active
 //  5. Discovers all specs in spectr/specs/ directory
 //  6. Parses each spec's spec.md for title and requirement count
-//  7. Sorts results per design specification (active changes by
-//     completion ascending, specs by requirement count descending)
+//  7. Sorts results per design specification (active changes by state,
+//     then completion ascending, specs by requirement count descending)
 //
 // Returns DashboardData structure or error if discovery fails.
 //
 //nolint:revive // cognitive-complexity justified for data collection
 func CollectData(projectPath string) (*DashboardData, error) {
 	data := &DashboardData{
-		Summary:          SummaryMetrics{},
+		Summary:          SummaryMetrics{ChangesByState: map[string]int{}},
 		ActiveChanges:    []ChangeProgress{},
 		CompletedChanges: []CompletedChange{},
 		Specs:            []SpecInfo{},
@@ -53,27 +55,36 @@ func CollectData(projectPath string) (*DashboardData, error) {
 			title = changeID
 		}
 
-		// Parse task counts from tasks.md
+		// Parse frontmatter metadata, ignoring invalid frontmatter
+		metadata, err := parsers.ParseProposalMetadata(proposalPath)
+		if err != nil {
+			metadata = parsers.ProposalMetadata{}
+		}
+
+		// Parse tasks from tasks.md
 		tasksPath := filepath.Join(changeDir, "tasks.md")
-		taskStatus, err := parsers.CountTasks(tasksPath)
+		tasks, err := parsers.ParseTasksFile(tasksPath)
 		if err != nil {
 			// If tasks.md can't be read, assume zero tasks
-			taskStatus = parsers.TaskStatus{Total: 0, Completed: 0}
+			tasks = &parsers.TaskList{}
 		}
+		taskStatus := tasks.Status()
 
 		// Calculate completion percentage
 		percentage := calculatePercentage(taskStatus.Completed, taskStatus.Total)
 
-		// Categorize as active or completed
-		// A change is completed if: (completed == total AND total > 0) OR total == 0
-		isCompleted := (taskStatus.Completed == taskStatus.Total && taskStatus.Total > 0) ||
-			taskStatus.Total == 0
+		// Categorize by lifecycle state; only changes ready to archive
+		// count as completed
+		state := lifecycle.Effective(metadata.Status)
+		data.Summary.ChangesByState[string(state)]++
 
-		if isCompleted {
+		if state == lifecycle.ReadyToArchive {
 			// Add to completed changes
 			data.CompletedChanges = append(data.CompletedChanges, CompletedChange{
-				ID:    changeID,
-				Title: title,
+				ID:               changeID,
+				Title:            title,
+				State:            string(state),
+				ProposalMetadata: metadata,
 			})
 			data.Summary.CompletedChanges++
 		} else {
@@ -86,6 +97,9 @@ func CollectData(projectPath string) (*DashboardData, error) {
 					Completed:  taskStatus.Completed,
 					Percentage: percentage,
 				},
+				State:            string(state),
+				Sections:         sectionProgress(tasks),
+				ProposalMetadata: metadata,
 			})
 			data.Summary.ActiveChanges++
 			data.Summary.TotalTasks += taskStatus.Total
@@ -129,8 +143,14 @@ func CollectData(projectPath string) (*DashboardData, error) {
 		data.Summary.TotalRequirements += reqCount
 	}
 
-	// Sort active changes by completion percentage (ascending), then by ID (alphabetical)
+	// Sort active changes by lifecycle state, then by completion
+	// percentage (ascending), then by ID (alphabetical)
 	sort.Slice(data.ActiveChanges, func(i, j int) bool {
+		stateI := lifecycle.State(data.ActiveChanges[i].State)
+		stateJ := lifecycle.State(data.ActiveChanges[j].State)
+		if stateI != stateJ {
+			return stateI.Before(stateJ)
+		}
 		// Sort by percentage first (ascending - lower completion first)
 		if data.ActiveChanges[i].Progress.Percentage != data.ActiveChanges[j].Progress.Percentage {
 			return data.ActiveChanges[i].Progress.Percentage < data.ActiveChanges[j].Progress.Percentage
@@ -172,3 +192,31 @@ func calculatePercentage(completed, total int) int {
 	// Round to nearest integer
 	return int(float64(completed) / float64(total) * 100.0)
 }
+
+// sectionProgress returns the progress of each tasks.md section that
+// contains tasks. Tasks before the first section are reported under
+// "Tasks".
+func sectionProgress(tasks *parsers.TaskList) []SectionProgress {
+	var sections []SectionProgress
+	for _, section := range tasks.Sections {
+		status := section.Status()
+		if status.Total == 0 {
+			continue
+		}
+
+		heading := section.Heading()
+		if heading == "" {
+			heading = "Tasks"
+		}
+		sections = append(sections, SectionProgress{
+			Heading: heading,
+			Progress: ProgressMetrics{
+				Total:      status.Total,
+				Completed:  status.Completed,
+				Percentage: calculatePercentage(status.Completed, status.Total),
+			},
+		})
+	}
+
+	return sections
+}
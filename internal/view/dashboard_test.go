@@ -281,7 +281,7 @@ func TestCollectData_OnlyCompletedChanges(t *testing.T) {
 	}
 
 	// Write a proposal.md
-	proposalContent := "# Test Completed\n\n## Why\nTest\n\n## What Changes\n- Test\n"
+	proposalContent := "---\nstatus: ready-to-archive\n---\n# Test Completed\n\n## Why\nTest\n\n## What Changes\n- Test\n"
 	if err := os.WriteFile(filepath.Join(changeDir, "proposal.md"), []byte(proposalContent), 0644); err != nil {
 		t.Fatalf("Failed to write proposal.md: %v", err)
 	}
@@ -372,12 +372,12 @@ func TestCollectData_ChangeWithZeroTasks(t *testing.T) {
 	}
 
 	// Verify metrics
-	// Changes with zero tasks are categorized as "completed" (see dashboard.go line 57-59)
-	if data.Summary.ActiveChanges != 0 {
-		t.Errorf("Expected ActiveChanges=0, got %d", data.Summary.ActiveChanges)
+	// Changes with zero tasks have nothing done yet and stay proposed
+	if data.Summary.ActiveChanges != 1 {
+		t.Errorf("Expected ActiveChanges=1, got %d", data.Summary.ActiveChanges)
 	}
-	if data.Summary.CompletedChanges != 1 {
-		t.Errorf("Expected CompletedChanges=1, got %d", data.Summary.CompletedChanges)
+	if data.Summary.CompletedChanges != 0 {
+		t.Errorf("Expected CompletedChanges=0, got %d", data.Summary.CompletedChanges)
 	}
 	if data.Summary.TotalTasks != 0 {
 		t.Errorf("Expected TotalTasks=0, got %d", data.Summary.TotalTasks)
@@ -385,18 +385,65 @@ func TestCollectData_ChangeWithZeroTasks(t *testing.T) {
 	if data.Summary.CompletedTasks != 0 {
 		t.Errorf("Expected CompletedTasks=0, got %d", data.Summary.CompletedTasks)
 	}
+	if data.Summary.ChangesByState["proposed"] != 1 {
+		t.Errorf("Expected 1 proposed change, got %v", data.Summary.ChangesByState)
+	}
 
-	// Verify the change is in completed list
-	if len(data.ActiveChanges) != 0 {
-		t.Errorf("Expected 0 active changes, got %d", len(data.ActiveChanges))
+	// Verify the change is in the active list
+	if len(data.CompletedChanges) != 0 {
+		t.Errorf("Expected 0 completed changes, got %d", len(data.CompletedChanges))
 	}
-	if len(data.CompletedChanges) != 1 {
-		t.Fatalf("Expected 1 completed change, got %d", len(data.CompletedChanges))
+	if len(data.ActiveChanges) != 1 {
+		t.Fatalf("Expected 1 active change, got %d", len(data.ActiveChanges))
 	}
-	if data.CompletedChanges[0].ID != "test-no-tasks" {
-		t.Errorf("Expected change ID='test-no-tasks', got '%s'",
-			data.CompletedChanges[0].ID)
+	if data.ActiveChanges[0].ID != "test-no-tasks" || data.ActiveChanges[0].State != "proposed" {
+		t.Errorf("Expected proposed change 'test-no-tasks', got %+v",
+			data.ActiveChanges[0])
+	}
+}
+
+// TestCollectData_TaskSections tests the per-section task progress of
+// active changes
+func TestCollectData_TaskSections(t *testing.T) {
+	tempDir := t.TempDir()
+	changeDir := filepath.Join(tempDir, "spectr", "changes", "test-change")
+	if err := os.MkdirAll(changeDir, 0755); err != nil {
+		t.Fatalf("Failed to create change directory: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(tempDir, "spectr", "specs"), 0755); err != nil {
+		t.Fatalf("Failed to create specs directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(changeDir, "proposal.md"), []byte("# Test Change\n"), 0644); err != nil {
+		t.Fatalf("Failed to write proposal.md: %v", err)
+	}
+	tasksContent := "- [x] Preamble\n\n## 1. Setup\n- [x] 1.1 One\n  - [ ] 1.1.1 Nested\n\n## 2. Empty\n\n## 3. Docs\n- [ ] 3.1 Two\n"
+	if err := os.WriteFile(filepath.Join(changeDir, "tasks.md"), []byte(tasksContent), 0644); err != nil {
+		t.Fatalf("Failed to write tasks.md: %v", err)
+	}
+
+	data, err := CollectData(tempDir)
+	if err != nil {
+		t.Fatalf("CollectData failed: %v", err)
+	}
+	if len(data.ActiveChanges) != 1 {
+		t.Fatalf("Expected 1 active change, got %d", len(data.ActiveChanges))
 	}
 
-	t.Log("Change with zero tasks correctly categorized as completed")
+	sections := data.ActiveChanges[0].Sections
+	expected := []SectionProgress{
+		{Heading: "Tasks", Progress: ProgressMetrics{Total: 1, Completed: 1, Percentage: 100}},
+		{Heading: "1. Setup", Progress: ProgressMetrics{Total: 2, Completed: 1, Percentage: 50}},
+		{Heading: "3. Docs", Progress: ProgressMetrics{Total: 1, Completed: 0, Percentage: 0}},
+	}
+	if len(sections) != len(expected) {
+		t.Fatalf("Expected %d sections, got %+v", len(expected), sections)
+	}
+	for i := range expected {
+		if sections[i] != expected[i] {
+			t.Errorf("Section %d = %+v, want %+v", i, sections[i], expected[i])
+		}
+	}
+	if data.Summary.TotalTasks != 4 || data.Summary.CompletedTasks != 2 {
+		t.Errorf("Expected 2/4 tasks, got %d/%d", data.Summary.CompletedTasks, data.Summary.TotalTasks)
+	}
 }
@@ -8,6 +8,8 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/connerohnesorge/spectr/internal/lifecycle"
 )
 
 const (
@@ -21,10 +23,9 @@ const (
 		"─────────────────────────"
 
 	// Section headers
-	summaryHeader          = "Summary:"
-	activeChangesHeader    = "Active Changes"
-	completedChangesHeader = "Completed Changes"
-	specsHeader            = "Specifications"
+	summaryHeader       = "Summary:"
+	activeChangesHeader = "Active Changes"
+	specsHeader         = "Specifications"
 
 	// Footer hint
 	footerHint = "Use spectr list --changes or " +
@@ -38,6 +39,8 @@ const (
 	indentation        = "  "
 	// Fixed width for change IDs in active changes section
 	changeIDWidth = 28
+	// Fixed width for task section headings below an active change
+	sectionHeadingWidth = 36
 	// Fixed width for spec IDs in specs section
 	specIDWidth = 28
 
@@ -83,8 +86,8 @@ var (
 // The output follows the format specification in design.md:
 // - Dashboard title with double-line separator
 // - Summary section with bullet points and metrics
-// - Active changes with progress bars
-// - Completed changes with checkmarks
+// - Active changes with progress bars, one section per lifecycle state
+// - Changes ready to archive with checkmarks
 // - Specifications with requirement counts
 // - Footer with navigation hints
 //
@@ -102,14 +105,33 @@ func FormatDashboardText(data *DashboardData) string {
 	sections = append(sections, formatSummarySection(data.Summary))
 	sections = append(sections, "")
 
-	// Section 2: Active Changes (only if there are active changes)
-	if len(data.ActiveChanges) > 0 {
+	// Section 2: Active changes grouped by state (only non-empty states);
+	// changes without a known state are listed last
+	byState := make(map[string][]ChangeProgress)
+	for _, change := range data.ActiveChanges {
+		byState[change.State] = append(byState[change.State], change)
+	}
+	for _, state := range lifecycle.States {
+		if changes := byState[string(state)]; len(changes) > 0 {
+			sections = append(sections,
+				formatActiveChangesSection(state.Label(), changes))
+			sections = append(sections, "")
+			delete(byState, string(state))
+		}
+	}
+	var other []ChangeProgress
+	for _, change := range data.ActiveChanges {
+		if _, ok := byState[change.State]; ok {
+			other = append(other, change)
+		}
+	}
+	if len(other) > 0 {
 		sections = append(sections,
-			formatActiveChangesSection(data.ActiveChanges))
+			formatActiveChangesSection(activeChangesHeader, other))
 		sections = append(sections, "")
 	}
 
-	// Section 3: Completed Changes (only if there are completed changes)
+	// Section 3: Ready to archive (only if there are such changes)
 	if len(data.CompletedChanges) > 0 {
 		sections = append(sections,
 			formatCompletedChangesSection(data.CompletedChanges))
@@ -145,18 +167,30 @@ func formatSummarySection(summary SummaryMetrics) string {
 	)
 	lines = append(lines, specsLine)
 
-	// Active Changes: X in progress
-	activeLine := fmt.Sprintf("%s %s Active Changes: %d in progress",
+	// Active Changes: X (Y proposed, Z in progress)
+	activeLine := fmt.Sprintf("%s %s Active Changes: %d",
 		indentation,
 		summaryBulletStyle.Render(summaryBullet),
 		summary.ActiveChanges,
 	)
+	var byState []string
+	for _, state := range lifecycle.States {
+		count := summary.ChangesByState[string(state)]
+		if state != lifecycle.ReadyToArchive && count > 0 {
+			byState = append(byState,
+				fmt.Sprintf("%d %s", count, strings.ToLower(state.Label())))
+		}
+	}
+	if len(byState) > 0 {
+		activeLine += " (" + strings.Join(byState, ", ") + ")"
+	}
 	lines = append(lines, activeLine)
 
-	// Completed Changes: X
-	completedLine := fmt.Sprintf("%s %s Completed Changes: %d",
+	// Ready to Archive: X
+	completedLine := fmt.Sprintf("%s %s %s: %d",
 		indentation,
 		summaryBulletStyle.Render(summaryBullet),
+		lifecycle.ReadyToArchive.Label(),
 		summary.CompletedChanges,
 	)
 	lines = append(lines, completedLine)
@@ -179,13 +213,13 @@ func formatSummarySection(summary SummaryMetrics) string {
 	return strings.Join(lines, newline)
 }
 
-// formatActiveChangesSection creates the active changes section
-// with progress bars
-func formatActiveChangesSection(changes []ChangeProgress) string {
+// formatActiveChangesSection creates a section of active changes
+// with progress bars under the given header
+func formatActiveChangesSection(header string, changes []ChangeProgress) string {
 	var lines []string
 
 	// Section header
-	lines = append(lines, headerStyle.Render(activeChangesHeader))
+	lines = append(lines, headerStyle.Render(header))
 	lines = append(lines, singleLineSeparator)
 
 	// Each active change: ◉ id [progress bar] percentage%
@@ -203,18 +237,31 @@ func formatActiveChangesSection(changes []ChangeProgress) string {
 			progressBar,
 		)
 		lines = append(lines, line)
+
+		// Break down progress by tasks.md section when there are several
+		if len(change.Sections) > 1 {
+			for _, section := range change.Sections {
+				lines = append(lines, fmt.Sprintf("%s     %-*s %d/%d",
+					indentation,
+					sectionHeadingWidth,
+					section.Heading,
+					section.Progress.Completed,
+					section.Progress.Total,
+				))
+			}
+		}
 	}
 
 	return strings.Join(lines, newline)
 }
 
-// formatCompletedChangesSection creates the completed changes
-// section with checkmarks
+// formatCompletedChangesSection creates the section of changes ready
+// to archive with checkmarks
 func formatCompletedChangesSection(changes []CompletedChange) string {
 	var lines []string
 
 	// Section header
-	lines = append(lines, headerStyle.Render(completedChangesHeader))
+	lines = append(lines, headerStyle.Render(lifecycle.ReadyToArchive.Label()))
 	lines = append(lines, singleLineSeparator)
 
 	// Each completed change: ✓ id
@@ -259,9 +306,9 @@ func formatSpecsSection(specs []SpecInfo) string {
 // The output structure matches the schema defined in design.md:
 //   - summary: Aggregate metrics
 //     (totalSpecs, totalRequirements, activeChanges, etc.)
-//   - activeChanges: Array of changes in progress with
-//     task completion metrics
-//   - completedChanges: Array of completed changes
+//   - activeChanges: Array of changes not yet ready to archive with
+//     their lifecycle state and task completion metrics
+//   - completedChanges: Array of changes ready to archive
 //   - specs: Array of specifications with requirement counts
 //
 // Arrays are pre-sorted by the CollectData() function,
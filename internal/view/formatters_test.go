@@ -65,14 +65,14 @@ func TestFormatDashboardText_FullDashboard(t *testing.T) {
 		"════════════════════════════════════════════════════════════",
 		"Summary:",
 		"5 specs, 42 requirements",
-		"Active Changes: 2 in progress",
-		"Completed Changes: 1",
+		"Active Changes: 2",
+		"Ready to Archive: 1",
 		"Task Progress: 8/15 (53% complete)",
 		"Active Changes",
 		"────────────────────────────────────────────────────────────",
 		"add-view-command",
 		"add-validate-command",
-		"Completed Changes",
+		"Ready to Archive",
 		"add-list-command",
 		"Specifications",
 		"cli-framework",
@@ -123,7 +123,7 @@ func TestFormatDashboardText_EmptyDashboard(t *testing.T) {
 	if strings.Contains(output, "Active Changes\n────") {
 		t.Error("Should not show Active Changes section when empty")
 	}
-	if strings.Contains(output, "Completed Changes\n────") {
+	if strings.Contains(output, "Ready to Archive\n────") {
 		t.Error("Should not show Completed Changes section when empty")
 	}
 	if strings.Contains(output, "Specifications\n────") {
@@ -173,7 +173,7 @@ func TestFormatDashboardText_OnlyActiveChanges(t *testing.T) {
 	}
 
 	// Should NOT have completed changes or specs sections
-	if strings.Contains(output, "Completed Changes\n────") {
+	if strings.Contains(output, "Ready to Archive\n────") {
 		t.Error("Should not show Completed Changes section when empty")
 	}
 	if strings.Contains(output, "Specifications\n────") {
@@ -203,7 +203,7 @@ func TestFormatDashboardText_OnlyCompletedChanges(t *testing.T) {
 	output := FormatDashboardText(data)
 
 	// Should have completed changes section
-	if !strings.Contains(output, "Completed Changes") {
+	if !strings.Contains(output, "Ready to Archive") {
 		t.Error("Expected Completed Changes section")
 	}
 	if !strings.Contains(output, "change-one") {
@@ -261,7 +261,7 @@ func TestFormatDashboardText_OnlySpecs(t *testing.T) {
 	if strings.Contains(output, "Active Changes\n────") {
 		t.Error("Should not show Active Changes section when empty")
 	}
-	if strings.Contains(output, "Completed Changes\n────") {
+	if strings.Contains(output, "Ready to Archive\n────") {
 		t.Error("Should not show Completed Changes section when empty")
 	}
 }
@@ -282,8 +282,8 @@ func TestFormatSummarySection(t *testing.T) {
 	expectedElements := []string{
 		"Summary:",
 		"3 specs, 25 requirements",
-		"Active Changes: 2 in progress",
-		"Completed Changes: 1",
+		"Active Changes: 2",
+		"Ready to Archive: 1",
 		"Task Progress: 5/10 (50% complete)",
 	}
 
@@ -321,7 +321,7 @@ func TestFormatActiveChangesSection(t *testing.T) {
 		},
 	}
 
-	output := formatActiveChangesSection(changes)
+	output := formatActiveChangesSection("Active Changes", changes)
 
 	expectedElements := []string{
 		"Active Changes",
@@ -341,6 +341,37 @@ func TestFormatActiveChangesSection(t *testing.T) {
 	}
 }
 
+func TestFormatActiveChangesSection_TaskSections(t *testing.T) {
+	changes := []ChangeProgress{
+		{
+			ID:       "sectioned",
+			Progress: ProgressMetrics{Total: 3, Completed: 1, Percentage: 33},
+			Sections: []SectionProgress{
+				{Heading: "1. Setup", Progress: ProgressMetrics{Total: 1, Completed: 1}},
+				{Heading: "2. Docs", Progress: ProgressMetrics{Total: 2}},
+			},
+		},
+		{
+			ID:       "single",
+			Progress: ProgressMetrics{Total: 1},
+			Sections: []SectionProgress{
+				{Heading: "1. Only Section", Progress: ProgressMetrics{Total: 1}},
+			},
+		},
+	}
+
+	output := formatActiveChangesSection("Active Changes", changes)
+
+	for _, expected := range []string{"1. Setup", "1/1", "2. Docs", "0/2"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain %q:\n%s", expected, output)
+		}
+	}
+	if strings.Contains(output, "Only Section") {
+		t.Errorf("Expected a single section not to be broken down:\n%s", output)
+	}
+}
+
 // TestFormatCompletedChangesSection tests completed changes formatting
 func TestFormatCompletedChangesSection(t *testing.T) {
 	changes := []CompletedChange{
@@ -351,7 +382,7 @@ func TestFormatCompletedChangesSection(t *testing.T) {
 	output := formatCompletedChangesSection(changes)
 
 	expectedElements := []string{
-		"Completed Changes",
+		"Ready to Archive",
 		"────────────────────────────────────────────────────────────",
 		"completed-one",
 		"completed-two",
@@ -2,6 +2,8 @@
 // a comprehensive project overview including specs, changes, and tasks.
 package view
 
+import "github.com/connerohnesorge/spectr/internal/parsers"
+
 // DashboardData represents the complete dashboard data structure
 // containing summary metrics, active changes, completed changes,
 // and specifications.
@@ -18,10 +20,12 @@ type SummaryMetrics struct {
 	TotalSpecs int `json:"totalSpecs"`
 	// Total requirements across all specs
 	TotalRequirements int `json:"totalRequirements"`
-	// Number of active changes (incomplete)
+	// Number of active changes (not yet ready to archive)
 	ActiveChanges int `json:"activeChanges"`
-	// Number of completed changes
+	// Number of changes ready to archive
 	CompletedChanges int `json:"completedChanges"`
+	// Number of changes in each lifecycle state
+	ChangesByState map[string]int `json:"changesByState"`
 	// Total tasks across all active changes
 	TotalTasks int `json:"totalTasks"`
 	// Completed tasks across all active changes
@@ -33,6 +37,17 @@ type ChangeProgress struct {
 	ID       string          `json:"id"`       // Change ID (directory name)
 	Title    string          `json:"title"`    // Change title from proposal.md
 	Progress ProgressMetrics `json:"progress"` // Task completion metrics
+	State    string          `json:"state"`    // Lifecycle state
+	// Task progress of each tasks.md section
+	Sections []SectionProgress `json:"sections,omitempty"`
+	// Proposal frontmatter (owner, status, labels, ...)
+	parsers.ProposalMetadata
+}
+
+// SectionProgress represents the task progress of a tasks.md section
+type SectionProgress struct {
+	Heading  string          `json:"heading"`  // Section heading, e.g. "1. Setup"
+	Progress ProgressMetrics `json:"progress"` // Task completion metrics
 }
 
 // ProgressMetrics represents task completion statistics for a change
@@ -42,10 +57,13 @@ type ProgressMetrics struct {
 	Percentage int `json:"percentage"` // Completion percentage (0-100)
 }
 
-// CompletedChange represents a change that has all tasks completed
+// CompletedChange represents a change that is ready to archive
 type CompletedChange struct {
 	ID    string `json:"id"`    // Change ID (directory name)
 	Title string `json:"title"` // Change title from proposal.md
+	State string `json:"state"` // Lifecycle state
+	// Proposal frontmatter (owner, status, labels, ...)
+	parsers.ProposalMetadata
 }
 
 // SpecInfo represents a specification with metadata